@@ -42,7 +42,13 @@ func (r *Comms) Authorize(ctx context.Context, auth *pb.AuthorizerAuth) (ack *me
 	if err != nil {
 		return &messages.Ack{Error: err.Error()}, err
 	}
+	done, err := r.CheckReplay("Authorize", auth.GetNodeID(),
+		auth.GetTimeStamp(), auth.GetSignature())
+	if err != nil {
+		return &messages.Ack{Error: err.Error()}, err
+	}
 	returned_err := r.handler.Authorize(auth, address)
+	done(returned_err)
 	errString := ""
 	if err != nil {
 		errString = err.Error()
@@ -52,7 +58,14 @@ func (r *Comms) Authorize(ctx context.Context, auth *pb.AuthorizerAuth) (ack *me
 
 // Request a signed certificate for HTTPS
 func (r *Comms) RequestCert(ctx context.Context, msg *pb.AuthorizerCertRequest) (*messages.Ack, error) {
-	return r.handler.RequestCert(msg)
+	done, err := r.CheckReplay("RequestCert", msg.GetGwID(),
+		msg.GetTimestamp(), msg.GetSignature())
+	if err != nil {
+		return &messages.Ack{Error: err.Error()}, err
+	}
+	ack, err := r.handler.RequestCert(msg)
+	done(err)
+	return ack, err
 }
 
 // Request ACME key for HTTPS
@@ -0,0 +1,63 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package authorizer
+
+import (
+	"context"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/replayguard"
+	"gitlab.com/xx_network/comms/messages"
+	"testing"
+	"time"
+)
+
+// Tests that RequestCert rejects replayed, unsigned and stale requests before
+// they reach the handler.
+func TestComms_RequestCert_Replay(t *testing.T) {
+	impl := NewImplementation()
+	calls := 0
+	impl.Functions.RequestCert = func(
+		*pb.AuthorizerCertRequest) (*messages.Ack, error) {
+		calls++
+		return &messages.Ack{}, nil
+	}
+	comms := &Comms{handler: impl}
+
+	request := &pb.AuthorizerCertRequest{
+		GwID:      []byte("gateway"),
+		Timestamp: time.Now().UnixNano(),
+		ACMEToken: "record",
+		Signature: []byte("signature"),
+	}
+	if _, err := comms.RequestCert(context.Background(), request); err != nil {
+		t.Errorf("RequestCert produced an error: %+v", err)
+	}
+	_, err := comms.RequestCert(context.Background(), request)
+	if err != replayguard.ErrReplayed {
+		t.Errorf("Replayed request was not rejected: %v", err)
+	}
+
+	_, err = comms.RequestCert(context.Background(), &pb.AuthorizerCertRequest{
+		GwID: []byte("gateway"), Timestamp: time.Now().UnixNano()})
+	if err != replayguard.ErrUnsigned {
+		t.Errorf("Unsigned request was not rejected: %v", err)
+	}
+
+	_, err = comms.RequestCert(context.Background(), &pb.AuthorizerCertRequest{
+		GwID:      []byte("gateway"),
+		Timestamp: time.Now().Add(-time.Hour).UnixNano(),
+		Signature: []byte("other"),
+	})
+	if err != replayguard.ErrStale {
+		t.Errorf("Stale request was not rejected: %v", err)
+	}
+
+	if calls != 1 {
+		t.Errorf("Handler called %d times, expected 1", calls)
+	}
+}
@@ -10,10 +10,14 @@
 package authorizer
 
 import (
+	"context"
 	"runtime/debug"
 
 	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/elixxir/comms/drain"
 	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/recorder"
+	"gitlab.com/elixxir/comms/replayguard"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/comms/messages"
 	"gitlab.com/xx_network/primitives/id"
@@ -26,6 +30,9 @@ type Comms struct {
 	handler Handler
 	*pb.UnimplementedAuthorizerServer
 	*messages.UnimplementedGenericServer
+	recorder.Tap
+	drain.Drainer
+	replayguard.Guard
 }
 
 // Starts a new server on the address:port specified by localServer
@@ -44,13 +51,21 @@ func StartAuthorizerServer(id *id.ID, localServer string, handler Handler,
 		ProtoComms: pc,
 		handler:    handler,
 	}
-	pb.RegisterAuthorizerServer(authorizerServer.GetServer(), &authorizerServer)
+	authorizerServer.GetServer().RegisterService(authorizerServer.TrackService(
+		authorizerServer.WrapService(&pb.Authorizer_ServiceDesc)), &authorizerServer)
 	messages.RegisterGenericServer(authorizerServer.GetServer(), &authorizerServer)
+	authorizerServer.RegisterHealth(authorizerServer.GetServer())
 
 	pc.Serve()
 	return &authorizerServer
 }
 
+// GracefulStop drains the calls in flight, cancelling any left once ctx is
+// done, and shuts down the server.
+func (r *Comms) GracefulStop(ctx context.Context) error {
+	return drain.GracefulStop(ctx, &r.Drainer, r.ProtoComms)
+}
+
 type Handler interface {
 	Authorize(auth *pb.AuthorizerAuth, ipAddr string) (err error)
 	RequestCert(msg *pb.AuthorizerCertRequest) (*messages.Ack, error)
@@ -0,0 +1,110 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the HTTPS listener serving the certificate of a Reloader
+
+package certificates
+
+import (
+	"crypto/tls"
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/xx_network/comms/connect"
+	"google.golang.org/grpc"
+	"net"
+	"net/http"
+	"sync"
+)
+
+// HttpsServer serves the gRPC-web endpoints of a comms server over HTTPS on a
+// listener of its own, presenting the certificate held by a Reloader to each
+// new handshake. Connections which have completed their handshake keep the
+// certificate they were served, so reloading never interrupts them. It is
+// embedded in the Comms of the servers serving gRPC-web; its zero value is not
+// serving.
+type HttpsServer struct {
+	mux    sync.Mutex
+	server *http.Server
+
+	// The gRPC server of the comms server and its gRPC-web wrapper, which is
+	// replaced if the gRPC server is, such as by restarting the gateway
+	grpcServer *grpc.Server
+	wrapped    *grpcweb.WrappedGrpcServer
+}
+
+// StartHttps listens on the address and serves the gRPC-web endpoints of the
+// comms server over HTTPS with the certificate held by the reloader. Returns
+// an error if already serving or the address cannot be listened on.
+func (h *HttpsServer) StartHttps(pc *connect.ProtoComms, address string,
+	reloader *Reloader) error {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	if h.server != nil {
+		return errors.New("HTTPS is already being served")
+	}
+
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		return errors.Errorf("Could not listen on %s: %+v", address, err)
+	}
+	tlsLis := tls.NewListener(lis, &tls.Config{
+		GetCertificate: reloader.GetCertificate,
+		NextProtos:     []string{"h2", "http/1.1"},
+	})
+
+	server := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter,
+			r *http.Request) {
+			grpcServer := pc.GetServer()
+			if grpcServer == nil {
+				http.Error(w, "server is shut down",
+					http.StatusServiceUnavailable)
+				return
+			}
+			h.wrap(grpcServer).ServeHTTP(w, r)
+		}),
+	}
+	h.server = server
+
+	jww.INFO.Printf("Starting HTTPS server on %s", address)
+	go func() {
+		err := server.Serve(tlsLis)
+		if err != nil && err != http.ErrServerClosed {
+			jww.ERROR.Printf("Failed to serve HTTPS on %s: %+v", address,
+				err)
+		}
+		jww.INFO.Printf("Stopped HTTPS server on %s", address)
+	}()
+	return nil
+}
+
+// StopHttps closes the HTTPS listener and its connections. It does nothing if
+// HTTPS is not being served.
+func (h *HttpsServer) StopHttps() {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	if h.server == nil {
+		return
+	}
+	if err := h.server.Close(); err != nil {
+		jww.WARN.Printf("Failed to close HTTPS server: %+v", err)
+	}
+	h.server = nil
+}
+
+// wrap returns the gRPC-web wrapper of the gRPC server.
+func (h *HttpsServer) wrap(grpcServer *grpc.Server) *grpcweb.WrappedGrpcServer {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	if h.grpcServer != grpcServer {
+		h.grpcServer = grpcServer
+		h.wrapped = grpcweb.WrapServer(grpcServer,
+			grpcweb.WithOriginFunc(func(origin string) bool { return true }))
+	}
+	return h.wrapped
+}
@@ -0,0 +1,176 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package certificates keeps the TLS certificate of a comms server current,
+// loading new certificate and key pairs from a provider, such as files on
+// disk or an ACME client, without restarting the server.
+//
+// New handshakes pick up a reloaded certificate through GetCertificate, which
+// must be set in the tls.Config of the listener. The listeners created by
+// gitlab.com/xx_network/comms/connect fix their certificate when they start,
+// so the servers serving gRPC-web serve it over HTTPS with reloaded
+// certificates on a listener of their own, an HttpsServer. Reloading only
+// covers these HTTPS certificates: the gRPC certificate of a server is its
+// identity, which peers pin from the NDF, so it is replaced by restarting the
+// server with the certificate published in a new NDF.
+
+package certificates
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	"os"
+	"sync"
+	"time"
+)
+
+// Provider returns the current certificate and key, PEM encoded. It is called
+// periodically by Poll; returning the same pair again does not reload it.
+type Provider func() (certPEM, keyPEM []byte, err error)
+
+// Reloader holds the certificate served for new TLS handshakes and replaces
+// it as new certificates are provided.
+type Reloader struct {
+	mux      sync.RWMutex
+	cert     *tls.Certificate
+	certPEM  []byte
+	keyPEM   []byte
+	onReload []func(cert tls.Certificate)
+}
+
+// NewReloader returns a Reloader serving the certificate and key.
+func NewReloader(certPEM, keyPEM []byte) (*Reloader, error) {
+	r := &Reloader{}
+	if err := r.Set(certPEM, keyPEM); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Set replaces the certificate served for new handshakes. Connections which
+// have already completed their handshake are unaffected. Returns an error,
+// keeping the current certificate, if the pair is invalid or has expired.
+func (r *Reloader) Set(certPEM, keyPEM []byte) error {
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return errors.Errorf("Could not load TLS keys: %+v", err)
+	}
+	cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return errors.WithMessage(err, "Could not parse x509 certificate")
+	}
+	if time.Now().After(cert.Leaf.NotAfter) {
+		return errors.Errorf("Certificate expired at %s",
+			cert.Leaf.NotAfter)
+	}
+
+	r.mux.Lock()
+	r.cert = &cert
+	r.certPEM, r.keyPEM = certPEM, keyPEM
+	onReload := r.onReload
+	r.mux.Unlock()
+
+	jww.INFO.Printf("Loaded TLS certificate for %v valid until %s",
+		cert.Leaf.DNSNames, cert.Leaf.NotAfter)
+	for _, f := range onReload {
+		f(cert)
+	}
+	return nil
+}
+
+// GetCertificate returns the current certificate. It matches the
+// GetCertificate field of tls.Config.
+func (r *Reloader) GetCertificate(*tls.ClientHelloInfo) (
+	*tls.Certificate, error) {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	return r.cert, nil
+}
+
+// GetPem returns the current certificate and key, PEM encoded.
+func (r *Reloader) GetPem() (certPEM, keyPEM []byte) {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	return r.certPEM, r.keyPEM
+}
+
+// OnReload registers a function called with each certificate loaded after
+// it is registered.
+func (r *Reloader) OnReload(f func(cert tls.Certificate)) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.onReload = append(r.onReload, f)
+}
+
+// Poll calls the provider every interval and loads the certificate it returns
+// if it has changed. Errors are logged and the current certificate is kept.
+// The returned function stops polling.
+func (r *Reloader) Poll(provider Provider, interval time.Duration) (
+	stop func()) {
+	quit := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-quit:
+				return
+			case <-ticker.C:
+				r.reload(provider)
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() { once.Do(func() { close(quit) }) }
+}
+
+// WatchFiles reloads the certificate and key from the files whenever their
+// contents change, checking every interval. The returned function stops
+// watching.
+func (r *Reloader) WatchFiles(certPath, keyPath string,
+	interval time.Duration) (stop func()) {
+	return r.Poll(FileProvider(certPath, keyPath), interval)
+}
+
+// reload loads the certificate from the provider if it has changed.
+func (r *Reloader) reload(provider Provider) {
+	certPEM, keyPEM, err := provider()
+	if err != nil {
+		jww.WARN.Printf("Failed to get TLS certificate from provider: %+v",
+			err)
+		return
+	}
+
+	currentCert, currentKey := r.GetPem()
+	if bytes.Equal(certPEM, currentCert) && bytes.Equal(keyPEM, currentKey) {
+		return
+	}
+
+	if err = r.Set(certPEM, keyPEM); err != nil {
+		jww.WARN.Printf("Failed to reload TLS certificate: %+v", err)
+	}
+}
+
+// FileProvider returns a Provider reading the certificate and key from files.
+func FileProvider(certPath, keyPath string) Provider {
+	return func() ([]byte, []byte, error) {
+		certPEM, err := os.ReadFile(certPath)
+		if err != nil {
+			return nil, nil, errors.Errorf("Could not read certificate: %+v",
+				err)
+		}
+		keyPEM, err := os.ReadFile(keyPath)
+		if err != nil {
+			return nil, nil, errors.Errorf("Could not read key: %+v", err)
+		}
+		return certPEM, keyPEM, nil
+	}
+}
@@ -0,0 +1,106 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package certificates
+
+import (
+	"bytes"
+	"crypto/tls"
+	"gitlab.com/elixxir/comms/testkeys"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// Tests that Set replaces the certificate returned by GetCertificate, calls
+// the OnReload hooks and keeps the current certificate when given an
+// invalid pair.
+func TestReloader_Set(t *testing.T) {
+	r, err := NewReloader(testkeys.GetNodeCert(), testkeys.GetNodeKey())
+	if err != nil {
+		t.Fatalf("Unable to create reloader: %+v", err)
+	}
+
+	reloaded := make(chan tls.Certificate, 1)
+	r.OnReload(func(cert tls.Certificate) { reloaded <- cert })
+
+	err = r.Set(testkeys.GetGatewayCert(), testkeys.GetGatewayKey())
+	if err != nil {
+		t.Fatalf("Set produced an error: %+v", err)
+	}
+	cert, err := r.GetCertificate(nil)
+	if err != nil {
+		t.Fatalf("GetCertificate produced an error: %+v", err)
+	}
+	gatewayCert, err := tls.X509KeyPair(testkeys.GetGatewayCert(),
+		testkeys.GetGatewayKey())
+	if err != nil {
+		t.Fatalf("Unable to load gateway keys: %+v", err)
+	}
+	if !bytes.Equal(cert.Certificate[0], gatewayCert.Certificate[0]) {
+		t.Errorf("GetCertificate did not return the new certificate")
+	}
+	select {
+	case <-reloaded:
+	default:
+		t.Errorf("OnReload hook was not called")
+	}
+
+	// A mismatched pair is rejected
+	if r.Set(testkeys.GetNodeCert(), testkeys.GetGatewayKey()) == nil {
+		t.Errorf("Set should reject a mismatched certificate and key")
+	}
+	certPEM, _ := r.GetPem()
+	if !bytes.Equal(certPEM, testkeys.GetGatewayCert()) {
+		t.Errorf("Certificate should be kept after a failed reload")
+	}
+}
+
+// Tests that WatchFiles reloads the certificate once the files change.
+func TestReloader_WatchFiles(t *testing.T) {
+	dir := t.TempDir()
+	certPath := filepath.Join(dir, "cert.crt")
+	keyPath := filepath.Join(dir, "key.key")
+	writeFile := func(path string, data []byte) {
+		if err := os.WriteFile(path, data, 0600); err != nil {
+			t.Fatalf("Unable to write %s: %+v", path, err)
+		}
+	}
+	writeFile(certPath, testkeys.GetNodeCert())
+	writeFile(keyPath, testkeys.GetNodeKey())
+
+	r, err := NewReloader(testkeys.GetNodeCert(), testkeys.GetNodeKey())
+	if err != nil {
+		t.Fatalf("Unable to create reloader: %+v", err)
+	}
+	reloaded := make(chan tls.Certificate, 1)
+	r.OnReload(func(cert tls.Certificate) { reloaded <- cert })
+
+	stop := r.WatchFiles(certPath, keyPath, time.Millisecond)
+	defer stop()
+
+	select {
+	case <-reloaded:
+		t.Fatalf("Unchanged files should not be reloaded")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	writeFile(keyPath, testkeys.GetGatewayKey())
+	writeFile(certPath, testkeys.GetGatewayCert())
+
+	select {
+	case <-reloaded:
+	case <-time.After(time.Second):
+		t.Fatalf("Changed files were not reloaded")
+	}
+	certPEM, keyPEM := r.GetPem()
+	if !bytes.Equal(certPEM, testkeys.GetGatewayCert()) ||
+		!bytes.Equal(keyPEM, testkeys.GetGatewayKey()) {
+		t.Errorf("Reloader does not hold the new certificate and key")
+	}
+}
@@ -0,0 +1,191 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the verification and automatic renewal of channel leases
+
+package client
+
+import (
+	"context"
+	"crypto/ed25519"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/crypto/channel"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/ndf"
+	"sync"
+	"time"
+)
+
+// VerifyChannelLease returns an error unless the lease has not expired and is
+// signed by UD, with the channel signing key from the NDF, for the user's
+// username and Ed25519 public key, as signed by channel.SignChannelLease.
+func VerifyChannelLease(def *ndf.NetworkDefinition, username string,
+	lease *pb.ChannelLeaseResponse) error {
+	udbKey := ed25519.PublicKey(def.UDB.ChannelSigningPubKeyEd25519)
+	if len(udbKey) != ed25519.PublicKeySize {
+		return errors.New("NDF has no UD channel signing key")
+	}
+
+	expiry := time.Unix(0, lease.GetLease())
+	if !time.Now().Before(expiry) {
+		return errors.Errorf("Channel lease expired at %s", expiry)
+	}
+	if !channel.VerifyChannelLease(lease.GetUDLeaseEd25519Signature(),
+		lease.GetUserEd25519PubKey(), username, expiry, udbKey) {
+		return errors.New("Channel lease is not signed by UD")
+	}
+	return nil
+}
+
+// ChannelLeaseParams configures a ChannelLeaseManager.
+type ChannelLeaseParams struct {
+	// How long before the lease expires it is renewed
+	RenewBefore time.Duration
+
+	// Time waited before retrying a failed renewal
+	RetryInterval time.Duration
+}
+
+// DefaultChannelLeaseParams returns the default ChannelLeaseParams.
+func DefaultChannelLeaseParams() ChannelLeaseParams {
+	return ChannelLeaseParams{
+		RenewBefore:   24 * time.Hour,
+		RetryInterval: time.Minute,
+	}
+}
+
+// ChannelLeaseManager keeps a user's channel lease current by renewing it
+// with UD ahead of its expiry, or requesting a new one if it has expired.
+type ChannelLeaseManager struct {
+	comms      *Comms
+	host       *connect.Host
+	def        *ndf.NetworkDefinition
+	username   string
+	newRequest func() (*pb.ChannelLeaseRequest, error)
+	params     ChannelLeaseParams
+
+	mux       sync.Mutex
+	lease     *pb.ChannelLeaseResponse
+	callbacks []func(lease *pb.ChannelLeaseResponse)
+}
+
+// NewChannelLeaseManager returns a ChannelLeaseManager for the lease of the
+// user with the username from the UD host, starting from the current lease,
+// which may be nil. newRequest returns a new request for the lease, signed by
+// the user.
+func (c *Comms) NewChannelLeaseManager(host *connect.Host,
+	def *ndf.NetworkDefinition, username string,
+	current *pb.ChannelLeaseResponse,
+	newRequest func() (*pb.ChannelLeaseRequest, error),
+	params ChannelLeaseParams) (*ChannelLeaseManager, error) {
+	if current != nil {
+		if err := VerifyChannelLease(def, username, current); err != nil {
+			return nil, errors.WithMessage(err, "Invalid current lease")
+		}
+	}
+	return &ChannelLeaseManager{
+		comms:      c,
+		host:       host,
+		def:        def,
+		username:   username,
+		newRequest: newRequest,
+		params:     params,
+		lease:      current,
+	}, nil
+}
+
+// Get returns the current lease, or nil if there is none.
+func (m *ChannelLeaseManager) Get() *pb.ChannelLeaseResponse {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	return m.lease
+}
+
+// OnRenew registers a function called with each new lease.
+func (m *ChannelLeaseManager) OnRenew(f func(lease *pb.ChannelLeaseResponse)) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	m.callbacks = append(m.callbacks, f)
+}
+
+// Start renews the lease ahead of its expiry until the returned function is
+// called. Stop the manager before revoking the lease.
+func (m *ChannelLeaseManager) Start() (stop func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		for {
+			select {
+			case <-time.After(m.untilRenewal()):
+			case <-ctx.Done():
+				return
+			}
+
+			err := m.Renew()
+			if err == nil && m.untilRenewal() <= 0 {
+				err = errors.New("Renewed lease is already due for renewal")
+			}
+			if err != nil {
+				jww.WARN.Printf("Renewing channel lease failed, retrying "+
+					"in %s: %+v", m.params.RetryInterval, err)
+				select {
+				case <-time.After(m.params.RetryInterval):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return cancel
+}
+
+// untilRenewal returns the time until the lease is due for renewal.
+func (m *ChannelLeaseManager) untilRenewal() time.Duration {
+	lease := m.Get()
+	if lease == nil {
+		return 0
+	}
+	return time.Until(
+		time.Unix(0, lease.GetLease()).Add(-m.params.RenewBefore))
+}
+
+// Renew renews the current lease, or requests a new one if there is none or
+// it has expired.
+func (m *ChannelLeaseManager) Renew() error {
+	request, err := m.newRequest()
+	if err != nil {
+		return errors.WithMessage(err, "Unable to create lease request")
+	}
+
+	current := m.Get()
+	var lease *pb.ChannelLeaseResponse
+	if current == nil || !time.Now().Before(time.Unix(0, current.GetLease())) {
+		lease, err = m.comms.SendChannelLeaseRequest(m.host, request)
+	} else {
+		lease, err = m.comms.SendRenewChannelLease(m.host,
+			&pb.ChannelLeaseRenewal{Request: request, Lease: current})
+	}
+	if err != nil {
+		return err
+	}
+	if err = VerifyChannelLease(m.def, m.username, lease); err != nil {
+		return errors.WithMessage(err, "Received invalid lease")
+	}
+
+	m.mux.Lock()
+	m.lease = lease
+	callbacks := m.callbacks
+	m.mux.Unlock()
+
+	jww.INFO.Printf("Renewed channel lease until %s",
+		time.Unix(0, lease.GetLease()))
+	for _, callback := range callbacks {
+		callback(lease)
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package client
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/udb"
+	"gitlab.com/elixxir/crypto/channel"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/ndf"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newTestLease returns a lease until the expiry signed by the UD key, over
+// the username as UD signs it.
+func newTestLease(username string, userKey ed25519.PublicKey,
+	expiry time.Time, udKey ed25519.PrivateKey) *pb.ChannelLeaseResponse {
+	return &pb.ChannelLeaseResponse{
+		Lease:             expiry.UnixNano(),
+		UserEd25519PubKey: userKey,
+		UDLeaseEd25519Signature: channel.SignChannelLease(
+			userKey, username, expiry, udKey),
+	}
+}
+
+// newTestLeaseKeys returns a user key and an NDF holding a new UD key.
+func newTestLeaseKeys(t *testing.T) (ed25519.PublicKey,
+	ed25519.PrivateKey, *ndf.NetworkDefinition) {
+	userKey, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("Unable to generate user key: %+v", err)
+	}
+	udPub, udKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("Unable to generate UD key: %+v", err)
+	}
+	def := &ndf.NetworkDefinition{
+		UDB: ndf.UDB{ChannelSigningPubKeyEd25519: udPub},
+	}
+	return userKey, udKey, def
+}
+
+// Tests that VerifyChannelLease accepts unexpired leases signed by UD for the
+// user, and rejects all others.
+func TestVerifyChannelLease(t *testing.T) {
+	userKey, udKey, def := newTestLeaseKeys(t)
+	const username = "alice"
+	lease := newTestLease(username, userKey, time.Now().Add(time.Hour), udKey)
+
+	if err := VerifyChannelLease(def, username, lease); err != nil {
+		t.Errorf("Valid lease was rejected: %+v", err)
+	}
+
+	if err := VerifyChannelLease(def, "bob", lease); err == nil {
+		t.Errorf("Lease for another user was accepted")
+	}
+
+	userID := id.NewIdFromString("user", id.User, t).Marshal()
+	if err := VerifyChannelLease(def, string(userID), lease); err == nil {
+		t.Errorf("Lease was accepted for the user ID in place of the " +
+			"username")
+	}
+
+	expired := newTestLease(username, userKey, time.Now().Add(-time.Second),
+		udKey)
+	if err := VerifyChannelLease(def, username, expired); err == nil {
+		t.Errorf("Expired lease was accepted")
+	}
+
+	_, _, otherDef := newTestLeaseKeys(t)
+	if err := VerifyChannelLease(otherDef, username, lease); err == nil {
+		t.Errorf("Lease signed by another key was accepted")
+	}
+
+	if err := VerifyChannelLease(&ndf.NetworkDefinition{}, username, lease); err == nil {
+		t.Errorf("Lease was accepted without a UD key")
+	}
+}
+
+// Tests that a ChannelLeaseManager requests a lease when it has none, and
+// renews it ahead of its expiry.
+func TestChannelLeaseManager(t *testing.T) {
+	userKey, udKey, def := newTestLeaseKeys(t)
+	userID := id.NewIdFromString("user", id.User, t).Marshal()
+	const username = "alice"
+	const leaseDuration = 2 * time.Second
+
+	impl := udb.NewImplementation()
+	var requested, renewed int32
+	impl.Functions.RequestChannelLease = func(
+		request *pb.ChannelLeaseRequest) (*pb.ChannelLeaseResponse, error) {
+		atomic.AddInt32(&requested, 1)
+		return newTestLease(username, request.UserEd25519PubKey,
+			time.Now().Add(leaseDuration), udKey), nil
+	}
+	impl.Functions.RenewChannelLease = func(
+		renewal *pb.ChannelLeaseRenewal) (*pb.ChannelLeaseResponse, error) {
+		atomic.AddInt32(&renewed, 1)
+		return newTestLease(username, renewal.Request.UserEd25519PubKey,
+			time.Now().Add(leaseDuration), udKey), nil
+	}
+
+	udAddr := getNextAddress()
+	ud := udb.StartServer(&id.UDB, udAddr, impl, nil, nil)
+	defer ud.Shutdown()
+
+	c, err := NewClientComms(&id.DummyUser, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := connect.NewManagerTesting(t).AddHost(&id.UDB, udAddr, nil,
+		params)
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+
+	newRequest := func() (*pb.ChannelLeaseRequest, error) {
+		signature := make([]byte, 32)
+		_, err := rand.Read(signature)
+		return &pb.ChannelLeaseRequest{
+			UserID:                 userID,
+			UserEd25519PubKey:      userKey,
+			Timestamp:              time.Now().UnixNano(),
+			UserPubKeyRSASignature: signature,
+		}, err
+	}
+	m, err := c.NewChannelLeaseManager(host, def, username, nil, newRequest,
+		ChannelLeaseParams{
+			RenewBefore:   leaseDuration - 200*time.Millisecond,
+			RetryInterval: time.Second,
+		})
+	if err != nil {
+		t.Fatalf("Unable to create lease manager: %+v", err)
+	}
+
+	leases := make(chan *pb.ChannelLeaseResponse, 10)
+	m.OnRenew(func(lease *pb.ChannelLeaseResponse) { leases <- lease })
+	stop := m.Start()
+	defer stop()
+
+	for i := 0; i < 2; i++ {
+		select {
+		case lease := <-leases:
+			if err = VerifyChannelLease(def, username, lease); err != nil {
+				t.Errorf("Manager received invalid lease: %+v", err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Lease %d was not received", i)
+		}
+	}
+	if atomic.LoadInt32(&requested) != 1 || atomic.LoadInt32(&renewed) < 1 {
+		t.Errorf("Lease requested %d times and renewed %d times, expected "+
+			"one request and at least one renewal",
+			atomic.LoadInt32(&requested), atomic.LoadInt32(&renewed))
+	}
+}
+
+// Smoke test SendRevokeChannelLease
+func TestComms_SendRevokeChannelLease(t *testing.T) {
+	impl := udb.NewImplementation()
+	revoked := false
+	impl.Functions.RevokeChannelLease = func(
+		*pb.ChannelLeaseRevocation) (*messages.Ack, error) {
+		revoked = true
+		return &messages.Ack{}, nil
+	}
+	udAddr := getNextAddress()
+	ud := udb.StartServer(&id.UDB, udAddr, impl, nil, nil)
+	defer ud.Shutdown()
+
+	c, err := NewClientComms(&id.DummyUser, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := connect.NewManagerTesting(t).AddHost(&id.UDB, udAddr, nil,
+		params)
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+
+	_, err = c.SendRevokeChannelLease(host, &pb.ChannelLeaseRevocation{
+		Timestamp:              time.Now().UnixNano(),
+		UserPubKeyRSASignature: []byte("signature"),
+	})
+	if err != nil {
+		t.Errorf("RevokeChannelLease: Error received: %+v", err)
+	}
+	if !revoked {
+		t.Errorf("RevokeChannelLease did not reach the handler")
+	}
+}
@@ -22,7 +22,8 @@ import (
 	"time"
 )
 
-// SendPutMessage Client -> Gateway Send Function
+// SendPutMessage Client -> Gateway Send Function. If the gateway rejects the
+// message, the response is returned with a *mixmessages.SlotRejectedError.
 func (c *Comms) SendPutMessage(host *connect.Host, message *pb.GatewaySlot,
 	timeout time.Duration) (*pb.GatewaySlotResponse, error) {
 
@@ -54,15 +55,15 @@ func (c *Comms) SendPutMessage(host *connect.Host, message *pb.GatewaySlot,
 	jww.TRACE.Printf("Sending Put message: %+v", message)
 	resultMsg, err := c.Send(host, f)
 	if err != nil {
-		return nil, err
+		return pb.CheckSlotError(err)
 	}
 
 	result := &pb.GatewaySlotResponse{}
-
 	return result, ptypes.UnmarshalAny(resultMsg, result)
 }
 
-// SendPutManyMessages Client -> Gateway Send Function
+// SendPutManyMessages Client -> Gateway Send Function. If the gateway rejects
+// the messages, the response is returned with a *mixmessages.SlotRejectedError.
 func (c *Comms) SendPutManyMessages(host *connect.Host,
 	messages *pb.GatewaySlots, timeout time.Duration) (
 	*pb.GatewaySlotResponse, error) {
@@ -93,11 +94,10 @@ func (c *Comms) SendPutManyMessages(host *connect.Host,
 	jww.TRACE.Printf("Sending PutManyMessages: %+v", messages)
 	resultMsg, err := c.Send(host, f)
 	if err != nil {
-		return nil, err
+		return pb.CheckSlotError(err)
 	}
 
 	result := &pb.GatewaySlotResponse{}
-
 	return result, ptypes.UnmarshalAny(resultMsg, result)
 }
 
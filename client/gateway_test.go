@@ -8,6 +8,7 @@
 package client
 
 import (
+	"github.com/pkg/errors"
 	jww "github.com/spf13/jwalterweatherman"
 	"gitlab.com/elixxir/comms/gateway"
 	pb "gitlab.com/elixxir/comms/mixmessages"
@@ -53,6 +54,62 @@ func TestSendPutMessage(t *testing.T) {
 	}
 }
 
+// Tests that a message rejected by the gateway's handler is returned as a
+// SlotRejectedError with the reason and suggestions, even when the handler
+// also returns an error.
+func TestSendPutMessage_Rejected(t *testing.T) {
+	gatewayAddress := getNextAddress()
+	testID := id.NewIdFromString("test", id.Gateway, t)
+	impl := gateway.NewImplementation()
+	impl.Functions.PutMessage = func(message *pb.GatewaySlot,
+		ipAddr string) (*pb.GatewaySlotResponse, error) {
+		return &pb.GatewaySlotResponse{
+			RoundID:          message.GetRoundID(),
+			Rejection:        pb.SlotRejection_ROUND_FULL,
+			RetryAfter:       int64(3 * time.Second),
+			SuggestedRoundID: message.GetRoundID() + 1,
+		}, errors.New("round is full")
+	}
+	gw := gateway.StartGateway(testID, gatewayAddress, impl, nil, nil,
+		gossip.DefaultManagerFlags())
+	defer gw.Shutdown()
+	var c Comms
+
+	for _, connectionType := range []connect.ConnectionType{connect.Grpc, connect.Web} {
+		manager := connect.NewManagerTesting(t)
+
+		params := connect.GetDefaultHostParams()
+		params.ConnectionType = connectionType
+		params.AuthEnabled = false
+		host, err := manager.AddHost(testID, gatewayAddress, nil, params)
+		if err != nil {
+			t.Errorf("Unable to call NewHost: %+v", err)
+		}
+
+		resp, err := c.SendPutMessage(host, &pb.GatewaySlot{RoundID: 5},
+			10*time.Second)
+		rejected, ok := err.(*pb.SlotRejectedError)
+		if !ok {
+			t.Fatalf("PutMessage: Expected SlotRejectedError, received: %+v",
+				err)
+		}
+		expected := &pb.SlotRejectedError{
+			Reason:           pb.SlotRejection_ROUND_FULL,
+			RoundID:          5,
+			RetryAfter:       3 * time.Second,
+			SuggestedRoundID: 6,
+			Message:          "round is full",
+		}
+		if *rejected != *expected {
+			t.Errorf("PutMessage: Unexpected rejection.\nexpected: %+v"+
+				"\nreceived: %+v", expected, rejected)
+		}
+		if resp.GetAccepted() {
+			t.Errorf("PutMessage: Rejected message marked accepted")
+		}
+	}
+}
+
 // Test the restart function of gateway comms
 func TestRestart(t *testing.T) {
 	gatewayAddress := getNextAddress()
@@ -270,3 +327,11 @@ func (m mockGatewayImpl) RequestBatchMessages(message *pb.GetMessagesBatch) (*pb
 func (m mockGatewayImpl) RequestTlsCert(msg *pb.RequestGatewayCert) (*pb.GatewayCertificate, error) {
 	return &pb.GatewayCertificate{}, nil
 }
+
+func (m mockGatewayImpl) ReplicateRound(msg *pb.RoundReplica) error {
+	return nil
+}
+
+func (m mockGatewayImpl) RequestRoundReplica(msg *pb.GetRoundReplica) (*pb.RoundReplica, error) {
+	return &pb.RoundReplica{}, nil
+}
@@ -76,6 +76,14 @@ func (s *MockRegistration) CheckRegistration(msg *pb.RegisteredNodeCheck) (*pb.R
 	return nil, nil
 }
 
+func (s *MockRegistration) RotateNodeCredentials(*pb.NodeCredentialRotation, *connect.Auth) error {
+	return nil
+}
+
+func (s *MockRegistration) DeregisterNode(*pb.NodeDeregistration, *connect.Auth) error {
+	return nil
+}
+
 // ------------------------- Mock Error Registration Server Handler ---------------------------
 
 type MockRegistrationError struct {
@@ -112,3 +120,11 @@ func (s *MockRegistrationError) RegisterUser(confirmation *pb.ClientRegistration
 func (s *MockRegistrationError) CheckRegistration(msg *pb.RegisteredNodeCheck) (*pb.RegisteredNodeConfirmation, error) {
 	return nil, nil
 }
+
+func (s *MockRegistrationError) RotateNodeCredentials(*pb.NodeCredentialRotation, *connect.Auth) error {
+	return nil
+}
+
+func (s *MockRegistrationError) DeregisterNode(*pb.NodeDeregistration, *connect.Auth) error {
+	return nil
+}
@@ -164,3 +164,207 @@ func (c *Comms) UnregisterToken(host *connect.Host, message *pb.UnregisterTokenR
 	result := &messages.Ack{}
 	return result, ptypes.UnmarshalAny(resultMsg, result)
 }
+
+// ListRegistrations returns the tokens and tracked IDs registered to the
+// TransmissionRsaPem, with their expiries. The request is signed.
+func (c *Comms) ListRegistrations(host *connect.Host, message *pb.ListRegistrationsRequest) (*pb.RegistrationList, error) {
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContext()
+		defer cancel()
+
+		// Send the message
+		var err error
+		var resultMsg = &pb.RegistrationList{}
+		if conn.IsWeb() {
+			wc := conn.GetWebConn()
+			err = wc.Invoke(ctx, "/mixmessages.NotificationBot/ListRegistrations",
+				message, resultMsg)
+		} else {
+			resultMsg, err = pb.NewNotificationBotClient(conn.GetGrpcConn()).
+				ListRegistrations(ctx, message)
+		}
+		if err != nil {
+			return nil, errors.New(err.Error())
+		}
+		return ptypes.MarshalAny(resultMsg)
+	}
+
+	// Execute the Send function
+	jww.TRACE.Printf("Sending ListRegistrations message: %+v", message)
+	resultMsg, err := c.Send(host, f)
+	if err != nil {
+		return nil, err
+	}
+
+	// Marshall the result
+	result := &pb.RegistrationList{}
+	return result, ptypes.UnmarshalAny(resultMsg, result)
+}
+
+// RefreshRegistration extends all registrations of the TransmissionRsaPem
+// before they expire, returning their new expiries. The request is signed.
+func (c *Comms) RefreshRegistration(host *connect.Host, message *pb.RefreshRegistrationRequest) (*pb.RegistrationList, error) {
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContext()
+		defer cancel()
+
+		// Send the message
+		var err error
+		var resultMsg = &pb.RegistrationList{}
+		if conn.IsWeb() {
+			wc := conn.GetWebConn()
+			err = wc.Invoke(ctx, "/mixmessages.NotificationBot/RefreshRegistration",
+				message, resultMsg)
+		} else {
+			resultMsg, err = pb.NewNotificationBotClient(conn.GetGrpcConn()).
+				RefreshRegistration(ctx, message)
+		}
+		if err != nil {
+			return nil, errors.New(err.Error())
+		}
+		return ptypes.MarshalAny(resultMsg)
+	}
+
+	// Execute the Send function
+	jww.TRACE.Printf("Sending RefreshRegistration message: %+v", message)
+	resultMsg, err := c.Send(host, f)
+	if err != nil {
+		return nil, err
+	}
+
+	// Marshall the result
+	result := &pb.RegistrationList{}
+	return result, ptypes.UnmarshalAny(resultMsg, result)
+}
+
+// RegistrationSigner creates the signed V2 notification requests of a user.
+type RegistrationSigner interface {
+	RegisterToken(app, token string) (*pb.RegisterTokenRequest, error)
+	UnregisterToken(app, token string) (*pb.UnregisterTokenRequest, error)
+	RegisterTrackedIDs(ids [][]byte) (*pb.RegisterTrackedIdRequest, error)
+	UnregisterTrackedIDs(ids [][]byte) (*pb.UnregisterTrackedIdRequest, error)
+	ListRegistrations() (*pb.ListRegistrationsRequest, error)
+}
+
+// RegistrationDiff lists the changes which bring the actual registrations of
+// a user to the desired registrations.
+type RegistrationDiff struct {
+	RegisterTokens       []*pb.TokenRegistration
+	UnregisterTokens     []*pb.TokenRegistration
+	RegisterTrackedIDs   [][]byte
+	UnregisterTrackedIDs [][]byte
+}
+
+// DiffRegistrations returns the changes which bring the actual registrations
+// to the desired registrations. Expiries are ignored.
+func DiffRegistrations(desired, actual *pb.RegistrationList) RegistrationDiff {
+	var diff RegistrationDiff
+
+	tokenKey := func(t *pb.TokenRegistration) string {
+		return t.GetApp() + "\x00" + t.GetToken()
+	}
+	actualTokens := make(map[string]bool)
+	for _, t := range actual.GetTokens() {
+		actualTokens[tokenKey(t)] = true
+	}
+	desiredTokens := make(map[string]bool)
+	for _, t := range desired.GetTokens() {
+		desiredTokens[tokenKey(t)] = true
+		if !actualTokens[tokenKey(t)] {
+			diff.RegisterTokens = append(diff.RegisterTokens, t)
+		}
+	}
+	for _, t := range actual.GetTokens() {
+		if !desiredTokens[tokenKey(t)] {
+			diff.UnregisterTokens = append(diff.UnregisterTokens, t)
+		}
+	}
+
+	actualIDs := make(map[string]bool)
+	for _, t := range actual.GetTrackedIDs() {
+		actualIDs[string(t.GetTrackedIntermediaryID())] = true
+	}
+	desiredIDs := make(map[string]bool)
+	for _, t := range desired.GetTrackedIDs() {
+		desiredIDs[string(t.GetTrackedIntermediaryID())] = true
+		if !actualIDs[string(t.GetTrackedIntermediaryID())] {
+			diff.RegisterTrackedIDs = append(diff.RegisterTrackedIDs,
+				t.GetTrackedIntermediaryID())
+		}
+	}
+	for _, t := range actual.GetTrackedIDs() {
+		if !desiredIDs[string(t.GetTrackedIntermediaryID())] {
+			diff.UnregisterTrackedIDs = append(diff.UnregisterTrackedIDs,
+				t.GetTrackedIntermediaryID())
+		}
+	}
+
+	return diff
+}
+
+// ReconcileRegistrations lists the registrations of the user and registers or
+// unregisters tokens and tracked IDs until they match the desired
+// registrations. It stops at the first request which fails; as each change
+// is idempotent, it can be called again to finish reconciling. Returns the
+// changes made.
+func (c *Comms) ReconcileRegistrations(host *connect.Host,
+	desired *pb.RegistrationList, signer RegistrationSigner) (
+	RegistrationDiff, error) {
+	listRequest, err := signer.ListRegistrations()
+	if err != nil {
+		return RegistrationDiff{}, err
+	}
+	actual, err := c.ListRegistrations(host, listRequest)
+	if err != nil {
+		return RegistrationDiff{}, errors.WithMessage(err,
+			"Unable to list registrations")
+	}
+
+	diff := DiffRegistrations(desired, actual)
+	for _, t := range diff.UnregisterTokens {
+		request, err := signer.UnregisterToken(t.GetApp(), t.GetToken())
+		if err == nil {
+			_, err = c.UnregisterToken(host, request)
+		}
+		if err != nil {
+			return diff, errors.WithMessagef(err,
+				"Unable to unregister token for %s", t.GetApp())
+		}
+	}
+	for _, t := range diff.RegisterTokens {
+		request, err := signer.RegisterToken(t.GetApp(), t.GetToken())
+		if err == nil {
+			_, err = c.RegisterToken(host, request)
+		}
+		if err != nil {
+			return diff, errors.WithMessagef(err,
+				"Unable to register token for %s", t.GetApp())
+		}
+	}
+	if len(diff.UnregisterTrackedIDs) > 0 {
+		request, err := signer.UnregisterTrackedIDs(diff.UnregisterTrackedIDs)
+		if err == nil {
+			_, err = c.UnregisterTrackedID(host, request)
+		}
+		if err != nil {
+			return diff, errors.WithMessage(err,
+				"Unable to unregister tracked IDs")
+		}
+	}
+	if len(diff.RegisterTrackedIDs) > 0 {
+		request, err := signer.RegisterTrackedIDs(diff.RegisterTrackedIDs)
+		if err == nil {
+			_, err = c.RegisterTrackedID(host, request)
+		}
+		if err != nil {
+			return diff, errors.WithMessage(err,
+				"Unable to register tracked IDs")
+		}
+	}
+
+	return diff, nil
+}
@@ -5,7 +5,9 @@ import (
 	"gitlab.com/elixxir/comms/notificationBot"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/primitives/id"
+	"strconv"
 	"testing"
+	"time"
 )
 
 // Smoke test for UnregisterForNotifications
@@ -35,7 +37,9 @@ func TestComms_RegisterToken(t *testing.T) {
 	}
 
 	// Unregister client with notification bot
-	_, err = c.RegisterToken(host, &pb.RegisterTokenRequest{})
+	_, err = c.RegisterToken(host, &pb.RegisterTokenRequest{
+		RequestTimestamp: time.Now().UnixNano(),
+		TokenSignature:   []byte("signature")})
 	if err != nil {
 		t.Errorf("RegistrationMessage: Error received: %s", err)
 	}
@@ -68,7 +72,10 @@ func TestComms_RegisterTrackedID(t *testing.T) {
 	}
 
 	// Unregister client with notification bot
-	_, err = c.RegisterTrackedID(host, &pb.RegisterTrackedIdRequest{})
+	_, err = c.RegisterTrackedID(host, &pb.RegisterTrackedIdRequest{
+		Request: &pb.TrackedIntermediaryIdRequest{
+			RequestTimestamp: time.Now().UnixNano(),
+			Signature:        []byte("signature")}})
 	if err != nil {
 		t.Errorf("RegistrationMessage: Error received: %s", err)
 	}
@@ -101,7 +108,9 @@ func TestComms_UnregisterToken(t *testing.T) {
 	}
 
 	// Unregister client with notification bot
-	_, err = c.UnregisterToken(host, &pb.UnregisterTokenRequest{})
+	_, err = c.UnregisterToken(host, &pb.UnregisterTokenRequest{
+		RequestTimestamp: time.Now().UnixNano(),
+		TokenSignature:   []byte("signature")})
 	if err != nil {
 		t.Errorf("RegistrationMessage: Error received: %s", err)
 	}
@@ -134,8 +143,221 @@ func TestComms_UnregisterTrackedID(t *testing.T) {
 	}
 
 	// Unregister client with notification bot
-	_, err = c.UnregisterTrackedID(host, &pb.UnregisterTrackedIdRequest{})
+	_, err = c.UnregisterTrackedID(host, &pb.UnregisterTrackedIdRequest{
+		Request: &pb.TrackedIntermediaryIdRequest{
+			RequestTimestamp: time.Now().UnixNano(),
+			Signature:        []byte("signature")}})
 	if err != nil {
 		t.Errorf("RegistrationMessage: Error received: %s", err)
 	}
 }
+
+// testRegistrationSigner creates requests with fresh timestamps and
+// distinct placeholder signatures.
+type testRegistrationSigner struct {
+	count int
+}
+
+func (s *testRegistrationSigner) next() (int64, []byte) {
+	s.count++
+	return time.Now().UnixNano(), []byte(strconv.Itoa(s.count))
+}
+
+func (s *testRegistrationSigner) RegisterToken(app, token string) (
+	*pb.RegisterTokenRequest, error) {
+	ts, sig := s.next()
+	return &pb.RegisterTokenRequest{App: app, Token: token,
+		RequestTimestamp: ts, TokenSignature: sig}, nil
+}
+
+func (s *testRegistrationSigner) UnregisterToken(app, token string) (
+	*pb.UnregisterTokenRequest, error) {
+	ts, sig := s.next()
+	return &pb.UnregisterTokenRequest{App: app, Token: token,
+		RequestTimestamp: ts, TokenSignature: sig}, nil
+}
+
+func (s *testRegistrationSigner) RegisterTrackedIDs(ids [][]byte) (
+	*pb.RegisterTrackedIdRequest, error) {
+	ts, sig := s.next()
+	return &pb.RegisterTrackedIdRequest{
+		Request: &pb.TrackedIntermediaryIdRequest{TrackedIntermediaryID: ids,
+			RequestTimestamp: ts, Signature: sig}}, nil
+}
+
+func (s *testRegistrationSigner) UnregisterTrackedIDs(ids [][]byte) (
+	*pb.UnregisterTrackedIdRequest, error) {
+	ts, sig := s.next()
+	return &pb.UnregisterTrackedIdRequest{
+		Request: &pb.TrackedIntermediaryIdRequest{TrackedIntermediaryID: ids,
+			RequestTimestamp: ts, Signature: sig}}, nil
+}
+
+func (s *testRegistrationSigner) ListRegistrations() (
+	*pb.ListRegistrationsRequest, error) {
+	ts, sig := s.next()
+	return &pb.ListRegistrationsRequest{RequestTimestamp: ts,
+		Signature: sig}, nil
+}
+
+// Tests that DiffRegistrations lists the tokens and tracked IDs to register
+// and unregister, ignoring expiries.
+func TestDiffRegistrations(t *testing.T) {
+	desired := &pb.RegistrationList{
+		Tokens: []*pb.TokenRegistration{
+			{App: "a", Token: "1"}, {App: "b", Token: "1"}},
+		TrackedIDs: []*pb.TrackedIdRegistration{
+			{TrackedIntermediaryID: []byte("x")},
+			{TrackedIntermediaryID: []byte("y")}},
+	}
+	actual := &pb.RegistrationList{
+		Tokens: []*pb.TokenRegistration{
+			{App: "a", Token: "1", Expiry: 5}, {App: "a", Token: "2"}},
+		TrackedIDs: []*pb.TrackedIdRegistration{
+			{TrackedIntermediaryID: []byte("y"), Expiry: 5},
+			{TrackedIntermediaryID: []byte("z")}},
+	}
+
+	diff := DiffRegistrations(desired, actual)
+	if len(diff.RegisterTokens) != 1 || diff.RegisterTokens[0].App != "b" {
+		t.Errorf("Unexpected tokens to register: %v", diff.RegisterTokens)
+	}
+	if len(diff.UnregisterTokens) != 1 || diff.UnregisterTokens[0].Token != "2" {
+		t.Errorf("Unexpected tokens to unregister: %v", diff.UnregisterTokens)
+	}
+	if len(diff.RegisterTrackedIDs) != 1 ||
+		string(diff.RegisterTrackedIDs[0]) != "x" {
+		t.Errorf("Unexpected IDs to register: %q", diff.RegisterTrackedIDs)
+	}
+	if len(diff.UnregisterTrackedIDs) != 1 ||
+		string(diff.UnregisterTrackedIDs[0]) != "z" {
+		t.Errorf("Unexpected IDs to unregister: %q", diff.UnregisterTrackedIDs)
+	}
+
+	diff = DiffRegistrations(desired, desired)
+	if len(diff.RegisterTokens)+len(diff.UnregisterTokens)+
+		len(diff.RegisterTrackedIDs)+len(diff.UnregisterTrackedIDs) != 0 {
+		t.Errorf("Identical registrations produced changes: %+v", diff)
+	}
+}
+
+// Tests that ReconcileRegistrations brings the registrations held by the
+// notification bot to the desired registrations.
+func TestComms_ReconcileRegistrations(t *testing.T) {
+	testId := id.NewIdFromString("test", id.Generic, t)
+	clientId := id.NewIdFromString("client", id.Generic, t)
+
+	// The notification bot starts with a stale token and tracked ID
+	tokens := map[string]string{"stale": "a"}
+	trackedIDs := map[string]bool{"stale": true}
+	impl := notificationBot.NewImplementation()
+	impl.Functions.RegisterToken = func(msg *pb.RegisterTokenRequest) error {
+		tokens[msg.Token] = msg.App
+		return nil
+	}
+	impl.Functions.UnregisterToken = func(msg *pb.UnregisterTokenRequest) error {
+		delete(tokens, msg.Token)
+		return nil
+	}
+	impl.Functions.RegisterTrackedID = func(msg *pb.RegisterTrackedIdRequest) error {
+		for _, tid := range msg.Request.TrackedIntermediaryID {
+			trackedIDs[string(tid)] = true
+		}
+		return nil
+	}
+	impl.Functions.UnregisterTrackedID = func(msg *pb.UnregisterTrackedIdRequest) error {
+		for _, tid := range msg.Request.TrackedIntermediaryID {
+			delete(trackedIDs, string(tid))
+		}
+		return nil
+	}
+	impl.Functions.ListRegistrations = func(
+		*pb.ListRegistrationsRequest) (*pb.RegistrationList, error) {
+		list := &pb.RegistrationList{}
+		for token, app := range tokens {
+			list.Tokens = append(list.Tokens,
+				&pb.TokenRegistration{App: app, Token: token})
+		}
+		for tid := range trackedIDs {
+			list.TrackedIDs = append(list.TrackedIDs,
+				&pb.TrackedIdRegistration{TrackedIntermediaryID: []byte(tid)})
+		}
+		return list, nil
+	}
+
+	nbAddress := getNextAddress()
+	nb := notificationBot.StartNotificationBot(testId, nbAddress, impl, nil, nil)
+	defer nb.Shutdown()
+
+	c, err := NewClientComms(clientId, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Can't create client comms: %+v", err)
+	}
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := connect.NewManagerTesting(t).AddHost(testId, nbAddress, nil,
+		params)
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+
+	desired := &pb.RegistrationList{
+		Tokens: []*pb.TokenRegistration{{App: "a", Token: "fresh"}},
+		TrackedIDs: []*pb.TrackedIdRegistration{
+			{TrackedIntermediaryID: []byte("fresh")}},
+	}
+	signer := &testRegistrationSigner{}
+	if _, err = c.ReconcileRegistrations(host, desired, signer); err != nil {
+		t.Fatalf("Unable to reconcile registrations: %+v", err)
+	}
+	if len(tokens) != 1 || tokens["fresh"] != "a" {
+		t.Errorf("Tokens were not reconciled: %v", tokens)
+	}
+	if len(trackedIDs) != 1 || !trackedIDs["fresh"] {
+		t.Errorf("Tracked IDs were not reconciled: %v", trackedIDs)
+	}
+
+	diff, err := c.ReconcileRegistrations(host, desired, signer)
+	if err != nil {
+		t.Fatalf("Unable to reconcile registrations again: %+v", err)
+	}
+	if len(diff.RegisterTokens)+len(diff.UnregisterTokens)+
+		len(diff.RegisterTrackedIDs)+len(diff.UnregisterTrackedIDs) != 0 {
+		t.Errorf("Reconciled registrations produced changes: %+v", diff)
+	}
+}
+
+// Smoke test for RefreshRegistration
+func TestComms_RefreshRegistration(t *testing.T) {
+	testId := id.NewIdFromString("test", id.Generic, t)
+	clientId := id.NewIdFromString("client", id.Generic, t)
+
+	// Start notification bot
+	nbAddress := getNextAddress()
+	nb := notificationBot.StartNotificationBot(testId, nbAddress,
+		notificationBot.NewImplementation(), nil, nil)
+	defer nb.Shutdown()
+
+	// Create client's comms object
+	c, err := NewClientComms(clientId, nil, nil, nil)
+	if err != nil {
+		t.Errorf("Can't create client comms: %+v", err)
+	}
+	manager := connect.NewManagerTesting(t)
+
+	// Add notification bot to comm's manager
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(testId, nbAddress, nil, params)
+	if err != nil {
+		t.Errorf("Unable to call NewHost: %+v", err)
+	}
+
+	// Refresh the client's registrations with notification bot
+	_, err = c.RefreshRegistration(host, &pb.RefreshRegistrationRequest{
+		RequestTimestamp: time.Now().UnixNano(),
+		Signature:        []byte("signature")})
+	if err != nil {
+		t.Errorf("RefreshRegistration: Error received: %s", err)
+	}
+}
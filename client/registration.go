@@ -19,6 +19,7 @@ import (
 	"github.com/pkg/errors"
 	jww "github.com/spf13/jwalterweatherman"
 	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/network"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/primitives/id"
 	"gitlab.com/xx_network/primitives/ndf"
@@ -63,6 +64,88 @@ func (c *Comms) SendRegistrationMessage(host *connect.Host,
 	return result, ptypes.UnmarshalAny(resultMsg, result)
 }
 
+// Client -> Registration Send Function
+func (c *Comms) SendRegistrationChallengeRequest(host *connect.Host) (
+	*pb.RegistrationChallenge, error) {
+
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContext()
+		defer cancel()
+
+		// Send the message
+		var resultMsg = &pb.RegistrationChallenge{}
+		var err error
+		if conn.IsWeb() {
+			wc := conn.GetWebConn()
+			err = wc.Invoke(ctx,
+				"/mixmessages.ClientRegistrar/RequestRegistrationChallenge",
+				&pb.RegistrationChallengeRequest{}, resultMsg)
+		} else {
+			resultMsg, err = pb.NewClientRegistrarClient(conn.GetGrpcConn()).
+				RequestRegistrationChallenge(ctx,
+					&pb.RegistrationChallengeRequest{})
+		}
+		if err != nil {
+			return nil, err
+		}
+		return ptypes.MarshalAny(resultMsg)
+	}
+
+	// Execute the Send function
+	jww.TRACE.Printf("Sending Registration Challenge request")
+	resultMsg, err := c.Send(host, f)
+	if err != nil {
+		return nil, err
+	}
+
+	// Marshall the result
+	result := &pb.RegistrationChallenge{}
+	return result, ptypes.UnmarshalAny(resultMsg, result)
+}
+
+// Client -> Registration Send Function
+func (c *Comms) SendRegistrationBatch(host *connect.Host,
+	message *pb.ClientRegistrationBatch) (
+	*pb.SignedClientRegistrationConfirmationsBatch, error) {
+
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContext()
+		defer cancel()
+
+		// Send the message
+		var resultMsg = &pb.SignedClientRegistrationConfirmationsBatch{}
+		var err error
+		if conn.IsWeb() {
+			wc := conn.GetWebConn()
+			err = wc.Invoke(ctx, "/mixmessages.ClientRegistrar/RegisterUserBatch",
+				message, resultMsg)
+		} else {
+			resultMsg, err = pb.NewClientRegistrarClient(conn.GetGrpcConn()).
+				RegisterUserBatch(ctx, message)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return ptypes.MarshalAny(resultMsg)
+	}
+
+	// Execute the Send function
+	jww.TRACE.Printf("Sending Registration batch of %d",
+		len(message.GetRegistrations()))
+	resultMsg, err := c.Send(host, f)
+	if err != nil {
+		return nil, err
+	}
+
+	// Marshall the result
+	result := &pb.SignedClientRegistrationConfirmationsBatch{}
+	return result, ptypes.UnmarshalAny(resultMsg, result)
+}
+
 // RequestNdf is used to get an NDF from permissioning. It is only used by UDB
 // when starting or by client in testing. Other than those two uses, this
 // function should never be used as clients.
@@ -155,3 +238,12 @@ func (c *Comms) RetrieveNdf(currentDef *ndf.NetworkDefinition) (*ndf.NetworkDefi
 	}
 	return updatedNdf, nil
 }
+
+// NewNdfWatcher returns a watcher keeping the NDF current by streaming each new
+// NDF from the permissioning host, starting from the current NDF, which may be
+// nil. Call Start on the watcher to begin watching.
+func (c *Comms) NewNdfWatcher(host *connect.Host,
+	current *ndf.NetworkDefinition, params network.NdfWatcherParams) (
+	*network.NdfWatcher, error) {
+	return network.NewNdfWatcher(c.ProtoComms, host, current, params)
+}
@@ -231,6 +231,78 @@ func (c *Comms) SendChannelLeaseRequest(host *connect.Host, message *pb.ChannelL
 
 }
 
+// Client -> User Discovery channel lease renewal
+func (c *Comms) SendRenewChannelLease(host *connect.Host, message *pb.ChannelLeaseRenewal) (*pb.ChannelLeaseResponse, error) {
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContext()
+		defer cancel()
+
+		// Send the message
+		var resultMsg = &pb.ChannelLeaseResponse{}
+		var err error
+		if conn.IsWeb() {
+			wc := conn.GetWebConn()
+			err = wc.Invoke(
+				ctx, "/mixmessages.UDB/RenewChannelLease", message, resultMsg)
+		} else {
+			resultMsg, err = pb.NewUDBClient(conn.GetGrpcConn()).
+				RenewChannelLease(ctx, message)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return ptypes.MarshalAny(resultMsg)
+	}
+
+	jww.TRACE.Printf("Sending Channel Lease Renewal message: %+v", message)
+	resultMsg, err := c.Send(host, f)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &pb.ChannelLeaseResponse{}
+
+	return result, ptypes.UnmarshalAny(resultMsg, result)
+}
+
+// Client -> User Discovery channel lease revocation
+func (c *Comms) SendRevokeChannelLease(host *connect.Host, message *pb.ChannelLeaseRevocation) (*messages.Ack, error) {
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContext()
+		defer cancel()
+
+		// Send the message
+		var resultMsg = &messages.Ack{}
+		var err error
+		if conn.IsWeb() {
+			wc := conn.GetWebConn()
+			err = wc.Invoke(
+				ctx, "/mixmessages.UDB/RevokeChannelLease", message, resultMsg)
+		} else {
+			resultMsg, err = pb.NewUDBClient(conn.GetGrpcConn()).
+				RevokeChannelLease(ctx, message)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return ptypes.MarshalAny(resultMsg)
+	}
+
+	jww.TRACE.Printf("Sending Channel Lease Revocation message: %+v", message)
+	resultMsg, err := c.Send(host, f)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &messages.Ack{}
+
+	return result, ptypes.UnmarshalAny(resultMsg, result)
+}
+
 // SendUsernameValidation is a client to User Discovery send function. This will send a
 // mixmessages.UsernameValidationRequest to UD, which UD will validate and send back a
 // mixmessages.UsernameValidation.
@@ -262,3 +334,114 @@ func (c *Comms) SendUsernameValidation(host *connect.Host,
 	response := &pb.UsernameValidation{}
 	return response, ptypes.UnmarshalAny(responseMessage, response)
 }
+
+// Client -> User Discovery Register Facts Function
+func (c *Comms) SendRegisterFacts(host *connect.Host, message *pb.FactRegisterBatch) (*pb.FactRegisterBatchResponse, error) {
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContext()
+		defer cancel()
+
+		// Send the message
+		var resultMsg = &pb.FactRegisterBatchResponse{}
+		var err error
+		if conn.IsWeb() {
+			wc := conn.GetWebConn()
+			err = wc.Invoke(
+				ctx, "/mixmessages.UDB/RegisterFacts", message, resultMsg)
+		} else {
+			resultMsg, err = pb.NewUDBClient(conn.GetGrpcConn()).
+				RegisterFacts(ctx, message)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return ptypes.MarshalAny(resultMsg)
+	}
+
+	// Execute the Send function
+	jww.TRACE.Printf("Sending Register Facts message: %+v", message)
+	resultMsg, err := c.Send(host, f)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &pb.FactRegisterBatchResponse{}
+
+	return result, ptypes.UnmarshalAny(resultMsg, result)
+}
+
+// Client -> User Discovery Confirm Facts Function
+func (c *Comms) SendConfirmFacts(host *connect.Host, message *pb.FactConfirmBatch) (*pb.FactConfirmBatchResponse, error) {
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContext()
+		defer cancel()
+
+		// Send the message
+		var resultMsg = &pb.FactConfirmBatchResponse{}
+		var err error
+		if conn.IsWeb() {
+			wc := conn.GetWebConn()
+			err = wc.Invoke(
+				ctx, "/mixmessages.UDB/ConfirmFacts", message, resultMsg)
+		} else {
+			resultMsg, err = pb.NewUDBClient(conn.GetGrpcConn()).
+				ConfirmFacts(ctx, message)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return ptypes.MarshalAny(resultMsg)
+	}
+
+	// Execute the Send function
+	jww.TRACE.Printf("Sending Confirm Facts message: %+v", message)
+	resultMsg, err := c.Send(host, f)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &pb.FactConfirmBatchResponse{}
+
+	return result, ptypes.UnmarshalAny(resultMsg, result)
+}
+
+// Client -> User Discovery List Facts Function
+func (c *Comms) SendListFacts(host *connect.Host, message *pb.FactListRequest) (*pb.FactListResponse, error) {
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContext()
+		defer cancel()
+
+		// Send the message
+		var resultMsg = &pb.FactListResponse{}
+		var err error
+		if conn.IsWeb() {
+			wc := conn.GetWebConn()
+			err = wc.Invoke(
+				ctx, "/mixmessages.UDB/ListFacts", message, resultMsg)
+		} else {
+			resultMsg, err = pb.NewUDBClient(conn.GetGrpcConn()).
+				ListFacts(ctx, message)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return ptypes.MarshalAny(resultMsg)
+	}
+
+	// Execute the Send function
+	jww.TRACE.Printf("Sending List Facts message: %+v", message)
+	resultMsg, err := c.Send(host, f)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &pb.FactListResponse{}
+
+	return result, ptypes.UnmarshalAny(resultMsg, result)
+}
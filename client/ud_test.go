@@ -8,11 +8,15 @@
 package client
 
 import (
+	"crypto/rand"
 	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/testkeys"
 	"gitlab.com/elixxir/comms/udb"
 	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/crypto/signature/rsa"
 	"gitlab.com/xx_network/primitives/id"
 	"testing"
+	"time"
 )
 
 // Smoke test SendRegisterUser
@@ -155,3 +159,56 @@ func TestComms_SendRemoveUser(t *testing.T) {
 		}
 	}
 }
+
+// Smoke test SendRegisterFacts, SendConfirmFacts and SendListFacts
+func TestComms_SendFactBatches(t *testing.T) {
+	udAddr := getNextAddress()
+	key, err := rsa.LoadPrivateKeyFromPem(testkeys.GetNodeKey())
+	if err != nil {
+		t.Fatalf("Unable to load key: %+v", err)
+	}
+	impl := udb.NewImplementation()
+	impl.Functions.GetUserKey = func([]byte) (*rsa.PublicKey, error) {
+		return key.GetPublic(), nil
+	}
+	ud := udb.StartServer(&id.UDB, udAddr, impl, nil, nil)
+	defer ud.Shutdown()
+
+	c, err := NewClientComms(&id.DummyUser, nil, nil, nil)
+	if err != nil {
+		t.Error(err)
+	}
+	manager := connect.NewManagerTesting(t)
+
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(&id.UDB, udAddr, nil, params)
+	if err != nil {
+		t.Errorf("Unable to call NewHost: %+v", err)
+	}
+
+	registered, err := c.SendRegisterFacts(host, &pb.FactRegisterBatch{
+		Requests: []*pb.FactRegisterRequest{{}, {}}})
+	if err != nil {
+		t.Errorf("RegisterFacts: Error received: %+v", err)
+	} else if len(registered.Results) != 2 {
+		t.Errorf("RegisterFacts: Received %d results, expected 2",
+			len(registered.Results))
+	}
+
+	_, err = c.SendConfirmFacts(host, &pb.FactConfirmBatch{
+		Requests: []*pb.FactConfirmRequest{{}}})
+	if err != nil {
+		t.Errorf("ConfirmFacts: Error received: %+v", err)
+	}
+
+	request := &pb.FactListRequest{
+		UID: id.DummyUser.Marshal(), Timestamp: time.Now().UnixNano()}
+	if err = request.Sign(rand.Reader, key); err != nil {
+		t.Fatalf("Unable to sign request: %+v", err)
+	}
+	_, err = c.SendListFacts(host, request)
+	if err != nil {
+		t.Errorf("ListFacts: Error received: %+v", err)
+	}
+}
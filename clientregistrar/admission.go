@@ -0,0 +1,287 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the admission controls a registration must pass before it reaches
+// the handler
+
+package clientregistrar
+
+import (
+	"container/list"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrRateLimited is returned when the caller's address has made too many
+	// registrations.
+	ErrRateLimited = status.Error(codes.ResourceExhausted,
+		"too many registrations from this address")
+
+	// ErrInvalidSolution is returned for registrations without a valid
+	// solution to an unexpired, unused proof-of-work challenge.
+	ErrInvalidSolution = status.Error(codes.PermissionDenied,
+		"proof-of-work solution is missing, invalid, expired or already used")
+
+	// ErrInvalidCode is returned for registrations whose registration code
+	// was not issued, has expired or has already been used.
+	ErrInvalidCode = status.Error(codes.PermissionDenied,
+		"registration code is invalid, expired or already used")
+
+	// ErrBatchTooLarge is returned for batches of more registrations than
+	// allowed.
+	ErrBatchTooLarge = status.Error(codes.InvalidArgument,
+		"too many registrations in batch")
+)
+
+// Admission holds the controls applied to registrations before they reach the
+// handler. Controls left nil, or zero, are disabled.
+type Admission struct {
+	// Requires a solved proof-of-work challenge with each registration
+	ProofOfWork *ProofOfWork
+
+	// Limits the registrations from each caller address
+	Limiter *IPLimiter
+
+	// Redeems the registration code of each registration, making it single
+	// use. Codes are reserved before the registration reaches the handler
+	// and only used once the handler accepts it.
+	Codes CodeStore
+
+	// Maximum number of registrations in a batch
+	MaxBatchSize int
+}
+
+// SetAdmission replaces the admission controls of the server.
+func (r *Comms) SetAdmission(admission Admission) {
+	r.admissionMux.Lock()
+	defer r.admissionMux.Unlock()
+	r.admission = admission
+}
+
+// getAdmission returns the admission controls of the server.
+func (r *Comms) getAdmission() Admission {
+	r.admissionMux.RLock()
+	defer r.admissionMux.RUnlock()
+	return r.admission
+}
+
+// limit charges n registrations to the address of the caller.
+func (a Admission) limit(ctx context.Context, n int) error {
+	if a.Limiter == nil {
+		return nil
+	}
+	ip, _, err := connect.GetAddressFromContext(ctx)
+	if err != nil {
+		return errors.WithMessage(err, "Unable to limit registrations")
+	}
+	if !a.Limiter.Allow(ip, n) {
+		jww.WARN.Printf("Rejected %d registrations from %s: rate limited", n, ip)
+		return ErrRateLimited
+	}
+	return nil
+}
+
+// admit checks the proof of work and reserves the registration code of the
+// registration. The returned function must be called with the error returned
+// by handling the registration, so the code is only used if it succeeded.
+func (a Admission) admit(msg *pb.ClientRegistration) (
+	done func(err error), err error) {
+	if a.ProofOfWork != nil {
+		if err = a.ProofOfWork.Check(msg); err != nil {
+			return nil, err
+		}
+	}
+	if a.Codes == nil {
+		return func(error) {}, nil
+	}
+	done, err = a.Codes.Reserve(msg.GetRegistrationCode())
+	if err != nil {
+		jww.WARN.Printf("Rejected registration code: %+v", err)
+		return nil, ErrInvalidCode
+	}
+	return done, nil
+}
+
+// nonceLength is the length of a challenge nonce; an expiry, random bytes and
+// a MAC over both.
+const nonceLength = 8 + 16 + sha256.Size
+
+// ProofOfWork issues proof-of-work challenges and checks their solutions.
+// Challenges are authenticated by the server rather than stored, so only the
+// nonces of solved challenges are remembered, until they expire.
+type ProofOfWork struct {
+	difficulty uint32
+	lifetime   time.Duration
+	key        []byte
+
+	mux  sync.Mutex
+	used map[string]*list.Element
+
+	// Used nonces in the order they were used, which is close to the order
+	// they expire in as every challenge has the same lifetime
+	order list.List
+}
+
+// usedNonce is a remembered nonce.
+type usedNonce struct {
+	nonce  string
+	expiry time.Time
+}
+
+// NewProofOfWork returns a ProofOfWork issuing challenges which require
+// difficulty leading zero bits and expire after the lifetime.
+func NewProofOfWork(difficulty uint32, lifetime time.Duration) (
+	*ProofOfWork, error) {
+	key := make([]byte, sha256.Size)
+	if _, err := rand.Read(key); err != nil {
+		return nil, errors.Wrap(err, "Unable to generate challenge key")
+	}
+	return &ProofOfWork{
+		difficulty: difficulty,
+		lifetime:   lifetime,
+		key:        key,
+		used:       make(map[string]*list.Element),
+	}, nil
+}
+
+// Challenge returns a new challenge.
+func (p *ProofOfWork) Challenge() (*pb.RegistrationChallenge, error) {
+	expiry := time.Now().Add(p.lifetime)
+	nonce := make([]byte, 8+16, nonceLength)
+	binary.BigEndian.PutUint64(nonce, uint64(expiry.UnixNano()))
+	if _, err := rand.Read(nonce[8:]); err != nil {
+		return nil, errors.Wrap(err, "Unable to generate challenge nonce")
+	}
+	return &pb.RegistrationChallenge{
+		Nonce:      append(nonce, p.mac(nonce)...),
+		Difficulty: p.difficulty,
+		Expiry:     expiry.UnixNano(),
+	}, nil
+}
+
+// Check returns ErrInvalidSolution unless the registration solves an
+// unexpired challenge issued by p whose nonce has not been used before.
+func (p *ProofOfWork) Check(msg *pb.ClientRegistration) error {
+	nonce := msg.GetChallengeNonce()
+	if len(nonce) != nonceLength ||
+		!hmac.Equal(nonce[8+16:], p.mac(nonce[:8+16])) {
+		return ErrInvalidSolution
+	}
+	now := time.Now()
+	expiry := time.Unix(0, int64(binary.BigEndian.Uint64(nonce)))
+	if !now.Before(expiry) {
+		return ErrInvalidSolution
+	}
+
+	challenge := &pb.RegistrationChallenge{
+		Nonce:      nonce,
+		Difficulty: p.difficulty,
+	}
+	if !challenge.Verify(msg.GetClientTransmissionRSAPubKey(),
+		msg.GetChallengeSolution()) {
+		return ErrInvalidSolution
+	}
+
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	p.forgetExpired(now)
+	if _, ok := p.used[string(nonce)]; ok {
+		return ErrInvalidSolution
+	}
+	p.used[string(nonce)] = p.order.PushBack(
+		&usedNonce{nonce: string(nonce), expiry: expiry})
+	return nil
+}
+
+// forgetExpired removes the oldest used nonces once they have expired. A nonce
+// used after one expiring later is kept until that one expires, which is
+// harmless as expired nonces are rejected before being looked up. The lock
+// must be held.
+func (p *ProofOfWork) forgetExpired(now time.Time) {
+	for e := p.order.Front(); e != nil; e = p.order.Front() {
+		used := e.Value.(*usedNonce)
+		if now.Before(used.expiry) {
+			return
+		}
+		p.order.Remove(e)
+		delete(p.used, used.nonce)
+	}
+}
+
+// mac authenticates the start of a nonce.
+func (p *ProofOfWork) mac(b []byte) []byte {
+	h := hmac.New(sha256.New, p.key)
+	h.Write(b)
+	return h.Sum(nil)
+}
+
+// IPLimiter limits the number of registrations from each address within a
+// fixed window.
+type IPLimiter struct {
+	limit  int
+	window time.Duration
+
+	mux       sync.Mutex
+	windows   map[string]*ipWindow
+	lastSweep time.Time
+}
+
+// ipWindow counts the registrations from an address in the current window.
+type ipWindow struct {
+	start time.Time
+	count int
+}
+
+// NewIPLimiter returns an IPLimiter allowing limit registrations from each
+// address per window.
+func NewIPLimiter(limit int, window time.Duration) *IPLimiter {
+	return &IPLimiter{
+		limit:   limit,
+		window:  window,
+		windows: make(map[string]*ipWindow),
+	}
+}
+
+// Allow returns true and counts the n registrations from the address if they
+// are within its limit.
+func (l *IPLimiter) Allow(ip string, n int) bool {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	now := time.Now()
+	if now.Sub(l.lastSweep) >= l.window {
+		for addr, w := range l.windows {
+			if now.Sub(w.start) >= l.window {
+				delete(l.windows, addr)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	w, ok := l.windows[ip]
+	if !ok || now.Sub(w.start) >= l.window {
+		w = &ipWindow{start: now}
+		l.windows[ip] = w
+	}
+	if w.count+n > l.limit {
+		return false
+	}
+	w.count += n
+	return true
+}
@@ -0,0 +1,260 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package clientregistrar
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gitlab.com/elixxir/comms/client"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/testkeys"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc/status"
+)
+
+// solvedRegistration returns a registration for the key solving a new
+// challenge from p.
+func solvedRegistration(p *ProofOfWork, key string,
+	t *testing.T) *pb.ClientRegistration {
+	challenge, err := p.Challenge()
+	if err != nil {
+		t.Fatalf("Unable to get challenge: %+v", err)
+	}
+	return &pb.ClientRegistration{
+		ClientTransmissionRSAPubKey: key,
+		ChallengeNonce:              challenge.Nonce,
+		ChallengeSolution:           challenge.Solve(key),
+	}
+}
+
+// Tests that ProofOfWork.Check accepts each solved challenge once, and
+// rejects challenges which are forged, expired or solved for another key.
+func TestProofOfWork_Check(t *testing.T) {
+	p, err := NewProofOfWork(8, time.Minute)
+	if err != nil {
+		t.Fatalf("Unable to create proof of work: %+v", err)
+	}
+
+	msg := solvedRegistration(p, "key", t)
+	if err = p.Check(msg); err != nil {
+		t.Errorf("Solved challenge was rejected: %+v", err)
+	}
+	if err = p.Check(msg); err != ErrInvalidSolution {
+		t.Errorf("Reused challenge was not rejected: %v", err)
+	}
+
+	msg = solvedRegistration(p, "key", t)
+	msg.ClientTransmissionRSAPubKey = "other key"
+	msg.ChallengeSolution = nil
+	if err = p.Check(msg); err != ErrInvalidSolution {
+		t.Errorf("Unsolved challenge was not rejected: %v", err)
+	}
+
+	msg = solvedRegistration(p, "key", t)
+	msg.ChallengeNonce[0] ^= 1
+	if err = p.Check(msg); err != ErrInvalidSolution {
+		t.Errorf("Forged challenge was not rejected: %v", err)
+	}
+
+	p.lifetime = 0
+	if err = p.Check(solvedRegistration(p, "key", t)); err != ErrInvalidSolution {
+		t.Errorf("Expired challenge was not rejected: %v", err)
+	}
+
+	// Used nonces are forgotten once they expire
+	p.order.Front().Value.(*usedNonce).expiry = time.Now()
+	p.lifetime = time.Minute
+	if err = p.Check(solvedRegistration(p, "key", t)); err != nil {
+		t.Errorf("Solved challenge was rejected: %+v", err)
+	}
+	if len(p.used) != 1 || p.order.Len() != 1 {
+		t.Errorf("Expired nonce was not forgotten: %d remembered",
+			len(p.used))
+	}
+}
+
+// Tests that IPLimiter.Allow counts registrations per address and window.
+func TestIPLimiter_Allow(t *testing.T) {
+	l := NewIPLimiter(3, time.Minute)
+
+	if !l.Allow("a", 2) || !l.Allow("a", 1) {
+		t.Errorf("Registrations within the limit were not allowed")
+	}
+	if l.Allow("a", 1) {
+		t.Errorf("Registration over the limit was allowed")
+	}
+	if !l.Allow("b", 3) {
+		t.Errorf("Registrations from another address were not allowed")
+	}
+	if l.Allow("c", 4) {
+		t.Errorf("Batch over the limit was allowed")
+	}
+
+	l.windows["a"].start = time.Now().Add(-time.Minute)
+	if !l.Allow("a", 1) {
+		t.Errorf("Registration in a new window was not allowed")
+	}
+}
+
+// Tests that MemoryCodeStore reserves each code for one registration at a
+// time before it expires, using it only once the registration succeeds.
+func TestMemoryCodeStore_Reserve(t *testing.T) {
+	s := NewMemoryCodeStore()
+	codes, err := s.Generate(2, time.Minute)
+	if err != nil {
+		t.Fatalf("Unable to generate codes: %+v", err)
+	}
+	s.Issue("expired", time.Now())
+
+	done, err := s.Reserve(codes[0])
+	if err != nil {
+		t.Fatalf("Code was not reserved: %+v", err)
+	}
+	if _, err = s.Reserve(codes[0]); err == nil {
+		t.Errorf("Reserved code was reserved again")
+	}
+
+	// A failed registration releases the code
+	done(errors.New("handler failed"))
+	done, err = s.Reserve(codes[0])
+	if err != nil {
+		t.Fatalf("Released code was not reserved: %+v", err)
+	}
+	done(nil)
+	if _, err = s.Reserve(codes[0]); err == nil {
+		t.Errorf("Used code was reserved")
+	}
+
+	s.Revoke(codes[1])
+	if _, err = s.Reserve(codes[1]); err == nil {
+		t.Errorf("Revoked code was reserved")
+	}
+	if _, err = s.Reserve("expired"); err == nil {
+		t.Errorf("Expired code was reserved")
+	}
+	if _, err = s.Reserve("unknown"); err == nil {
+		t.Errorf("Unknown code was reserved")
+	}
+}
+
+// Tests that a registration code is not used up by a registration the
+// handler fails.
+func TestComms_RegisterUser_HandlerFailure(t *testing.T) {
+	impl := NewImplementation()
+	fail := true
+	impl.Functions.RegisterUser = func(*pb.ClientRegistration) (
+		*pb.SignedClientRegistrationConfirmations, error) {
+		if fail {
+			return nil, errors.New("transient failure")
+		}
+		return &pb.SignedClientRegistrationConfirmations{}, nil
+	}
+	codes := NewMemoryCodeStore()
+	codes.Issue("a", time.Now().Add(time.Minute))
+	comms := &Comms{handler: impl}
+	comms.SetAdmission(Admission{Codes: codes})
+
+	msg := &pb.ClientRegistration{RegistrationCode: "a"}
+	if _, err := comms.RegisterUser(context.Background(), msg); err == nil {
+		t.Errorf("Handler failure was not returned")
+	}
+
+	fail = false
+	if _, err := comms.RegisterUser(context.Background(), msg); err != nil {
+		t.Errorf("Code of a failed registration was used up: %+v", err)
+	}
+	_, err := comms.RegisterUser(context.Background(), msg)
+	if err != ErrInvalidCode {
+		t.Errorf("Reused code was not rejected: %v", err)
+	}
+}
+
+// Tests that registrations, alone and in batches, must pass the admission
+// controls of the server.
+func TestComms_RegisterUser_Admission(t *testing.T) {
+	regAddress := getNextServerAddress()
+	keyData := testkeys.LoadFromPath(testkeys.GetNodeKeyPath())
+	certData := testkeys.LoadFromPath(testkeys.GetNodeCertPath())
+	testId := id.NewIdFromString("test", id.Generic, t)
+	rg := StartClientRegistrarServer(testId, regAddress,
+		NewImplementation(), certData, keyData)
+	defer rg.Shutdown()
+
+	pow, err := NewProofOfWork(8, time.Minute)
+	if err != nil {
+		t.Fatalf("Unable to create proof of work: %+v", err)
+	}
+	codes := NewMemoryCodeStore()
+	codes.Issue("a", time.Now().Add(time.Minute))
+	codes.Issue("b", time.Now().Add(time.Minute))
+	rg.SetAdmission(Admission{
+		ProofOfWork:  pow,
+		Limiter:      NewIPLimiter(4, time.Minute),
+		Codes:        codes,
+		MaxBatchSize: 2,
+	})
+
+	var c client.Comms
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := connect.NewManagerTesting(t).AddHost(testId, regAddress,
+		certData, params)
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+
+	register := func(code string) *pb.ClientRegistration {
+		challenge, err := c.SendRegistrationChallengeRequest(host)
+		if err != nil {
+			t.Fatalf("Unable to get challenge: %+v", err)
+		}
+		return &pb.ClientRegistration{
+			RegistrationCode:            code,
+			ClientTransmissionRSAPubKey: "key",
+			ChallengeNonce:              challenge.Nonce,
+			ChallengeSolution:           challenge.Solve("key"),
+		}
+	}
+
+	if _, err = c.SendRegistrationMessage(host, register("a")); err != nil {
+		t.Errorf("Admitted registration failed: %+v", err)
+	}
+	_, err = c.SendRegistrationMessage(host, register("a"))
+	if status.Code(err) != status.Code(ErrInvalidCode) {
+		t.Errorf("Reused code was not rejected: %v", err)
+	}
+
+	_, err = c.SendRegistrationBatch(host, &pb.ClientRegistrationBatch{
+		Registrations: []*pb.ClientRegistration{
+			register("b"), register("b"), register("c")},
+	})
+	if status.Code(err) != status.Code(ErrBatchTooLarge) {
+		t.Errorf("Oversized batch was not rejected: %v", err)
+	}
+
+	batch, err := c.SendRegistrationBatch(host, &pb.ClientRegistrationBatch{
+		Registrations: []*pb.ClientRegistration{register("b"), {}},
+	})
+	if err != nil {
+		t.Fatalf("Batch failed: %+v", err)
+	}
+	if len(batch.Confirmations) != 2 || batch.Confirmations[0].Error != "" ||
+		batch.Confirmations[1].Error == "" {
+		t.Errorf("Unexpected batch confirmations: %+v", batch.Confirmations)
+	}
+
+	// Four registrations have been counted against the address
+	_, err = c.SendRegistrationMessage(host, register("c"))
+	if status.Code(err) != status.Code(ErrRateLimited) {
+		t.Errorf("Registration over the limit was not rejected: %v", err)
+	}
+}
@@ -0,0 +1,103 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the store of single-use, expiring registration codes
+
+package clientregistrar
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"github.com/pkg/errors"
+	"sync"
+	"time"
+)
+
+// CodeStore redeems registration codes.
+type CodeStore interface {
+	// Reserve claims the code for a registration being handled. It returns
+	// an error if the code was never issued, has expired, has already been
+	// used or is reserved. Otherwise, the returned function must be called
+	// with the error returned by handling the registration: the code is used
+	// if that error is nil, and released to be reserved again if not.
+	Reserve(code string) (done func(err error), err error)
+}
+
+// MemoryCodeStore is a CodeStore holding the codes it issues in memory.
+type MemoryCodeStore struct {
+	mux      sync.Mutex
+	codes    map[string]time.Time
+	reserved map[string]struct{}
+}
+
+// NewMemoryCodeStore returns an empty MemoryCodeStore.
+func NewMemoryCodeStore() *MemoryCodeStore {
+	return &MemoryCodeStore{
+		codes:    make(map[string]time.Time),
+		reserved: make(map[string]struct{}),
+	}
+}
+
+// Issue adds a code which can be redeemed once before the expiry.
+func (s *MemoryCodeStore) Issue(code string, expiry time.Time) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.codes[code] = expiry
+}
+
+// Generate issues n random codes which expire after the lifetime.
+func (s *MemoryCodeStore) Generate(n int, lifetime time.Duration) (
+	[]string, error) {
+	expiry := time.Now().Add(lifetime)
+	codes := make([]string, n)
+	for i := range codes {
+		b := make([]byte, 10)
+		if _, err := rand.Read(b); err != nil {
+			return nil, errors.Wrap(err, "Unable to generate code")
+		}
+		codes[i] = base32.StdEncoding.EncodeToString(b)
+		s.Issue(codes[i], expiry)
+	}
+	return codes, nil
+}
+
+// Revoke removes a code before it is redeemed.
+func (s *MemoryCodeStore) Revoke(code string) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	delete(s.codes, code)
+}
+
+// Reserve claims the code for a registration being handled, removing it from
+// the store once the registration succeeds.
+func (s *MemoryCodeStore) Reserve(code string) (func(err error), error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	expiry, ok := s.codes[code]
+	if !ok {
+		return nil, errors.Errorf("Code %q was not issued or has already "+
+			"been used", code)
+	}
+	if !time.Now().Before(expiry) {
+		delete(s.codes, code)
+		return nil, errors.Errorf("Code %q expired at %s", code, expiry)
+	}
+	if _, ok = s.reserved[code]; ok {
+		return nil, errors.Errorf("Code %q is being redeemed", code)
+	}
+	s.reserved[code] = struct{}{}
+
+	return func(err error) {
+		s.mux.Lock()
+		defer s.mux.Unlock()
+		delete(s.reserved, code)
+		if err == nil {
+			delete(s.codes, code)
+		}
+	}, nil
+}
@@ -37,9 +37,72 @@ func (r *Comms) RequestToken(context.Context, *messages.Ping) (*messages.AssignT
 
 // RegisterUser event handler which registers a user with the platform
 func (r *Comms) RegisterUser(ctx context.Context, msg *pb.ClientRegistration) (
+	*pb.SignedClientRegistrationConfirmations, error) {
+	admission := r.getAdmission()
+	if err := admission.limit(ctx, 1); err != nil {
+		return nil, err
+	}
+	done, err := admission.admit(msg)
+	if err != nil {
+		return nil, err
+	}
+	confirmation, err := r.registerUser(msg)
+	done(err)
+	return confirmation, err
+}
+
+// RequestRegistrationChallenge returns a new proof-of-work challenge. If no
+// proof of work is required, the challenge has no difficulty.
+func (r *Comms) RequestRegistrationChallenge(context.Context,
+	*pb.RegistrationChallengeRequest) (*pb.RegistrationChallenge, error) {
+	pow := r.getAdmission().ProofOfWork
+	if pow == nil {
+		return &pb.RegistrationChallenge{}, nil
+	}
+	return pow.Challenge()
+}
+
+// RegisterUserBatch registers each user in the batch. Registrations which are
+// not admitted or fail have the error set in their confirmation.
+func (r *Comms) RegisterUserBatch(ctx context.Context,
+	msg *pb.ClientRegistrationBatch) (
+	*pb.SignedClientRegistrationConfirmationsBatch, error) {
+	admission := r.getAdmission()
+	registrations := msg.GetRegistrations()
+	if admission.MaxBatchSize > 0 &&
+		len(registrations) > admission.MaxBatchSize {
+		return nil, ErrBatchTooLarge
+	}
+	if err := admission.limit(ctx, len(registrations)); err != nil {
+		return nil, err
+	}
+
+	confirmations := make(
+		[]*pb.SignedClientRegistrationConfirmations, len(registrations))
+	for i, registration := range registrations {
+		done, err := admission.admit(registration)
+		if err != nil {
+			confirmations[i] = &pb.SignedClientRegistrationConfirmations{
+				Error: err.Error(),
+			}
+			continue
+		}
+		confirmations[i], err = r.registerUser(registration)
+		done(err)
+	}
+	return &pb.SignedClientRegistrationConfirmationsBatch{
+		Confirmations: confirmations,
+	}, nil
+}
+
+// registerUser passes an admitted registration to the handler
+func (r *Comms) registerUser(msg *pb.ClientRegistration) (
 	*pb.SignedClientRegistrationConfirmations, error) {
 	// Obtain the signed key by passing to registration server
 	confirmationMessage, err := r.handler.RegisterUser(msg)
+	if confirmationMessage == nil {
+		confirmationMessage = &pb.SignedClientRegistrationConfirmations{}
+	}
 	// Obtain the error message, if any
 	errMsg := ""
 	if err != nil {
@@ -10,10 +10,15 @@
 package clientregistrar
 
 import (
+	"context"
 	"runtime/debug"
+	"sync"
 
 	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/elixxir/comms/certificates"
+	"gitlab.com/elixxir/comms/drain"
 	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/recorder"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/comms/messages"
 	"gitlab.com/xx_network/primitives/id"
@@ -26,6 +31,12 @@ type Comms struct {
 	handler Handler
 	*pb.UnimplementedClientRegistrarServer
 	*messages.UnimplementedGenericServer
+	recorder.Tap
+	drain.Drainer
+	certificates.HttpsServer
+
+	admission    Admission
+	admissionMux sync.RWMutex
 }
 
 // Starts a new server on the address:port specified by localServer
@@ -44,13 +55,31 @@ func StartClientRegistrarServer(id *id.ID, localServer string, handler Handler,
 		ProtoComms: pc,
 		handler:    handler,
 	}
-	pb.RegisterClientRegistrarServer(clientRegistrarServer.GetServer(), &clientRegistrarServer)
+	clientRegistrarServer.GetServer().RegisterService(clientRegistrarServer.TrackService(
+		clientRegistrarServer.WrapService(&pb.ClientRegistrar_ServiceDesc)), &clientRegistrarServer)
 	messages.RegisterGenericServer(clientRegistrarServer.GetServer(), &clientRegistrarServer)
+	clientRegistrarServer.RegisterHealth(clientRegistrarServer.GetServer())
 
 	pc.ServeWithWeb()
 	return &clientRegistrarServer
 }
 
+// GracefulStop drains the calls in flight, cancelling any left once ctx is
+// done, and shuts down the server and its HTTPS listener.
+func (r *Comms) GracefulStop(ctx context.Context) error {
+	err := drain.GracefulStop(ctx, &r.Drainer, r.ProtoComms)
+	r.StopHttps()
+	return err
+}
+
+// ServeHttpsReloading serves the gRPC-web endpoints of the client registrar over
+// HTTPS on a listener of its own at the address, presenting the certificate
+// held by the reloader to each new handshake.
+func (r *Comms) ServeHttpsReloading(address string,
+	reloader *certificates.Reloader) error {
+	return r.StartHttps(r.ProtoComms, address, reloader)
+}
+
 type Handler interface {
 	RegisterUser(msg *pb.ClientRegistration) (confirmation *pb.SignedClientRegistrationConfirmations, err error)
 }
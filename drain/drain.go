@@ -0,0 +1,290 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package drain lets a comms server stop accepting new calls and wait for
+// those in flight to finish before it shuts down, reporting itself as not
+// serving through the gRPC health service while it drains.
+
+package drain
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/xx_network/comms/connect"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"sync"
+	"time"
+)
+
+// errDraining is returned to calls made while the server is draining. The
+// Unavailable code tells clients to retry against another server.
+var errDraining = status.Error(codes.Unavailable,
+	"server is draining and not accepting new calls")
+
+// DrainParams configures how a Drainer drains.
+type DrainParams struct {
+	// How long the server reports NOT_SERVING while still accepting new
+	// calls, giving load balancers time to move traffic elsewhere before
+	// calls are rejected
+	HealthGrace time.Duration
+}
+
+// DefaultDrainParams returns the default DrainParams.
+func DefaultDrainParams() DrainParams {
+	return DrainParams{
+		HealthGrace: 5 * time.Second,
+	}
+}
+
+// Drainer tracks the calls in flight on a comms server. Services are
+// registered through TrackService and the health service through
+// RegisterHealth when the server starts. Its zero value uses
+// DefaultDrainParams.
+type Drainer struct {
+	mux      sync.Mutex
+	params   *DrainParams
+	draining bool
+	calls    map[uint64]context.CancelFunc
+	nextCall uint64
+
+	// Closed once the server is draining and no calls remain
+	idle chan struct{}
+
+	health *health.Server
+}
+
+// RegisterHealth registers the gRPC health service on the server, reporting
+// it as serving, and accepts new calls again if the Drainer was drained.
+func (d *Drainer) RegisterHealth(server *grpc.Server) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	d.draining = false
+	d.health = health.NewServer()
+	healthpb.RegisterHealthServer(server, d.health)
+}
+
+// SetDrainParams replaces the parameters of the Drainer.
+func (d *Drainer) SetDrainParams(params DrainParams) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	d.params = &params
+}
+
+// GetDrainParams returns the parameters of the Drainer.
+func (d *Drainer) GetDrainParams() DrainParams {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	return d.getParams()
+}
+
+// getParams returns the parameters of the Drainer. The lock must be held.
+func (d *Drainer) getParams() DrainParams {
+	if d.params != nil {
+		return *d.params
+	}
+	return DefaultDrainParams()
+}
+
+// IsDraining returns true once Drain has begun rejecting new calls.
+func (d *Drainer) IsDraining() bool {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	return d.draining
+}
+
+// Drain reports the server as NOT_SERVING and, once the HealthGrace of its
+// parameters has passed, stops accepting new calls and waits for the calls in
+// flight to finish. If ctx is done first, the calls still in flight are
+// cancelled and an error wrapping that of ctx is returned.
+func (d *Drainer) Drain(ctx context.Context) error {
+	d.mux.Lock()
+	if d.health != nil {
+		d.health.Shutdown()
+	}
+	grace := d.getParams().HealthGrace
+	d.mux.Unlock()
+
+	// Keep serving while load balancers notice the health status
+	if grace > 0 {
+		jww.INFO.Printf("Reporting NOT_SERVING for %s before draining", grace)
+		timer := time.NewTimer(grace)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+		}
+	}
+
+	d.mux.Lock()
+	d.draining = true
+	if len(d.calls) == 0 {
+		d.mux.Unlock()
+		return nil
+	}
+	if d.idle == nil {
+		d.idle = make(chan struct{})
+	}
+	idle := d.idle
+	jww.INFO.Printf("Draining %d calls in flight", len(d.calls))
+	d.mux.Unlock()
+
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+		cancelled := d.cancelCalls()
+		return errors.WithMessagef(ctx.Err(),
+			"Cancelled %d calls still in flight after draining", cancelled)
+	}
+}
+
+// GracefulStop drains the server and then shuts it down. The deadline of ctx
+// should allow for the HealthGrace of the Drainer. Once ctx is done,
+// the calls still in flight and any streams not tracked by the Drainer, such
+// as health watches, are closed as the server stops, so ctx should have a
+// deadline.
+func GracefulStop(ctx context.Context, d *Drainer,
+	pc *connect.ProtoComms) error {
+	err := d.Drain(ctx)
+
+	server := pc.GetServer()
+	if server == nil {
+		return err
+	}
+
+	// Let the responses of the drained calls be written before stopping
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		server.Stop()
+	}
+
+	pc.Shutdown()
+	return err
+}
+
+// startCall registers a new call, returning a context which is cancelled if
+// draining times out and a function ending the call. Returns an error if the
+// server is draining.
+func (d *Drainer) startCall(ctx context.Context) (
+	context.Context, func(), error) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	if d.draining {
+		return nil, nil, errDraining
+	}
+
+	if d.calls == nil {
+		d.calls = make(map[uint64]context.CancelFunc)
+	}
+	call := d.nextCall
+	d.nextCall++
+	ctx, cancel := context.WithCancel(ctx)
+	d.calls[call] = cancel
+
+	return ctx, func() { d.endCall(call) }, nil
+}
+
+// endCall removes the call, signalling Drain once the last call ends.
+func (d *Drainer) endCall(call uint64) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	if cancel, ok := d.calls[call]; ok {
+		cancel()
+		delete(d.calls, call)
+	}
+	if d.draining && len(d.calls) == 0 && d.idle != nil {
+		close(d.idle)
+		d.idle = nil
+	}
+}
+
+// cancelCalls cancels the contexts of every call in flight and returns how
+// many there were.
+func (d *Drainer) cancelCalls() int {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	for _, cancel := range d.calls {
+		cancel()
+	}
+	return len(d.calls)
+}
+
+// TrackService returns a copy of the service description whose method and
+// stream handlers are tracked by the Drainer.
+func (d *Drainer) TrackService(desc *grpc.ServiceDesc) *grpc.ServiceDesc {
+	tracked := *desc
+
+	tracked.Methods = make([]grpc.MethodDesc, len(desc.Methods))
+	for i, md := range desc.Methods {
+		tracked.Methods[i] = grpc.MethodDesc{
+			MethodName: md.MethodName,
+			Handler:    d.trackMethod(md.Handler),
+		}
+	}
+
+	tracked.Streams = make([]grpc.StreamDesc, len(desc.Streams))
+	for i, sd := range desc.Streams {
+		tracked.Streams[i] = sd
+		tracked.Streams[i].Handler = d.trackStream(sd.Handler)
+	}
+
+	return &tracked
+}
+
+// methodHandler matches the signature of grpc.MethodDesc.Handler.
+type methodHandler = func(srv interface{}, ctx context.Context,
+	dec func(interface{}) error,
+	interceptor grpc.UnaryServerInterceptor) (interface{}, error)
+
+// trackMethod tracks a unary call for as long as its handler runs.
+func (d *Drainer) trackMethod(handler methodHandler) methodHandler {
+	return func(srv interface{}, ctx context.Context,
+		dec func(interface{}) error,
+		interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		ctx, end, err := d.startCall(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer end()
+		return handler(srv, ctx, dec, interceptor)
+	}
+}
+
+// trackStream tracks a streaming call for as long as its handler runs.
+func (d *Drainer) trackStream(handler grpc.StreamHandler) grpc.StreamHandler {
+	return func(srv interface{}, stream grpc.ServerStream) error {
+		ctx, end, err := d.startCall(stream.Context())
+		if err != nil {
+			return err
+		}
+		defer end()
+		return handler(srv, &trackedStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+// trackedStream replaces the context of a stream with one cancelled if
+// draining times out.
+type trackedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the context of the tracked call.
+func (ts *trackedStream) Context() context.Context {
+	return ts.ctx
+}
@@ -0,0 +1,184 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package drain
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+// testStream is a grpc.ServerStream with only a context.
+type testStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the context of the stream.
+func (ts *testStream) Context() context.Context {
+	return ts.ctx
+}
+
+// newTestService returns a tracked service with a unary method and a stream
+// which block until release is closed or their context is cancelled. started
+// receives once each call has begun.
+func newTestService(d *Drainer, release chan struct{},
+	started chan struct{}) *grpc.ServiceDesc {
+	wait := func(ctx context.Context) error {
+		started <- struct{}{}
+		select {
+		case <-release:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return d.TrackService(&grpc.ServiceDesc{
+		ServiceName: "test",
+		Methods: []grpc.MethodDesc{{
+			MethodName: "Unary",
+			Handler: func(srv interface{}, ctx context.Context,
+				dec func(interface{}) error,
+				interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				return nil, wait(ctx)
+			},
+		}},
+		Streams: []grpc.StreamDesc{{
+			StreamName: "Stream",
+			Handler: func(srv interface{}, stream grpc.ServerStream) error {
+				return wait(stream.Context())
+			},
+		}},
+	})
+}
+
+// servingStatus returns the status reported by the health service.
+func servingStatus(d *Drainer, t *testing.T) healthpb.HealthCheckResponse_ServingStatus {
+	resp, err := d.health.Check(context.Background(),
+		&healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatalf("Health check produced an error: %+v", err)
+	}
+	return resp.Status
+}
+
+// Tests that Drain reports the server as not serving while still accepting new
+// calls for the health grace period, then rejects new calls and waits for the
+// calls in flight to finish.
+func TestDrainer_Drain(t *testing.T) {
+	d := &Drainer{}
+	d.SetDrainParams(DrainParams{HealthGrace: 50 * time.Millisecond})
+	d.RegisterHealth(grpc.NewServer())
+	release, started := make(chan struct{}), make(chan struct{}, 3)
+	desc := newTestService(d, release, started)
+
+	if servingStatus(d, t) != healthpb.HealthCheckResponse_SERVING {
+		t.Errorf("Server should report serving before draining")
+	}
+
+	results := make(chan error, 3)
+	go func() {
+		_, err := desc.Methods[0].Handler(nil, context.Background(), nil, nil)
+		results <- err
+	}()
+	go func() {
+		results <- desc.Streams[0].Handler(nil,
+			&testStream{ctx: context.Background()})
+	}()
+	<-started
+	<-started
+
+	drained := make(chan error)
+	go func() { drained <- d.Drain(context.Background()) }()
+
+	// Calls are accepted while load balancers notice the health status
+	for servingStatus(d, t) != healthpb.HealthCheckResponse_NOT_SERVING {
+		time.Sleep(time.Millisecond)
+	}
+	if d.IsDraining() {
+		t.Errorf("Drainer should not reject calls during the health grace")
+	}
+	go func() {
+		_, err := desc.Methods[0].Handler(nil, context.Background(), nil, nil)
+		results <- err
+	}()
+	<-started
+
+	// Wait for draining to begin
+	for !d.IsDraining() {
+		time.Sleep(time.Millisecond)
+	}
+	if servingStatus(d, t) != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("Server should report not serving while draining")
+	}
+	_, err := desc.Methods[0].Handler(nil, context.Background(), nil, nil)
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("New call while draining should be unavailable: %v", err)
+	}
+
+	select {
+	case <-drained:
+		t.Fatalf("Drain returned before calls in flight finished")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	close(release)
+	for i := 0; i < 3; i++ {
+		if err = <-results; err != nil {
+			t.Errorf("Call in flight produced an error: %+v", err)
+		}
+	}
+	if err = <-drained; err != nil {
+		t.Errorf("Drain produced an error: %+v", err)
+	}
+
+	// Registering again accepts new calls
+	d.RegisterHealth(grpc.NewServer())
+	if d.IsDraining() {
+		t.Errorf("Drainer should accept calls once registered again")
+	}
+}
+
+// Tests that Drain cancels the calls still in flight once its context is
+// done and returns an error wrapping that of the context.
+func TestDrainer_Drain_Deadline(t *testing.T) {
+	d := &Drainer{}
+	d.SetDrainParams(DrainParams{})
+	release, started := make(chan struct{}), make(chan struct{}, 1)
+	desc := newTestService(d, release, started)
+
+	result := make(chan error)
+	go func() {
+		result <- desc.Streams[0].Handler(nil,
+			&testStream{ctx: context.Background()})
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(),
+		10*time.Millisecond)
+	defer cancel()
+	err := d.Drain(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded error, received: %v", err)
+	}
+
+	select {
+	case err = <-result:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Stream should be cancelled, received: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("Stream in flight was not cancelled")
+	}
+}
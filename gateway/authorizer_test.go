@@ -46,7 +46,8 @@ func TestComms_SendAuthorizerCertRequest(t *testing.T) {
 
 	// Generate message to send
 	msg := &pb.AuthorizerCertRequest{
-		Timestamp: int64(54321),
+		Timestamp: time.Now().UnixNano(),
+		Signature: []byte("signature"),
 	}
 
 	// Send auth cert request to authorizer
@@ -18,44 +18,41 @@ import (
 	"gitlab.com/xx_network/comms/connect"
 	"google.golang.org/grpc/metadata"
 	"io"
+	"sync/atomic"
+	"time"
 )
 
 // --------------------------- UploadMixedBatch Logic ----------------------------------------//
 
-// UploadUnmixedBatch streams the slots in the batch to the node
+// UploadUnmixedBatch streams the slots in the batch to the node. The batch is
+// split across parallel streams if sharding is enabled with SetBatchShards.
 func (g *Comms) UploadUnmixedBatch(host *connect.Host,
 	batchInfo pb.BatchInfo, batch *pb.Batch) error {
-	// Retrieve the streaming service
-	streamingClient, cancel, err := g.getUnmixedBatchStreamClient(
-		host, batchInfo)
-	if err != nil {
-		return errors.Errorf("Could not retrieve steaming service: %v", err)
-	}
+	ctx, cancel := g.getUnmixedBatchStreamContext(&batchInfo)
 	defer cancel()
 
-	// Stream each slot
-	for i, slot := range batch.Slots {
-		if err = streamingClient.Send(slot); err != nil {
-			return errors.Errorf("Could not stream "+
-				"slot (%d/%d) for round %d: %v",
-				i, len(batch.Slots), batch.Round.ID, err)
-		}
+	open := func(ctx context.Context) (pb.SlotStreamClient, error) {
+		return g.getUnmixedBatchStream(host, ctx)
 	}
 
-	// Receive ack and cancel client streaming context
-	ack, err := streamingClient.CloseAndRecv()
+	// Stream each slot
+	_, err := g.batchSharding.SendSlots(ctx, host.GetId().String(), open,
+		batch.Slots)
 	if err != nil {
-		return errors.Errorf("Could not receive final "+
-			"acknowledgement on streaming batch: %v", err)
-	}
-
-	if ack != nil && ack.Error != "" {
-		return errors.Errorf("Remote Server Error: %v", ack.Error)
+		return errors.Errorf("Could not stream batch of %d slots for "+
+			"round %d: %v", len(batch.Slots), batch.Round.GetID(), err)
 	}
 
 	return nil
 }
 
+// SetBatchShards sets the number of parallel streams used when uploading a
+// batch to a node, split by slot index range. The node may accept fewer.
+// Values below 2 upload each batch over a single stream.
+func (g *Comms) SetBatchShards(shards int) {
+	g.batchSharding.SetBatchShards(shards)
+}
+
 // getUnmixedBatchStreamClient gets the streaming client
 // using a header and returns the stream and the cancel context
 // if there are no connection errors
@@ -125,11 +122,133 @@ func (g *Comms) getUnmixedBatchStream(host *connect.Host,
 
 // ------------------------- DownloadMixedBatch Logic ----------------------------------------//
 
+// DefaultMaxBatchResumes is the number of times a download of a mixed batch is
+// resumed after its stream fails, unless set with SetMaxBatchResumes.
+const DefaultMaxBatchResumes = 3
+
+// Bounds of the wait before resuming a download of a mixed batch, which
+// doubles with each resumption
+const (
+	minBatchResumeBackoff = 100 * time.Millisecond
+	maxBatchResumeBackoff = 5 * time.Second
+)
+
+// DownloadMixedBatch downloads the slots of the mixed batch from the node. If
+// the stream fails, the download resumes from the last slot received.
 func (g *Comms) DownloadMixedBatch(ready *pb.BatchReady,
 	host *connect.Host) ([]*pb.Slot, error) {
+	slots := make([]*pb.Slot, 0)
+	err := g.DownloadMixedBatchFunc(ready, host, func(slot *pb.Slot) error {
+		slots = append(slots, slot)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return slots, nil
+}
+
+// DownloadMixedBatchFunc downloads the mixed batch from the node, passing each
+// slot to receive as it arrives instead of buffering the batch. If the stream
+// fails, the download resumes from the last slot received, so each slot is
+// passed to receive once. An error returned by receive stops the download.
+// The download always starts from the first slot, as the whole batch is
+// needed to verify it; the StartIndex of ready is only set when resuming.
+//
+// The batch is verified against the commitment of the node once every slot
+// has been received, so receive may be passed slots before a
+// *pb.BatchIntegrityError is returned; they must then be discarded.
+func (g *Comms) DownloadMixedBatchFunc(ready *pb.BatchReady,
+	host *connect.Host, receive func(slot *pb.Slot) error) error {
+	jww.INFO.Printf("Receiving batch for round %d", ready.RoundId)
+
+	var stream *pb.VerifyingSlotStream
+	maxResumes := g.GetMaxBatchResumes()
+	for resumes := 0; ; resumes++ {
+		var start uint32
+		if stream != nil {
+			start = stream.Received()
+		}
+
+		streamClient, cancel, err := g.getMixedBatchStreamClient(
+			&pb.BatchReady{RoundId: ready.RoundId, StartIndex: start}, host)
+		if err == nil {
+			// Verify the slots against the commitment sent by the node
+			if stream == nil {
+				stream = pb.NewVerifyingSlotStream(streamClient)
+			} else {
+				err = stream.Resume(streamClient)
+			}
+
+			if err == nil {
+				var done bool
+				done, err = receiveMixedBatch(stream, receive)
+				if done {
+					cancel()
+					return err
+				}
+			}
+			cancel()
+		}
+
+		if resumes >= maxResumes {
+			return errors.Errorf("Error receiving mixed batch via stream "+
+				"for round %d after %d resumptions: %v", ready.RoundId,
+				resumes, err)
+		}
+		backoff := minBatchResumeBackoff << resumes
+		if backoff > maxBatchResumeBackoff || backoff <= 0 {
+			backoff = maxBatchResumeBackoff
+		}
+		jww.WARN.Printf("Error receiving mixed batch via stream for round "+
+			"%d, resuming in %s: %v", ready.RoundId, backoff, err)
+		time.Sleep(backoff)
+	}
+}
+
+// receiveMixedBatch passes the slots received on the stream to receive until
+// the stream ends. Returns true if the download is finished and false if the
+// stream failed and the download may be resumed.
+func receiveMixedBatch(stream *pb.VerifyingSlotStream,
+	receive func(slot *pb.Slot) error) (bool, error) {
+	for {
+		slot, err := stream.Recv()
+		if err == io.EOF {
+			return true, nil
+		} else if err != nil {
+			var integrityErr *pb.BatchIntegrityError
+			return errors.As(err, &integrityErr), err
+		}
+
+		if err = receive(slot); err != nil {
+			return true, err
+		}
+	}
+}
+
+// SetMaxBatchResumes sets the number of times a download of a mixed batch is
+// resumed after its stream fails. Values below 1 disable resuming.
+func (g *Comms) SetMaxBatchResumes(maxResumes int) {
+	if maxResumes < 0 {
+		maxResumes = 0
+	}
+	atomic.StoreInt32(&g.maxBatchResumes, int32(maxResumes))
+}
+
+// GetMaxBatchResumes returns the number of times a download of a mixed batch
+// is resumed after its stream fails.
+func (g *Comms) GetMaxBatchResumes() int {
+	return int(atomic.LoadInt32(&g.maxBatchResumes))
+}
+
+// getMixedBatchStreamClient requests the mixed batch from the node and
+// returns the stream it is received on and the cancel func of the stream.
+func (g *Comms) getMixedBatchStreamClient(ready *pb.BatchReady,
+	host *connect.Host) (pb.Node_DownloadMixedBatchClient,
+	context.CancelFunc, error) {
 	// Create the Stream Function
 	ctx, cancel := connect.StreamingContext()
-	defer cancel()
 	f := func(conn connect.Connection) (interface{}, error) {
 		// Pack message into an authenticated message
 		authMsg, err := g.PackAuthenticatedMessage(ready, host, false)
@@ -147,21 +266,9 @@ func (g *Comms) DownloadMixedBatch(ready *pb.BatchReady,
 
 	resultClient, err := g.ProtoComms.Stream(host, f)
 	if err != nil {
-		return nil, err
-	}
-
-	stream := resultClient.(pb.Node_DownloadMixedBatchClient)
-	jww.INFO.Printf("Receiving batch for round %d", ready.RoundId)
-	slots := make([]*pb.Slot, 0)
-
-	slot, err := stream.Recv()
-	for ; err == nil; slot, err = stream.Recv() {
-		slots = append(slots, slot)
-	}
-	if err != io.EOF {
-		return nil, errors.Errorf("Error receiving mixed batch via stream for round %d: %v",
-			ready.RoundId, err)
+		cancel()
+		return nil, nil, err
 	}
 
-	return slots, nil
+	return resultClient.(pb.Node_DownloadMixedBatchClient), cancel, nil
 }
@@ -10,6 +10,7 @@ package gateway
 import (
 	"bytes"
 	"context"
+	"github.com/pkg/errors"
 	jww "github.com/spf13/jwalterweatherman"
 	"gitlab.com/elixxir/comms/mixmessages"
 	"gitlab.com/elixxir/comms/node"
@@ -27,6 +28,7 @@ import (
 func TestMain(m *testing.M) {
 	jww.SetStdoutThreshold(jww.LevelTrace)
 	connect.TestingOnlyDisableTLS = true
+	node.TestingOnlyDisableAuthorization = true
 	os.Exit(m.Run())
 }
 
@@ -98,6 +100,148 @@ func mockStreamMixedBatch(server mixmessages.Node_DownloadMixedBatchServer,
 	return nil
 }
 
+// startResumeTestNode starts a node which streams the slots for mixed batch
+// downloads, failing the first failures streams after sending failAfter
+// slots past the requested start index. Returns the node, a host for it and the start index of each request.
+func startResumeTestNode(slots []*mixmessages.Slot, failures, failAfter int,
+	t *testing.T) (*node.Comms, *connect.Host, *[]uint32) {
+	keyData := testkeys.LoadFromPath(testkeys.GetNodeKeyPath())
+	certData := testkeys.LoadFromPath(testkeys.GetNodeCertPath())
+
+	var starts []uint32
+	receiverImpl := node.NewImplementation()
+	receiverImpl.Functions.DownloadMixedBatch = func(
+		stream mixmessages.Node_DownloadMixedBatchServer,
+		batchInfo *mixmessages.BatchReady, auth *connect.Auth) error {
+		starts = append(starts, batchInfo.StartIndex)
+		for i, slot := range slots {
+			if len(starts) <= failures &&
+				i == int(batchInfo.StartIndex)+failAfter {
+				return errors.New("stream failed")
+			}
+			if err := stream.Send(slot); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	nodeID := id.NewIdFromString("test", id.Node, t)
+	serverAddress := getNextServerAddress()
+	server := node.StartNode(nodeID, serverAddress, 0, receiverImpl,
+		certData, keyData)
+
+	manager := connect.NewManagerTesting(t)
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(nodeID, serverAddress, certData, params)
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+
+	return server, host, &starts
+}
+
+// Tests that a mixed batch download resumes from the last slot received when
+// its stream fails, receiving every slot once and in order.
+func TestComms_DownloadMixedBatch_Resume(t *testing.T) {
+	slots := make([]*mixmessages.Slot, 10)
+	for i := range slots {
+		slots[i] = &mixmessages.Slot{Index: uint32(i), PayloadA: []byte{byte(i)}}
+	}
+	server, host, starts := startResumeTestNode(slots, 2, 4, t)
+	defer server.Shutdown()
+
+	testID := id.NewIdFromString("test", id.Gateway, t)
+	gateway := StartGateway(testID, getNextGatewayAddress(), NewImplementation(),
+		nil, nil, gossip.DefaultManagerFlags())
+	defer gateway.Shutdown()
+
+	received, err := gateway.DownloadMixedBatch(
+		&mixmessages.BatchReady{RoundId: 4}, host)
+	if err != nil {
+		t.Fatalf("DownloadMixedBatch produced an error: %+v", err)
+	}
+
+	if len(received) != len(slots) {
+		t.Fatalf("Received %d slots, expected %d", len(received), len(slots))
+	}
+	for i, slot := range received {
+		if slot.Index != uint32(i) {
+			t.Errorf("Slot %d received out of order: %d", i, slot.Index)
+		}
+	}
+
+	expectedStarts := []uint32{0, 4, 8}
+	if !reflect.DeepEqual(*starts, expectedStarts) {
+		t.Errorf("Unexpected start indexes requested."+
+			"\nexpected: %v\nreceived: %v", expectedStarts, *starts)
+	}
+}
+
+// Tests that a mixed batch download gives up once it has been resumed the
+// maximum number of times.
+func TestComms_DownloadMixedBatch_MaxResumes(t *testing.T) {
+	slots := []*mixmessages.Slot{{Index: 0}, {Index: 1}, {Index: 2}}
+	server, host, starts := startResumeTestNode(slots, len(slots)+1, 1, t)
+	defer server.Shutdown()
+
+	testID := id.NewIdFromString("test", id.Gateway, t)
+	gateway := StartGateway(testID, getNextGatewayAddress(), NewImplementation(),
+		nil, nil, gossip.DefaultManagerFlags())
+	defer gateway.Shutdown()
+	gateway.SetMaxBatchResumes(1)
+
+	_, err := gateway.DownloadMixedBatch(
+		&mixmessages.BatchReady{RoundId: 4}, host)
+	if err == nil {
+		t.Errorf("Expected error once resumptions were exhausted")
+	}
+	if len(*starts) != 2 {
+		t.Errorf("Expected 2 requests with 1 resumption, received %d",
+			len(*starts))
+	}
+}
+
+// Tests that DownloadMixedBatchFunc passes slots to the callback as they
+// arrive and stops when the callback returns an error.
+func TestComms_DownloadMixedBatchFunc(t *testing.T) {
+	slots := []*mixmessages.Slot{{Index: 0}, {Index: 1}, {Index: 2}}
+	server, host, starts := startResumeTestNode(slots, 0, 0, t)
+	defer server.Shutdown()
+
+	testID := id.NewIdFromString("test", id.Gateway, t)
+	gateway := StartGateway(testID, getNextGatewayAddress(), NewImplementation(),
+		nil, nil, gossip.DefaultManagerFlags())
+	defer gateway.Shutdown()
+
+	var received int
+	err := gateway.DownloadMixedBatchFunc(&mixmessages.BatchReady{RoundId: 4},
+		host, func(slot *mixmessages.Slot) error {
+			received++
+			return nil
+		})
+	if err != nil {
+		t.Errorf("DownloadMixedBatchFunc produced an error: %+v", err)
+	}
+	if received != len(slots) {
+		t.Errorf("Callback received %d slots, expected %d", received,
+			len(slots))
+	}
+
+	stopErr := errors.New("stop")
+	err = gateway.DownloadMixedBatchFunc(&mixmessages.BatchReady{RoundId: 4},
+		host, func(slot *mixmessages.Slot) error {
+			return stopErr
+		})
+	if !errors.Is(err, stopErr) {
+		t.Errorf("Expected callback error, received: %v", err)
+	}
+	if len(*starts) != 2 {
+		t.Errorf("Download stopped by callback should not be resumed")
+	}
+}
+
 // Happy path
 func TestComms_StreamUnmixedBatch(t *testing.T) {
 	keyPath := testkeys.GetNodeKeyPath()
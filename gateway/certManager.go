@@ -0,0 +1,493 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the manager obtaining and renewing the HTTPS certificate of the
+// gateway through ACME, with the DNS-01 challenge published by the authorizer
+
+package gateway
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/elixxir/comms/certificates"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/crypto/authorize"
+	"gitlab.com/xx_network/comms/connect"
+	"golang.org/x/crypto/acme"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Names of the files kept in CertManagerParams.StorageDir
+const (
+	certFileName       = "cert.pem"
+	keyFileName        = "key.pem"
+	accountKeyFileName = "account.pem"
+)
+
+// CertManagerParams configures a CertManager.
+type CertManagerParams struct {
+	// URL of the ACME directory of the certificate authority
+	DirectoryURL string
+
+	// Domain name of the gateway which the certificate is issued for
+	Domain string
+
+	// Address the HTTPS listener serving the certificate listens on
+	HttpsAddress string
+
+	// Directory holding the ACME account key and the issued certificate and
+	// key, so they are reused across restarts
+	StorageDir string
+
+	// The certificate is renewed once it expires within this duration
+	RenewBefore time.Duration
+
+	// Maximum time taken to obtain a certificate when called through the
+	// Provider
+	Timeout time.Duration
+
+	// Client used to reach the ACME server; if nil, http.DefaultClient is
+	// used. Set it to trust the CA of a test server such as Pebble.
+	HTTPClient *http.Client
+
+	// Maximum time waited for the challenge record published by the
+	// authorizer to be served by DNS before the challenge is accepted. If
+	// zero, the challenge is accepted without waiting.
+	DNSPropagationTimeout time.Duration
+
+	// Interval between lookups of the challenge record
+	DNSPollInterval time.Duration
+
+	// Resolver used to look up the challenge record; if nil,
+	// net.DefaultResolver is used
+	Resolver *net.Resolver
+}
+
+// DefaultCertManagerParams returns the default CertManagerParams. Domain,
+// HttpsAddress and StorageDir have no default and must be set.
+func DefaultCertManagerParams() CertManagerParams {
+	return CertManagerParams{
+		DirectoryURL: "https://acme.zerossl.com/v2/DV90",
+		RenewBefore:  30 * 24 * time.Hour,
+		Timeout:      15 * time.Minute,
+
+		DNSPropagationTimeout: 10 * time.Minute,
+		DNSPollInterval:       10 * time.Second,
+	}
+}
+
+// CertManager obtains the HTTPS certificate of the gateway through ACME and
+// renews it before it expires. The external account binding credentials are
+// requested from the authorizer, which also publishes the DNS-01 challenge
+// records for the domain of the gateway.
+type CertManager struct {
+	comms      *Comms
+	authorizer *connect.Host
+	params     CertManagerParams
+	client     *acme.Client
+
+	// Looks up the TXT records of a name
+	lookupTXT func(ctx context.Context, name string) ([]string, error)
+
+	// Serialises obtaining certificates
+	mux        sync.Mutex
+	registered bool
+}
+
+// NewCertManager returns a CertManager obtaining certificates through the
+// authorizer host. The ACME account key is loaded from the storage directory,
+// or generated and stored there if none exists.
+func (g *Comms) NewCertManager(authorizer *connect.Host,
+	params CertManagerParams) (*CertManager, error) {
+	if params.Domain == "" {
+		return nil, errors.New("Cannot manage a certificate without a domain")
+	}
+	if params.StorageDir == "" {
+		return nil, errors.New(
+			"Cannot manage a certificate without a storage directory")
+	}
+	if err := os.MkdirAll(params.StorageDir, 0700); err != nil {
+		return nil, errors.Errorf("Could not create storage directory: %+v",
+			err)
+	}
+
+	accountKey, err := loadAccountKey(
+		filepath.Join(params.StorageDir, accountKeyFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	resolver := params.Resolver
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+
+	return &CertManager{
+		comms:      g,
+		authorizer: authorizer,
+		params:     params,
+		client: &acme.Client{
+			Key:          accountKey,
+			HTTPClient:   params.HTTPClient,
+			DirectoryURL: params.DirectoryURL,
+		},
+		lookupTXT: resolver.LookupTXT,
+	}, nil
+}
+
+// Certificate returns the stored certificate and key, obtaining new ones if
+// none are stored or the certificate is due for renewal. If renewing fails
+// while the stored certificate is still valid, it is returned and the error
+// is logged.
+func (m *CertManager) Certificate(ctx context.Context) (
+	certPEM, keyPEM []byte, err error) {
+	certPEM, keyPEM, expiry, err := m.load()
+	if err == nil && time.Now().Add(m.params.RenewBefore).Before(expiry) {
+		return certPEM, keyPEM, nil
+	} else if err != nil && !os.IsNotExist(errors.Cause(err)) {
+		jww.WARN.Printf("Discarding stored certificate: %+v", err)
+	}
+
+	newCert, newKey, obtainErr := m.Obtain(ctx)
+	if obtainErr == nil {
+		return newCert, newKey, nil
+	}
+	if err == nil && time.Now().Before(expiry) {
+		jww.WARN.Printf("Failed to renew certificate expiring at %s, "+
+			"keeping it: %+v", expiry, obtainErr)
+		return certPEM, keyPEM, nil
+	}
+	return nil, nil, obtainErr
+}
+
+// Provider returns a certificates.Provider returning the certificate from
+// Certificate, so a Reloader polling it picks up each renewal.
+func (m *CertManager) Provider() certificates.Provider {
+	return func() ([]byte, []byte, error) {
+		ctx, cancel := context.WithTimeout(context.Background(),
+			m.params.Timeout)
+		defer cancel()
+		return m.Certificate(ctx)
+	}
+}
+
+// Start serves the current certificate over HTTPS at the HttpsAddress of the
+// parameters, obtaining one if needed, and checks every interval whether it
+// must be renewed. Renewed certificates are served to new handshakes through
+// the Reloader, without restarting the gateway or interrupting existing
+// connections. It returns the Reloader holding the certificate and a function
+// which stops renewing.
+func (m *CertManager) Start(interval time.Duration) (
+	*certificates.Reloader, func(), error) {
+	provider := m.Provider()
+	certPEM, keyPEM, err := provider()
+	if err != nil {
+		return nil, nil, err
+	}
+	reloader, err := certificates.NewReloader(certPEM, keyPEM)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = m.comms.ServeHttpsReloading(m.params.HttpsAddress, reloader)
+	if err != nil {
+		return nil, nil, errors.WithMessage(err,
+			"Could not serve certificate over HTTPS")
+	}
+	return reloader, reloader.Poll(provider, interval), nil
+}
+
+// Obtain runs the ACME flow for a new certificate and stores it along with
+// its key, returning both PEM encoded.
+func (m *CertManager) Obtain(ctx context.Context) (
+	certPEM, keyPEM []byte, err error) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	if err = m.register(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	order, err := m.client.AuthorizeOrder(ctx,
+		acme.DomainIDs(m.params.Domain))
+	if err != nil {
+		return nil, nil, errors.Errorf("Could not create order for %s: %+v",
+			m.params.Domain, err)
+	}
+	for _, authzURL := range order.AuthzURLs {
+		if err = m.authorize(ctx, authzURL); err != nil {
+			return nil, nil, err
+		}
+	}
+	order, err = m.client.WaitOrder(ctx, order.URI)
+	if err != nil {
+		return nil, nil, errors.Errorf("Order for %s was not ready: %+v",
+			m.params.Domain, err)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, errors.Errorf("Could not generate key: %+v", err)
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader,
+		&x509.CertificateRequest{DNSNames: []string{m.params.Domain}}, key)
+	if err != nil {
+		return nil, nil, errors.Errorf("Could not create CSR: %+v", err)
+	}
+	chain, _, err := m.client.CreateOrderCert(ctx, order.FinalizeURL, csr,
+		true)
+	if err != nil {
+		return nil, nil, errors.Errorf("Could not finalize order for %s: %+v",
+			m.params.Domain, err)
+	}
+
+	for _, der := range chain {
+		certPEM = append(certPEM,
+			pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})...)
+	}
+	keyPEM, err = encodeECKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = writeFile(filepath.Join(m.params.StorageDir, keyFileName),
+		keyPEM); err != nil {
+		return nil, nil, err
+	}
+	if err = writeFile(filepath.Join(m.params.StorageDir, certFileName),
+		certPEM); err != nil {
+		return nil, nil, err
+	}
+
+	jww.INFO.Printf("Obtained certificate for %s", m.params.Domain)
+	return certPEM, keyPEM, nil
+}
+
+// register registers the ACME account, binding it to the external account
+// whose credentials are requested from the authorizer, unless it already
+// exists.
+func (m *CertManager) register(ctx context.Context) error {
+	if m.registered {
+		return nil
+	}
+
+	_, err := m.client.GetReg(ctx, "")
+	if err == nil {
+		m.registered = true
+		return nil
+	} else if !errors.Is(err, acme.ErrNoAccount) {
+		return errors.Errorf("Could not look up ACME account: %+v", err)
+	}
+
+	eab, err := m.comms.SendEABCredentialRequest(m.authorizer,
+		&pb.EABCredentialRequest{})
+	if err != nil {
+		return errors.WithMessage(err,
+			"Could not get EAB credentials from the authorizer")
+	}
+	hmacKey, err := base64.RawURLEncoding.DecodeString(
+		strings.TrimRight(eab.Key, "="))
+	if err != nil {
+		return errors.Errorf("Could not decode EAB key: %+v", err)
+	}
+
+	account := &acme.Account{ExternalAccountBinding: &acme.ExternalAccountBinding{
+		KID: eab.KeyId,
+		Key: hmacKey,
+	}}
+	_, err = m.client.Register(ctx, account, acme.AcceptTOS)
+	if err != nil && !errors.Is(err, acme.ErrAccountAlreadyExists) {
+		return errors.Errorf("Could not register ACME account: %+v", err)
+	}
+
+	m.registered = true
+	return nil
+}
+
+// authorize completes the DNS-01 challenge of the authorization, sending the
+// record to publish to the authorizer.
+func (m *CertManager) authorize(ctx context.Context, authzURL string) error {
+	authz, err := m.client.GetAuthorization(ctx, authzURL)
+	if err != nil {
+		return errors.Errorf("Could not get authorization: %+v", err)
+	}
+	if authz.Status == acme.StatusValid {
+		return nil
+	}
+
+	var challenge *acme.Challenge
+	for _, c := range authz.Challenges {
+		if c.Type == "dns-01" {
+			challenge = c
+			break
+		}
+	}
+	if challenge == nil {
+		return errors.Errorf("Authorization for %s has no dns-01 challenge",
+			authz.Identifier.Value)
+	}
+
+	record, err := m.client.DNS01ChallengeRecord(challenge.Token)
+	if err != nil {
+		return errors.Errorf("Could not create challenge record: %+v", err)
+	}
+	now := time.Now()
+	sig, err := authorize.SignCertRequest(rand.Reader,
+		m.comms.GetPrivateKey(), record, now)
+	if err != nil {
+		return errors.Errorf("Could not sign certificate request: %+v", err)
+	}
+	ack, err := m.comms.SendAuthorizerCertRequest(m.authorizer,
+		&pb.AuthorizerCertRequest{
+			GwID:      m.comms.GetId().Marshal(),
+			Timestamp: now.UnixNano(),
+			ACMEToken: record,
+			Signature: sig,
+		})
+	if err != nil {
+		return errors.WithMessage(err,
+			"Authorizer could not publish the challenge record")
+	} else if ack.GetError() != "" {
+		return errors.Errorf("Authorizer could not publish the challenge "+
+			"record: %s", ack.GetError())
+	}
+
+	if err = m.waitForRecord(ctx, record); err != nil {
+		return err
+	}
+	if _, err = m.client.Accept(ctx, challenge); err != nil {
+		return errors.Errorf("Could not accept challenge: %+v", err)
+	}
+	if _, err = m.client.WaitAuthorization(ctx, authzURL); err != nil {
+		return errors.Errorf("Authorization for %s failed: %+v",
+			authz.Identifier.Value, err)
+	}
+	return nil
+}
+
+// waitForRecord polls DNS until the challenge record of the domain is served,
+// so the certificate authority does not look it up before the authorizer's
+// DNS provider has published it.
+func (m *CertManager) waitForRecord(ctx context.Context, record string) error {
+	if m.params.DNSPropagationTimeout == 0 {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, m.params.DNSPropagationTimeout)
+	defer cancel()
+
+	name := "_acme-challenge." + m.params.Domain
+	for {
+		records, err := m.lookupTXT(ctx, name)
+		for _, r := range records {
+			if r == record {
+				return nil
+			}
+		}
+		jww.DEBUG.Printf("Challenge record not yet served for %s: %v", name,
+			err)
+
+		select {
+		case <-ctx.Done():
+			return errors.Errorf("Challenge record for %s was not served "+
+				"within %s", name, m.params.DNSPropagationTimeout)
+		case <-time.After(m.params.DNSPollInterval):
+		}
+	}
+}
+
+// load returns the stored certificate and key and the expiry of the
+// certificate.
+func (m *CertManager) load() (certPEM, keyPEM []byte, expiry time.Time,
+	err error) {
+	certPEM, err = os.ReadFile(filepath.Join(m.params.StorageDir, certFileName))
+	if err != nil {
+		return nil, nil, time.Time{}, errors.WithStack(err)
+	}
+	keyPEM, err = os.ReadFile(filepath.Join(m.params.StorageDir, keyFileName))
+	if err != nil {
+		return nil, nil, time.Time{}, errors.WithStack(err)
+	}
+
+	// Both files are checked together, as writing them may have been
+	// interrupted between the two
+	pair, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, nil, time.Time{}, errors.Errorf(
+			"Stored certificate and key do not match: %+v", err)
+	}
+	cert, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return nil, nil, time.Time{}, errors.Errorf(
+			"Could not parse stored certificate: %+v", err)
+	}
+	return certPEM, keyPEM, cert.NotAfter, nil
+}
+
+// loadAccountKey loads the ACME account key from the file, generating and
+// storing a new key if the file does not exist.
+func loadAccountKey(path string) (*ecdsa.PrivateKey, error) {
+	keyPEM, err := os.ReadFile(path)
+	if err == nil {
+		block, _ := pem.Decode(keyPEM)
+		if block == nil {
+			return nil, errors.New("Stored account key is not PEM encoded")
+		}
+		key, err := x509.ParseECPrivateKey(block.Bytes)
+		if err != nil {
+			return nil, errors.Errorf("Could not parse account key: %+v", err)
+		}
+		return key, nil
+	} else if !os.IsNotExist(err) {
+		return nil, errors.Errorf("Could not read account key: %+v", err)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, errors.Errorf("Could not generate account key: %+v", err)
+	}
+	keyPEM, err = encodeECKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return key, writeFile(path, keyPEM)
+}
+
+// encodeECKey returns the PEM encoding of the key.
+func encodeECKey(key *ecdsa.PrivateKey) ([]byte, error) {
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, errors.Errorf("Could not marshal key: %+v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}),
+		nil
+}
+
+// writeFile replaces the contents of the file, readable only by its owner,
+// without leaving it partially written.
+func writeFile(path string, data []byte) error {
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return errors.Errorf("Could not write %s: %+v", tmp, err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return errors.Errorf("Could not replace %s: %+v", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,314 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/tls"
+	"encoding/json"
+	"encoding/pem"
+	"gitlab.com/elixxir/comms/authorizer"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/testkeys"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/gossip"
+	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/primitives/id"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// newTestCertManager returns a CertManager storing its files in a temporary
+// directory, with an unreachable ACME server.
+func newTestCertManager(t *testing.T) *CertManager {
+	params := DefaultCertManagerParams()
+	params.DirectoryURL = "http://127.0.0.1:1/directory"
+	params.Domain = "gateway.cmix.rip"
+	params.StorageDir = t.TempDir()
+	params.Timeout = time.Second
+
+	m, err := (&Comms{}).NewCertManager(nil, params)
+	if err != nil {
+		t.Fatalf("Unable to create cert manager: %+v", err)
+	}
+	return m
+}
+
+// storeTestCertificate stores the test gateway certificate and key as though
+// the manager had obtained them.
+func storeTestCertificate(m *CertManager, t *testing.T) {
+	err := writeFile(filepath.Join(m.params.StorageDir, certFileName),
+		testkeys.GetGatewayCert())
+	if err != nil {
+		t.Fatalf("Unable to store certificate: %+v", err)
+	}
+	err = writeFile(filepath.Join(m.params.StorageDir, keyFileName),
+		testkeys.GetGatewayKey())
+	if err != nil {
+		t.Fatalf("Unable to store key: %+v", err)
+	}
+}
+
+// Tests that NewCertManager stores the account key it generates and loads it
+// again for a later manager using the same directory.
+func TestComms_NewCertManager(t *testing.T) {
+	m := newTestCertManager(t)
+
+	m2, err := (&Comms{}).NewCertManager(nil, m.params)
+	if err != nil {
+		t.Fatalf("Unable to create second cert manager: %+v", err)
+	}
+	if !m.client.Key.(*ecdsa.PrivateKey).Equal(m2.client.Key) {
+		t.Errorf("Account key was not reused")
+	}
+
+	params := m.params
+	params.Domain = ""
+	if _, err = (&Comms{}).NewCertManager(nil, params); err == nil {
+		t.Errorf("NewCertManager should require a domain")
+	}
+}
+
+// Tests that Certificate returns the stored certificate without contacting
+// the ACME server while it is not due for renewal.
+func TestCertManager_Certificate_Stored(t *testing.T) {
+	m := newTestCertManager(t)
+	storeTestCertificate(m, t)
+
+	certPEM, keyPEM, err := m.Certificate(context.Background())
+	if err != nil {
+		t.Fatalf("Certificate produced an error: %+v", err)
+	}
+	if !bytes.Equal(certPEM, testkeys.GetGatewayCert()) ||
+		!bytes.Equal(keyPEM, testkeys.GetGatewayKey()) {
+		t.Errorf("Certificate did not return the stored certificate and key")
+	}
+}
+
+// Tests that Certificate keeps the stored certificate when renewing it fails
+// while it is still valid, and returns an error when there is none.
+func TestCertManager_Certificate_RenewalFailure(t *testing.T) {
+	m := newTestCertManager(t)
+	m.params.RenewBefore = 100 * 365 * 24 * time.Hour
+
+	if _, _, err := m.Certificate(context.Background()); err == nil {
+		t.Errorf("Certificate should fail without a stored certificate " +
+			"or an ACME server")
+	}
+
+	storeTestCertificate(m, t)
+	certPEM, _, err := m.Certificate(context.Background())
+	if err != nil {
+		t.Fatalf("Certificate should keep the valid stored certificate: %+v",
+			err)
+	}
+	if !bytes.Equal(certPEM, testkeys.GetGatewayCert()) {
+		t.Errorf("Certificate did not return the stored certificate")
+	}
+
+	// A stored certificate which does not match its key is discarded
+	err = writeFile(filepath.Join(m.params.StorageDir, keyFileName),
+		testkeys.GetNodeKey())
+	if err != nil {
+		t.Fatalf("Unable to store key: %+v", err)
+	}
+	if _, _, err = m.Certificate(context.Background()); err == nil {
+		t.Errorf("Certificate should discard a mismatched certificate")
+	}
+}
+
+// Tests that Start serves the stored certificate over HTTPS and serves each
+// renewed certificate to new handshakes without restarting the gateway or
+// interrupting existing connections.
+func TestCertManager_Start_Renewal(t *testing.T) {
+	gwID := id.NewIdFromString("renewing", id.Gateway, t)
+	gw := StartGateway(gwID, getNextGatewayAddress(), NewImplementation(),
+		nil, nil, gossip.DefaultManagerFlags())
+	defer gw.Shutdown()
+	defer gw.StopHttps()
+	grpcServer := gw.GetServer()
+
+	params := DefaultCertManagerParams()
+	params.DirectoryURL = "http://127.0.0.1:1/directory"
+	params.Domain = "gateway.cmix.rip"
+	params.HttpsAddress = getNextGatewayAddress()
+	params.StorageDir = t.TempDir()
+	params.RenewBefore = 0
+	m, err := gw.NewCertManager(nil, params)
+	if err != nil {
+		t.Fatalf("Unable to create cert manager: %+v", err)
+	}
+	storeTestCertificate(m, t)
+
+	_, stop, err := m.Start(10 * time.Millisecond)
+	if err != nil {
+		t.Fatalf("Start produced an error: %+v", err)
+	}
+	defer stop()
+	oldConn := dialHttps(params.HttpsAddress, testkeys.GetGatewayCert(), t)
+	defer oldConn.Close()
+
+	// Store a renewed certificate as though it had been obtained
+	err = writeFile(filepath.Join(params.StorageDir, certFileName),
+		testkeys.GetNodeCert())
+	if err == nil {
+		err = writeFile(filepath.Join(params.StorageDir, keyFileName),
+			testkeys.GetNodeKey())
+	}
+	if err != nil {
+		t.Fatalf("Unable to store renewed certificate: %+v", err)
+	}
+
+	for i := 0; ; i++ {
+		conn, err := tls.Dial("tcp", params.HttpsAddress,
+			&tls.Config{InsecureSkipVerify: true})
+		if err != nil {
+			t.Fatalf("Failed to dial HTTPS: %+v", err)
+		}
+		leaf := conn.ConnectionState().PeerCertificates[0]
+		_ = conn.Close()
+		block, _ := pem.Decode(testkeys.GetNodeCert())
+		if bytes.Equal(leaf.Raw, block.Bytes) {
+			break
+		} else if i == 100 {
+			t.Fatalf("Renewed certificate was not served")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	checkServed(oldConn, params.HttpsAddress, t)
+	if gw.GetServer() != grpcServer {
+		t.Errorf("Gateway was restarted to serve the renewed certificate")
+	}
+}
+
+// Tests the full ACME flow against Pebble. It runs only when PEBBLE_DIRECTORY
+// is set to the directory URL of a Pebble server requiring external account
+// binding, and PEBBLE_CHALLTESTSRV to the management URL of the
+// pebble-challtestsrv which Pebble uses as its DNS resolver. PEBBLE_EAB_KID
+// and PEBBLE_EAB_KEY hold one of the MAC keys Pebble is configured with.
+func TestCertManager_Obtain_Pebble(t *testing.T) {
+	directory := os.Getenv("PEBBLE_DIRECTORY")
+	challtestsrv := os.Getenv("PEBBLE_CHALLTESTSRV")
+	if directory == "" || challtestsrv == "" {
+		t.Skip("PEBBLE_DIRECTORY and PEBBLE_CHALLTESTSRV are not set")
+	}
+	const domain = "gateway.pebble.test"
+
+	gwID := id.NewIdFromString("TestGatewayID", id.Gateway, t)
+	gateway := StartGateway(gwID, getNextGatewayAddress(), NewImplementation(),
+		testkeys.GetGatewayCert(), testkeys.GetGatewayKey(),
+		gossip.DefaultManagerFlags())
+	defer gateway.Shutdown()
+
+	// The authorizer publishes the challenge record through challtestsrv
+	impl := authorizer.NewImplementation()
+	impl.Functions.RequestEABCredentials = func(
+		*pb.EABCredentialRequest) (*pb.EABCredentialResponse, error) {
+		return &pb.EABCredentialResponse{
+			KeyId: os.Getenv("PEBBLE_EAB_KID"),
+			Key:   os.Getenv("PEBBLE_EAB_KEY"),
+		}, nil
+	}
+	impl.Functions.RequestCert = func(
+		msg *pb.AuthorizerCertRequest) (*messages.Ack, error) {
+		body, err := json.Marshal(map[string]string{
+			"host":  "_acme-challenge." + domain + ".",
+			"value": msg.ACMEToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		resp, err := http.Post(challtestsrv+"/set-txt", "application/json",
+			bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		return &messages.Ack{}, resp.Body.Close()
+	}
+	authAddr := getNextServerAddress()
+	authServer := authorizer.StartAuthorizerServer(&id.Authorizer, authAddr,
+		impl, nil, nil)
+	defer authServer.Shutdown()
+
+	hostParams := connect.GetDefaultHostParams()
+	hostParams.AuthEnabled = false
+	host, err := connect.NewManagerTesting(t).AddHost(&id.Authorizer,
+		authAddr, nil, hostParams)
+	if err != nil {
+		t.Fatalf("Failed to add host: %+v", err)
+	}
+
+	params := DefaultCertManagerParams()
+	params.DirectoryURL = directory
+	params.Domain = domain
+	params.HttpsAddress = getNextGatewayAddress()
+	params.StorageDir = t.TempDir()
+	// Pebble serves its API with a certificate from its own test CA
+	params.HTTPClient = &http.Client{Transport: &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}}
+	// The record is served by challtestsrv rather than the system resolver
+	params.DNSPropagationTimeout = 0
+	m, err := gateway.NewCertManager(host, params)
+	if err != nil {
+		t.Fatalf("Unable to create cert manager: %+v", err)
+	}
+
+	reloader, stop, err := m.Start(time.Hour)
+	if err != nil {
+		t.Fatalf("Unable to obtain certificate: %+v", err)
+	}
+	defer stop()
+
+	cert, _ := reloader.GetCertificate(nil)
+	if len(cert.Leaf.DNSNames) != 1 || cert.Leaf.DNSNames[0] != domain {
+		t.Errorf("Certificate issued for %v, expected %s",
+			cert.Leaf.DNSNames, domain)
+	}
+	storedCert, _, _, err := m.load()
+	certPEM, _ := reloader.GetPem()
+	if err != nil || !bytes.Equal(storedCert, certPEM) {
+		t.Errorf("Obtained certificate was not stored: %+v", err)
+	}
+}
+
+// Tests that waitForRecord returns once the challenge record is served and
+// fails if it is not served within the propagation timeout.
+func TestCertManager_waitForRecord(t *testing.T) {
+	m := newTestCertManager(t)
+	m.params.DNSPropagationTimeout = time.Second
+	m.params.DNSPollInterval = time.Millisecond
+
+	lookups := 0
+	m.lookupTXT = func(_ context.Context, name string) ([]string, error) {
+		if name != "_acme-challenge.gateway.cmix.rip" {
+			t.Errorf("Looked up unexpected name %s", name)
+		}
+		lookups++
+		if lookups < 3 {
+			return []string{"stale"}, nil
+		}
+		return []string{"stale", "record"}, nil
+	}
+	if err := m.waitForRecord(context.Background(), "record"); err != nil {
+		t.Errorf("Failed waiting for served record: %+v", err)
+	}
+	if lookups != 3 {
+		t.Errorf("Looked up record %d times, expected 3", lookups)
+	}
+
+	m.params.DNSPropagationTimeout = 20 * time.Millisecond
+	if m.waitForRecord(context.Background(), "missing") == nil {
+		t.Errorf("Expected error for record which is never served")
+	}
+}
@@ -51,12 +51,7 @@ func (g *Comms) PutMessage(ctx context.Context, msg *pb.GatewaySlot) (*pb.Gatewa
 	}
 
 	// Upload a message to the cMix Gateway
-	returnMsg, err := g.handler.PutMessage(msg, ipAddr)
-	if err != nil {
-		returnMsg = &pb.GatewaySlotResponse{}
-
-	}
-	return returnMsg, err
+	return slotResponse(g.handler.PutMessage(msg, ipAddr))
 }
 
 // Upload many messages to the cMix Gateway
@@ -69,12 +64,7 @@ func (g *Comms) PutManyMessages(ctx context.Context, msgs *pb.GatewaySlots) (*pb
 	}
 
 	// Upload messages to the cMix Gateway
-	returnMsg, err := g.handler.PutManyMessages(msgs, ipAddr)
-	if err != nil {
-		returnMsg = &pb.GatewaySlotResponse{}
-
-	}
-	return returnMsg, err
+	return slotResponse(g.handler.PutManyMessages(msgs, ipAddr))
 }
 
 // Receives a single message from a gateway proxy
@@ -95,12 +85,7 @@ func (g *Comms) PutMessageProxy(ctx context.Context, msg *messages.Authenticated
 	}
 
 	// Upload a message to the cMix Gateway
-	returnMsg, err := g.handler.PutMessageProxy(slot, authState)
-	if err != nil {
-		returnMsg = &pb.GatewaySlotResponse{}
-
-	}
-	return returnMsg, err
+	return slotResponse(g.handler.PutMessageProxy(slot, authState))
 }
 
 // Upload many messages to the cMix Gateway from a proxy
@@ -121,12 +106,26 @@ func (g *Comms) PutManyMessagesProxy(ctx context.Context, msg *messages.Authenti
 	}
 
 	// Upload messages to the cMix Gateway
-	returnMsg, err := g.handler.PutManyMessagesProxy(slots, authState)
-	if err != nil {
-		returnMsg = &pb.GatewaySlotResponse{}
+	return slotResponse(g.handler.PutManyMessagesProxy(slots, authState))
+}
 
+// slotResponse returns the handler's response to messages sent to the
+// gateway. A response with a rejection reason is returned as a gRPC status
+// error carrying the response, so senders can act on the reason while those
+// predating rejection reasons still see the call fail.
+func slotResponse(resp *pb.GatewaySlotResponse, err error) (
+	*pb.GatewaySlotResponse, error) {
+	if resp.GetRejection() != pb.SlotRejection_NOT_REJECTED {
+		resp.Accepted = false
+		if resp.Error == "" && err != nil {
+			resp.Error = err.Error()
+		}
+		return nil, pb.SlotRejectionStatus(resp)
 	}
-	return returnMsg, err
+	if err != nil {
+		return &pb.GatewaySlotResponse{}, err
+	}
+	return resp, nil
 }
 
 // Client -> Gateway unified polling
@@ -186,3 +185,30 @@ func (g *Comms) RequestTlsCert(ctx context.Context, msg *pb.RequestGatewayCert)
 func (g *Comms) RequestBatchMessages(ctx context.Context, msg *pb.GetMessagesBatch) (*pb.GetMessagesResponseBatch, error) {
 	return g.handler.RequestBatchMessages(msg)
 }
+
+// Gateway -> Gateway push of a round's messages to a replica
+func (g *Comms) ReplicateRound(ctx context.Context, msg *messages.AuthenticatedMessage) (*messages.Ack, error) {
+	// Verify the message authentication
+	authState, err := g.AuthenticatedReceiver(msg, ctx)
+	if err != nil {
+		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
+	}
+
+	// Unnmarshall the any message to the message type needed
+	replica := &pb.RoundReplica{}
+	err = ptypes.UnmarshalAny(msg.Message, replica)
+	if err != nil {
+		return nil, errors.New(err.Error())
+	}
+
+	// Only store replicas from the gateway which downloaded the batch
+	if err = g.verifyPushedReplica(replica, authState); err != nil {
+		return nil, err
+	}
+	return &messages.Ack{}, g.handler.ReplicateRound(replica)
+}
+
+// Gateway -> Gateway request for a replica of a round's messages
+func (g *Comms) RequestRoundReplica(ctx context.Context, msg *pb.GetRoundReplica) (*pb.RoundReplica, error) {
+	return g.handler.RequestRoundReplica(msg)
+}
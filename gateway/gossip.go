@@ -0,0 +1,354 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains typed gossip channels for sharing round data between gateways
+
+package gateway
+
+import (
+	"container/list"
+	"crypto"
+	"crypto/rand"
+	"encoding/binary"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/gossip"
+	"gitlab.com/xx_network/crypto/signature/rsa"
+	"gitlab.com/xx_network/crypto/tls"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/ndf"
+	"sync"
+	"time"
+)
+
+// Tags of the gossip protocols between gateways
+const (
+	BatchSendersGossipTag  = "batchSenders"
+	RecipientsGossipTag    = "recipients"
+	RoundMessagesGossipTag = "roundMessages"
+)
+
+// GossipKeySource returns the public key of the gateway, which its gossip and
+// round replicas are verified against.
+type GossipKeySource func(gwID *id.ID) (*rsa.PublicKey, error)
+
+// NdfGossipKeys returns a GossipKeySource reading the keys from the TLS
+// certificates of the gateways in the NDF returned by getNdf, so updates to
+// the NDF are used.
+func NdfGossipKeys(getNdf func() *ndf.NetworkDefinition) GossipKeySource {
+	var mux sync.Mutex
+	// Parsed keys by certificate
+	keys := make(map[string]*rsa.PublicKey)
+
+	return func(gwID *id.ID) (*rsa.PublicKey, error) {
+		def := getNdf()
+		if def == nil {
+			return nil, errors.New("No NDF to find gateway keys in")
+		}
+		gwBytes := gwID.Bytes()
+		for _, gw := range def.Gateways {
+			if string(gw.ID) != string(gwBytes) {
+				continue
+			}
+
+			mux.Lock()
+			defer mux.Unlock()
+			if key, ok := keys[gw.TlsCertificate]; ok {
+				return key, nil
+			}
+			cert, err := tls.LoadCertificate(gw.TlsCertificate)
+			if err != nil {
+				return nil, errors.WithMessagef(err,
+					"Unable to load certificate of gateway %s", gwID)
+			}
+			key, err := tls.ExtractPublicKey(cert)
+			if err != nil {
+				return nil, errors.WithMessagef(err,
+					"Unable to extract key of gateway %s", gwID)
+			}
+			keys[gw.TlsCertificate] = key
+			return key, nil
+		}
+		return nil, errors.Errorf("Gateway %s is not in the NDF", gwID)
+	}
+}
+
+// GossipParams configures the gossip channels between gateways.
+type GossipParams struct {
+	Flags gossip.ProtocolFlags
+
+	// Number of rounds remembered per channel to drop repeated gossip
+	RoundHistory int
+}
+
+// DefaultGossipParams returns the default GossipParams.
+func DefaultGossipParams() GossipParams {
+	return GossipParams{
+		Flags:        gossip.DefaultProtocolFlags(),
+		RoundHistory: 10000,
+	}
+}
+
+// BatchSendersGossip shares the IDs of the senders in each batch between
+// gateways.
+type BatchSendersGossip struct {
+	*gossipChannel
+}
+
+// NewBatchSendersGossip registers the batch senders gossip protocol, signing
+// with the gateway's key and verifying gossip against keys.
+func (g *Comms) NewBatchSendersGossip(key *rsa.PrivateKey,
+	keys GossipKeySource, peers []*id.ID,
+	params GossipParams) *BatchSendersGossip {
+	return &BatchSendersGossip{g.newGossipChannel(BatchSendersGossipTag, key,
+		keys, peers, params, func() proto.Message { return &pb.BatchSenders{} })}
+}
+
+// Gossip signs and sends the senders to the peers.
+func (b *BatchSendersGossip) Gossip(msg *pb.BatchSenders) error {
+	return b.gossip(msg, msg.GetRoundID())
+}
+
+// OnReceive registers a function called with the senders of each round
+// received from each gateway.
+func (b *BatchSendersGossip) OnReceive(
+	f func(msg *pb.BatchSenders, origin *id.ID)) {
+	b.onReceive(func(msg proto.Message, origin *id.ID) {
+		f(msg.(*pb.BatchSenders), origin)
+	})
+}
+
+// RecipientsGossip shares the IDs of the recipients in each batch between
+// gateways.
+type RecipientsGossip struct {
+	*gossipChannel
+}
+
+// NewRecipientsGossip registers the recipients gossip protocol, signing with
+// the gateway's key and verifying gossip against keys.
+func (g *Comms) NewRecipientsGossip(key *rsa.PrivateKey,
+	keys GossipKeySource, peers []*id.ID,
+	params GossipParams) *RecipientsGossip {
+	return &RecipientsGossip{g.newGossipChannel(RecipientsGossipTag, key,
+		keys, peers, params, func() proto.Message { return &pb.Recipients{} })}
+}
+
+// Gossip signs and sends the recipients to the peers.
+func (r *RecipientsGossip) Gossip(msg *pb.Recipients) error {
+	return r.gossip(msg, msg.GetRoundID())
+}
+
+// OnReceive registers a function called with the recipients of each round
+// received from each gateway.
+func (r *RecipientsGossip) OnReceive(
+	f func(msg *pb.Recipients, origin *id.ID)) {
+	r.onReceive(func(msg proto.Message, origin *id.ID) {
+		f(msg.(*pb.Recipients), origin)
+	})
+}
+
+// RoundMessagesGossip shares the messages of each round between gateways.
+type RoundMessagesGossip struct {
+	*gossipChannel
+}
+
+// NewRoundMessagesGossip registers the round messages gossip protocol,
+// signing with the gateway's key and verifying gossip against keys.
+func (g *Comms) NewRoundMessagesGossip(key *rsa.PrivateKey,
+	keys GossipKeySource, peers []*id.ID,
+	params GossipParams) *RoundMessagesGossip {
+	return &RoundMessagesGossip{g.newGossipChannel(RoundMessagesGossipTag,
+		key, keys, peers, params,
+		func() proto.Message { return &pb.RoundMessages{} })}
+}
+
+// Gossip signs and sends the round's messages to the peers.
+func (r *RoundMessagesGossip) Gossip(msg *pb.RoundMessages) error {
+	return r.gossip(msg, msg.GetRoundId())
+}
+
+// OnReceive registers a function called with the messages of each round
+// received from each gateway.
+func (r *RoundMessagesGossip) OnReceive(
+	f func(msg *pb.RoundMessages, origin *id.ID)) {
+	r.onReceive(func(msg proto.Message, origin *id.ID) {
+		f(msg.(*pb.RoundMessages), origin)
+	})
+}
+
+// gossipChannel signs, verifies and deduplicates a gossip protocol of one
+// type of message. Gossip from the same gateway for a round already received
+// is dropped, as signatures differ each time a message is signed.
+type gossipChannel struct {
+	comms    *Comms
+	tag      string
+	key      *rsa.PrivateKey
+	keys     GossipKeySource
+	newMsg   func() proto.Message
+	protocol *gossip.Protocol
+
+	mux       sync.Mutex
+	received  *roundHistory
+	callbacks []func(msg proto.Message, origin *id.ID)
+}
+
+// newGossipChannel registers the gossip protocol with the tag.
+func (g *Comms) newGossipChannel(tag string, key *rsa.PrivateKey,
+	keys GossipKeySource, peers []*id.ID, params GossipParams,
+	newMsg func() proto.Message) *gossipChannel {
+	c := &gossipChannel{
+		comms:    g,
+		tag:      tag,
+		key:      key,
+		keys:     keys,
+		newMsg:   newMsg,
+		received: newRoundHistory(params.RoundHistory),
+	}
+	g.Manager.NewGossip(tag, params.Flags, c.receive, c.verify, peers)
+	c.protocol, _ = g.Manager.Get(tag)
+	return c
+}
+
+// AddPeer adds the gateway to the peers gossip is sent to.
+func (c *gossipChannel) AddPeer(gwID *id.ID) error {
+	return c.protocol.AddGossipPeer(gwID)
+}
+
+// RemovePeer removes the gateway from the peers gossip is sent to.
+func (c *gossipChannel) RemovePeer(gwID *id.ID) error {
+	return c.protocol.RemoveGossipPeer(gwID)
+}
+
+// onReceive registers a function called with each message received.
+func (c *gossipChannel) onReceive(f func(msg proto.Message, origin *id.ID)) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.callbacks = append(c.callbacks, f)
+}
+
+// gossip signs the message and sends it to the peers.
+func (c *gossipChannel) gossip(msg proto.Message, roundID uint64) error {
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to marshal %s gossip", c.tag)
+	}
+	gossipMsg := &gossip.GossipMsg{
+		Tag:       c.tag,
+		Origin:    c.comms.GetId().Marshal(),
+		Payload:   payload,
+		Timestamp: time.Now().UnixNano(),
+	}
+	gossipMsg.Signature, err = rsa.Sign(rand.Reader, c.key, crypto.SHA256,
+		hashGossip(gossipMsg), nil)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to sign %s gossip", c.tag)
+	}
+
+	numPeers, errs := c.protocol.Gossip(gossipMsg)
+	if len(errs) > 0 {
+		return errors.Errorf("Failed to send %s gossip for round %d to %d "+
+			"of %d peers: %+v", c.tag, roundID, len(errs), numPeers, errs)
+	}
+	return nil
+}
+
+// verify checks the gossip is signed by the key of the gateway it is from.
+func (c *gossipChannel) verify(msg *gossip.GossipMsg, _ []byte) error {
+	origin, err := id.Unmarshal(msg.GetOrigin())
+	if err != nil {
+		return errors.WithMessage(err, "Invalid origin")
+	}
+	key, err := c.keys(origin)
+	if err != nil {
+		return errors.WithMessagef(err, "Unable to get key of %s", origin)
+	}
+	err = rsa.Verify(key, crypto.SHA256, hashGossip(msg), msg.GetSignature(),
+		nil)
+	return errors.WithMessagef(err, "Invalid signature from %s", origin)
+}
+
+// receive passes the verified gossip to the callbacks, unless it is for a
+// round already received from the gateway.
+func (c *gossipChannel) receive(msg *gossip.GossipMsg) error {
+	origin, err := id.Unmarshal(msg.GetOrigin())
+	if err != nil {
+		return errors.WithMessage(err, "Invalid origin")
+	}
+	payload := c.newMsg()
+	if err = proto.Unmarshal(msg.GetPayload(), payload); err != nil {
+		return errors.Wrapf(err, "Unable to unmarshal %s gossip", c.tag)
+	}
+
+	roundID := gossipRoundID(payload)
+	c.mux.Lock()
+	added := c.received.add(origin, roundID)
+	callbacks := c.callbacks
+	c.mux.Unlock()
+	if !added {
+		jww.DEBUG.Printf("Dropping repeated %s gossip for round %d from %s",
+			c.tag, roundID, origin)
+		return nil
+	}
+
+	for _, callback := range callbacks {
+		callback(payload, origin)
+	}
+	return nil
+}
+
+// gossipRoundID returns the ID of the round the gossiped message is for.
+func gossipRoundID(msg proto.Message) uint64 {
+	switch m := msg.(type) {
+	case *pb.BatchSenders:
+		return m.GetRoundID()
+	case *pb.Recipients:
+		return m.GetRoundID()
+	case *pb.RoundMessages:
+		return m.GetRoundId()
+	}
+	return 0
+}
+
+// hashGossip returns the hash of the gossip which is signed.
+func hashGossip(msg *gossip.GossipMsg) []byte {
+	h := crypto.SHA256.New()
+	h.Write(gossip.Marshal(msg))
+	timestamp := make([]byte, 8)
+	binary.BigEndian.PutUint64(timestamp, uint64(msg.GetTimestamp()))
+	h.Write(timestamp)
+	return h.Sum(nil)
+}
+
+// roundHistory remembers the most recent rounds received from each gateway.
+type roundHistory struct {
+	size  int
+	seen  map[string]*list.Element
+	order list.List
+}
+
+// newRoundHistory returns a roundHistory remembering size rounds.
+func newRoundHistory(size int) *roundHistory {
+	return &roundHistory{size: size, seen: make(map[string]*list.Element)}
+}
+
+// add records the round from the gateway, returning false if it was already
+// recorded.
+func (h *roundHistory) add(origin *id.ID, roundID uint64) bool {
+	key := make([]byte, 0, id.ArrIDLen+8)
+	key = append(key, origin.Bytes()...)
+	key = binary.BigEndian.AppendUint64(key, roundID)
+	if _, ok := h.seen[string(key)]; ok {
+		return false
+	}
+	h.seen[string(key)] = h.order.PushBack(string(key))
+	if h.order.Len() > h.size {
+		delete(h.seen, h.order.Remove(h.order.Front()).(string))
+	}
+	return true
+}
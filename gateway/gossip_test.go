@@ -0,0 +1,106 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package gateway
+
+import (
+	"crypto/rand"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/testkeys"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/gossip"
+	"gitlab.com/xx_network/crypto/signature/rsa"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/ndf"
+	"testing"
+	"time"
+)
+
+// Tests that recipients gossip is only received when signed with the key in
+// the NDF, and once per round from each gateway.
+func TestRecipientsGossip(t *testing.T) {
+	senderID := id.NewIdFromString("GossipSender", id.Gateway, t)
+	sender := StartGateway(senderID, getNextGatewayAddress(),
+		NewImplementation(), nil, nil, gossip.DefaultManagerFlags())
+	defer sender.Shutdown()
+	receiverID := id.NewIdFromString("GossipReceiver", id.Gateway, t)
+	receiverAddr := getNextGatewayAddress()
+	receiver := StartGateway(receiverID, receiverAddr, NewImplementation(),
+		nil, nil, gossip.DefaultManagerFlags())
+	defer receiver.Shutdown()
+
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	_, err := sender.AddHost(receiverID, receiverAddr, nil, params)
+	if err != nil {
+		t.Fatalf("Failed to add host: %+v", err)
+	}
+
+	key, err := rsa.LoadPrivateKeyFromPem(testkeys.GetGatewayKey())
+	if err != nil {
+		t.Fatalf("Failed to load key: %+v", err)
+	}
+	wrongKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %+v", err)
+	}
+	def := &ndf.NetworkDefinition{Gateways: []ndf.Gateway{{
+		ID:             senderID.Bytes(),
+		TlsCertificate: string(testkeys.GetGatewayCert()),
+	}}}
+	keys := NdfGossipKeys(func() *ndf.NetworkDefinition { return def })
+
+	received := make(chan uint64, 10)
+	receiverGossip := receiver.NewRecipientsGossip(key, keys, nil,
+		DefaultGossipParams())
+	receiverGossip.OnReceive(func(msg *pb.Recipients, origin *id.ID) {
+		if !origin.Cmp(senderID) {
+			t.Errorf("Received gossip from %s, expected %s", origin, senderID)
+		}
+		received <- msg.GetRoundID()
+	})
+
+	senderGossip := sender.NewRecipientsGossip(key, keys,
+		[]*id.ID{receiverID}, DefaultGossipParams())
+	// Only one protocol can be registered per tag, so share it
+	wrongGossip := &RecipientsGossip{&gossipChannel{
+		comms:    sender,
+		tag:      RecipientsGossipTag,
+		key:      wrongKey,
+		protocol: senderGossip.protocol,
+	}}
+
+	for i, roundID := range []uint64{1, 2, 2, 3} {
+		g := senderGossip
+		if i == 0 {
+			g = wrongGossip
+		}
+		err = g.Gossip(&pb.Recipients{RoundID: roundID})
+		if err != nil {
+			t.Fatalf("Failed to gossip round %d: %+v", roundID, err)
+		}
+	}
+
+	// Gossip is received concurrently, so rounds may arrive in any order
+	expected := map[uint64]bool{2: true, 3: true}
+	for len(expected) > 0 {
+		select {
+		case roundID := <-received:
+			if !expected[roundID] {
+				t.Errorf("Unexpectedly received round %d", roundID)
+			}
+			delete(expected, roundID)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Timed out waiting for rounds %v", expected)
+		}
+	}
+	select {
+	case roundID := <-received:
+		t.Errorf("Unexpectedly received round %d", roundID)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
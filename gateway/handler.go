@@ -12,13 +12,19 @@
 package gateway
 
 import (
+	"context"
 	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/elixxir/comms/certificates"
+	"gitlab.com/elixxir/comms/drain"
 	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/recorder"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/comms/gossip"
 	"gitlab.com/xx_network/comms/messages"
 	"gitlab.com/xx_network/primitives/id"
 	"runtime/debug"
+	"sync"
+	"time"
 )
 
 // Comms object bundles low-level connect.ProtoComms,
@@ -29,9 +35,25 @@ type Comms struct {
 	handler Handler
 	*pb.UnimplementedGatewayServer
 	*messages.UnimplementedGenericServer
+	recorder.Tap
+	drain.Drainer
+	certificates.HttpsServer
+
+	// Splits batches streamed to nodes across parallel streams
+	batchSharding pb.BatchSharding
+
+	// Number of times a mixed batch download is resumed; accessed atomically
+	maxBatchResumes int32
+
+	// Verifies round replicas pushed to the gateway; set by NewReplicator
+	replicatorMux sync.RWMutex
+	replicator    *Replicator
 }
 
-// Handler describes the endpoint callbacks for Gateway.
+// Handler describes the endpoint callbacks for Gateway. The PutMessage
+// callbacks reject messages with a reason the client can act on by setting
+// the Rejection of the returned response, which the client receives as a gRPC
+// status error carrying the response in place of any error returned with it.
 type Handler interface {
 	PutMessage(message *pb.GatewaySlot, ipAddr string) (*pb.GatewaySlotResponse, error)
 	PutManyMessages(msgs *pb.GatewaySlots, ipAddr string) (*pb.GatewaySlotResponse, error)
@@ -44,6 +66,10 @@ type Handler interface {
 	RequestTlsCert(message *pb.RequestGatewayCert) (*pb.GatewayCertificate, error)
 	BatchNodeRegistration(msg *pb.SignedClientBatchKeyRequest) (*pb.SignedBatchKeyResponse, error)
 	RequestBatchMessages(msg *pb.GetMessagesBatch) (*pb.GetMessagesResponseBatch, error)
+	// ReplicateRound is only called with replicas verified by the gateway's
+	// Replicator which were pushed by the gateway that downloaded the batch.
+	ReplicateRound(msg *pb.RoundReplica) error
+	RequestRoundReplica(msg *pb.GetRoundReplica) (*pb.RoundReplica, error)
 }
 
 // StartGateway starts a new gateway on the address:port specified by localServer
@@ -59,40 +85,75 @@ func StartGateway(id *id.ID, localServer string, handler Handler,
 		jww.FATAL.Panicf("Unable to StartCommServer: %+v", err)
 	}
 	gatewayServer := Comms{
-		handler:    handler,
-		ProtoComms: pc,
-		Manager:    gossip.NewManager(pc, gossipFlags),
+		handler:         handler,
+		ProtoComms:      pc,
+		Manager:         gossip.NewManager(pc, gossipFlags),
+		maxBatchResumes: DefaultMaxBatchResumes,
 	}
 
 	// Register the high-level comms endpoint functionality
 	grpcServer := gatewayServer.GetServer()
-	pb.RegisterGatewayServer(grpcServer, &gatewayServer)
+	grpcServer.RegisterService(gatewayServer.TrackService(
+		gatewayServer.WrapService(&pb.Gateway_ServiceDesc)), &gatewayServer)
 	messages.RegisterGenericServer(grpcServer, &gatewayServer)
+	gatewayServer.RegisterHealth(grpcServer)
 	gossip.RegisterGossipServer(grpcServer, gatewayServer.Manager)
 
 	pc.ServeWithWeb()
 	return &gatewayServer
 }
 
-// RestartGateway shuts down &restarts the underlying protocomms server,
-// re-registers grpc handlers & starts basic listeners again.  Intended for use
-// before replacing https certificates
+// GracefulStop drains the calls in flight, cancelling any left once ctx is
+// done, and shuts down the server and its HTTPS listener.
+func (g *Comms) GracefulStop(ctx context.Context) error {
+	err := drain.GracefulStop(ctx, &g.Drainer, g.ProtoComms)
+	g.StopHttps()
+	return err
+}
+
+// restartDrainTimeout bounds how long RestartGateway waits, after the health
+// grace period, for the calls in flight to finish before restarting.
+const restartDrainTimeout = 5 * time.Second
+
+// RestartGateway drains & shuts down the underlying protocomms server, then
+// restarts it, re-registers grpc handlers & starts basic listeners again.
+// The listener of ServeHttpsReloading keeps serving across the restart;
+// certificates it serves are reloaded without restarting.
 func (g *Comms) RestartGateway() error {
-	g.ProtoComms.Shutdown()
-	err := g.ProtoComms.Restart()
+	ctx, cancel := context.WithTimeout(context.Background(),
+		g.GetDrainParams().HealthGrace+restartDrainTimeout)
+	defer cancel()
+	err := drain.GracefulStop(ctx, &g.Drainer, g.ProtoComms)
+	if err != nil {
+		jww.WARN.Printf("Restarting gateway before all calls finished: %+v",
+			err)
+	}
+
+	err = g.ProtoComms.Restart()
 	if err != nil {
 		return err
 	}
 	// Register the high-level comms endpoint functionality
 	grpcServer := g.GetServer()
-	pb.RegisterGatewayServer(grpcServer, g)
+	grpcServer.RegisterService(
+		g.TrackService(g.WrapService(&pb.Gateway_ServiceDesc)), g)
 	messages.RegisterGenericServer(grpcServer, g)
+	g.RegisterHealth(grpcServer)
 	gossip.RegisterGossipServer(grpcServer, g.Manager)
 
 	g.ProtoComms.ServeWithWeb()
 	return nil
 }
 
+// ServeHttpsReloading serves the gRPC-web endpoints of the gateway over HTTPS
+// on a listener of its own at the address, presenting the certificate held by
+// the reloader to each new handshake. Reloaded certificates are served to new
+// connections without restarting the gateway or interrupting existing ones.
+func (g *Comms) ServeHttpsReloading(address string,
+	reloader *certificates.Reloader) error {
+	return g.StartHttps(g.ProtoComms, address, reloader)
+}
+
 // implementationFunctions for the Handler interface.
 type implementationFunctions struct {
 	PutMessage              func(message *pb.GatewaySlot, ipAddr string) (*pb.GatewaySlotResponse, error)
@@ -106,6 +167,8 @@ type implementationFunctions struct {
 	RequestTlsCert          func(message *pb.RequestGatewayCert) (*pb.GatewayCertificate, error)
 	BatchNodeRegistration   func(msg *pb.SignedClientBatchKeyRequest) (*pb.SignedBatchKeyResponse, error)
 	RequestBatchMessages    func(msg *pb.GetMessagesBatch) (*pb.GetMessagesResponseBatch, error)
+	ReplicateRound          func(msg *pb.RoundReplica) error
+	RequestRoundReplica     func(msg *pb.GetRoundReplica) (*pb.RoundReplica, error)
 }
 
 // Implementation allows users of the client library to set the
@@ -167,6 +230,14 @@ func NewImplementation() *Implementation {
 				warn(um)
 				return &pb.GetMessagesResponseBatch{}, nil
 			},
+			ReplicateRound: func(msg *pb.RoundReplica) error {
+				warn(um)
+				return nil
+			},
+			RequestRoundReplica: func(msg *pb.GetRoundReplica) (*pb.RoundReplica, error) {
+				warn(um)
+				return &pb.RoundReplica{}, nil
+			},
 		},
 	}
 }
@@ -223,3 +294,15 @@ func (s *Implementation) BatchNodeRegistration(msg *pb.SignedClientBatchKeyReque
 func (s *Implementation) RequestBatchMessages(msg *pb.GetMessagesBatch) (*pb.GetMessagesResponseBatch, error) {
 	return s.Functions.RequestBatchMessages(msg)
 }
+
+// ReplicateRound stores a verified replica of a round's messages pushed by the
+// gateway in the round's team which downloaded the batch.
+func (s *Implementation) ReplicateRound(msg *pb.RoundReplica) error {
+	return s.Functions.ReplicateRound(msg)
+}
+
+// RequestRoundReplica returns the replica of a round's messages held by the
+// gateway.
+func (s *Implementation) RequestRoundReplica(msg *pb.GetRoundReplica) (*pb.RoundReplica, error) {
+	return s.Functions.RequestRoundReplica(msg)
+}
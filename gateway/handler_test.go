@@ -0,0 +1,110 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package gateway
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/tls"
+	"encoding/pem"
+	"gitlab.com/elixxir/comms/certificates"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/testkeys"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/gossip"
+	"gitlab.com/xx_network/primitives/id"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// Tests that a gateway serving HTTPS from a Reloader serves a reloaded
+// certificate to new handshakes without restarting, while connections made
+// before the reload keep being served.
+func TestComms_ServeHttpsReloading(t *testing.T) {
+	gwAddress := getNextGatewayAddress()
+	httpsAddress := getNextGatewayAddress()
+	gwID := id.NewIdFromString("reloading", id.Gateway, t)
+	gw := StartGateway(gwID, gwAddress, NewImplementation(), nil, nil,
+		gossip.DefaultManagerFlags())
+	defer gw.Shutdown()
+	defer gw.StopHttps()
+	grpcServer := gw.GetServer()
+
+	reloader, err := certificates.NewReloader(testkeys.GetNodeCert(),
+		testkeys.GetNodeKey())
+	if err != nil {
+		t.Fatalf("Failed to create reloader: %+v", err)
+	}
+	if err = gw.ServeHttpsReloading(httpsAddress, reloader); err != nil {
+		t.Fatalf("Failed to serve HTTPS: %+v", err)
+	}
+
+	oldConn := dialHttps(httpsAddress, testkeys.GetNodeCert(), t)
+	defer oldConn.Close()
+
+	err = reloader.Set(testkeys.GetGatewayCert(), testkeys.GetGatewayKey())
+	if err != nil {
+		t.Fatalf("Failed to reload certificate: %+v", err)
+	}
+	newConn := dialHttps(httpsAddress, testkeys.GetGatewayCert(), t)
+	defer newConn.Close()
+
+	// The connection made before the reload is still served
+	checkServed(oldConn, httpsAddress, t)
+
+	if gw.GetServer() != grpcServer {
+		t.Errorf("Gateway was restarted to reload the certificate")
+	}
+
+	senderID := id.NewIdFromString("sender", id.Gateway, t)
+	sender := StartGateway(senderID, getNextGatewayAddress(),
+		NewImplementation(), nil, nil, gossip.DefaultManagerFlags())
+	defer sender.Shutdown()
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := sender.AddHost(gwID, gwAddress, nil, params)
+	if err != nil {
+		t.Fatalf("Failed to add host: %+v", err)
+	}
+	_, err = sender.SendPutMessageProxy(host, &pb.GatewaySlot{}, 5*time.Second)
+	if err != nil {
+		t.Errorf("Gateway not serving after reloading certificate: %+v", err)
+	}
+}
+
+// dialHttps completes a TLS handshake with the address, checking that the
+// certificate served is the expected one.
+func dialHttps(address string, expected []byte, t *testing.T) *tls.Conn {
+	conn, err := tls.Dial("tcp", address, &tls.Config{
+		InsecureSkipVerify: true,
+		NextProtos:         []string{"http/1.1"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to dial HTTPS: %+v", err)
+	}
+	block, _ := pem.Decode(expected)
+	served := conn.ConnectionState().PeerCertificates
+	if len(served) == 0 || !bytes.Equal(served[0].Raw, block.Bytes) {
+		t.Errorf("HTTPS served an unexpected certificate")
+	}
+	return conn
+}
+
+// checkServed checks that an HTTPS request over the connection is answered.
+func checkServed(conn *tls.Conn, address string, t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "https://"+address, nil)
+	if err := req.Write(conn); err != nil {
+		t.Fatalf("Failed to write request: %+v", err)
+	}
+	resp, err := http.ReadResponse(bufio.NewReader(conn), req)
+	if err != nil {
+		t.Fatalf("Connection not served: %+v", err)
+	}
+	_ = resp.Body.Close()
+}
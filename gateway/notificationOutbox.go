@@ -0,0 +1,357 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the outbox which durably queues notification batches for the
+// notification bot
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const outboxFileExt = ".batch"
+
+// deadLetterDir is the subdirectory of the outbox which batches the
+// notification bot rejects permanently are moved to.
+const deadLetterDir = "dead"
+
+// OutboxParams configures a NotificationOutbox.
+type OutboxParams struct {
+	// Directory the queued batches are stored in. Batches the notification
+	// bot rejects permanently are moved to its "dead" subdirectory.
+	Dir string
+
+	// Time waited before retrying a failed delivery. It doubles with each
+	// consecutive failure, up to MaxBackoff.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+
+	// Maximum number of batches queued; zero is unlimited
+	MaxQueued int
+}
+
+// DefaultOutboxParams returns the default OutboxParams, storing batches in
+// the directory.
+func DefaultOutboxParams(dir string) OutboxParams {
+	return OutboxParams{
+		Dir:        dir,
+		MinBackoff: 100 * time.Millisecond,
+		MaxBackoff: time.Minute,
+		MaxQueued:  10000,
+	}
+}
+
+// OutboxStats reports the state of a NotificationOutbox.
+type OutboxStats struct {
+	// Number of batches waiting to be delivered
+	Queued int
+
+	// Time the oldest queued batch has waited
+	OldestAge time.Duration
+
+	// Totals since the outbox was created
+	Delivered    uint64
+	Retries      uint64
+	Dropped      uint64
+	DeadLettered uint64
+}
+
+// NotificationOutbox queues notification batches on disk and delivers them to
+// the notification bot in order, retrying with backoff until each succeeds or
+// is rejected permanently. Batches left queued when the gateway stops are
+// delivered once a new outbox is started on the same directory.
+type NotificationOutbox struct {
+	comms  *Comms
+	host   *connect.Host
+	params OutboxParams
+
+	mux          sync.Mutex
+	queue        []outboxEntry
+	next         int64
+	delivered    uint64
+	retries      uint64
+	dropped      uint64
+	deadLettered uint64
+
+	// Signalled when a batch is enqueued
+	wake chan struct{}
+}
+
+// outboxEntry is a batch stored on disk.
+type outboxEntry struct {
+	path     string
+	enqueued time.Time
+}
+
+// NewNotificationOutbox returns a NotificationOutbox delivering to the
+// notification bot host, loading any batches queued in the directory.
+func (g *Comms) NewNotificationOutbox(host *connect.Host,
+	params OutboxParams) (*NotificationOutbox, error) {
+	if err := os.MkdirAll(params.Dir, 0700); err != nil {
+		return nil, errors.Wrap(err, "Unable to create outbox directory")
+	}
+	files, err := os.ReadDir(params.Dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to read outbox directory")
+	}
+
+	o := &NotificationOutbox{
+		comms:  g,
+		host:   host,
+		params: params,
+		wake:   make(chan struct{}, 1),
+	}
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		path := filepath.Join(params.Dir, f.Name())
+		if !strings.HasSuffix(f.Name(), outboxFileExt) {
+			// Remove batches whose write was interrupted
+			if strings.HasSuffix(f.Name(), ".tmp") {
+				_ = os.Remove(path)
+			}
+			continue
+		}
+		var seq, enqueued int64
+		_, err = fmt.Sscanf(f.Name(), "%d-%d"+outboxFileExt, &seq, &enqueued)
+		if err != nil {
+			jww.WARN.Printf("Ignoring unknown file %s in outbox", path)
+			continue
+		}
+		o.queue = append(o.queue,
+			outboxEntry{path: path, enqueued: time.Unix(0, enqueued)})
+		if seq >= o.next {
+			o.next = seq + 1
+		}
+	}
+	// File names start with a zero-padded sequence number
+	sort.Slice(o.queue, func(i, j int) bool {
+		return o.queue[i].path < o.queue[j].path
+	})
+	if len(o.queue) > 0 {
+		jww.INFO.Printf("Loaded %d queued notification batches", len(o.queue))
+	}
+	return o, nil
+}
+
+// Enqueue stores the batch on disk to be delivered. It returns an error if
+// the batch cannot be stored or the outbox is full.
+func (o *NotificationOutbox) Enqueue(batch *pb.NotificationBatch) error {
+	data, err := proto.Marshal(batch)
+	if err != nil {
+		return errors.Wrap(err, "Unable to marshal notification batch")
+	}
+
+	o.mux.Lock()
+	defer o.mux.Unlock()
+	if o.params.MaxQueued > 0 && len(o.queue) >= o.params.MaxQueued {
+		o.dropped++
+		return errors.Errorf("Outbox is full with %d batches, dropped "+
+			"batch for round %d", len(o.queue), batch.GetRoundID())
+	}
+
+	now := time.Now()
+	path := filepath.Join(o.params.Dir,
+		fmt.Sprintf("%020d-%d%s", o.next, now.UnixNano(), outboxFileExt))
+	if err = writeFileSync(path, data); err != nil {
+		return errors.WithMessagef(err,
+			"Unable to store batch for round %d", batch.GetRoundID())
+	}
+	o.next++
+	o.queue = append(o.queue, outboxEntry{path: path, enqueued: now})
+
+	select {
+	case o.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Start delivers the queued batches until the returned function is called.
+func (o *NotificationOutbox) Start() (stop func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		backoff := o.params.MinBackoff
+		for {
+			entry, ok := o.head()
+			if !ok {
+				select {
+				case <-o.wake:
+					continue
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			if err := o.deliver(entry); err != nil {
+				o.mux.Lock()
+				o.retries++
+				o.mux.Unlock()
+				jww.WARN.Printf("Delivering notification batch failed, "+
+					"retrying in %s: %+v", backoff, err)
+				select {
+				case <-time.After(backoff):
+				case <-ctx.Done():
+					return
+				}
+				if backoff *= 2; backoff > o.params.MaxBackoff {
+					backoff = o.params.MaxBackoff
+				}
+				continue
+			}
+			backoff = o.params.MinBackoff
+		}
+	}()
+	return cancel
+}
+
+// Stats returns the current state of the outbox.
+func (o *NotificationOutbox) Stats() OutboxStats {
+	o.mux.Lock()
+	defer o.mux.Unlock()
+	stats := OutboxStats{
+		Queued:       len(o.queue),
+		Delivered:    o.delivered,
+		Retries:      o.retries,
+		Dropped:      o.dropped,
+		DeadLettered: o.deadLettered,
+	}
+	if len(o.queue) > 0 {
+		stats.OldestAge = time.Since(o.queue[0].enqueued)
+	}
+	return stats
+}
+
+// head returns the oldest queued batch.
+func (o *NotificationOutbox) head() (outboxEntry, bool) {
+	o.mux.Lock()
+	defer o.mux.Unlock()
+	if len(o.queue) == 0 {
+		return outboxEntry{}, false
+	}
+	return o.queue[0], true
+}
+
+// deliver sends the batch to the notification bot, removing it from the queue
+// once delivered. Batches which cannot be read are dropped and batches the
+// notification bot rejects permanently are dead-lettered, so they do not hold
+// up the queue.
+func (o *NotificationOutbox) deliver(entry outboxEntry) error {
+	batch := &pb.NotificationBatch{}
+	data, err := os.ReadFile(entry.path)
+	if err == nil {
+		err = proto.Unmarshal(data, batch)
+	}
+	if err != nil {
+		jww.ERROR.Printf("Dropping unreadable notification batch %s: %+v",
+			entry.path, err)
+		o.remove(entry, false)
+		return nil
+	}
+
+	err = o.comms.SendNotificationBatch(o.host, batch)
+	if err != nil && isPermanentDeliveryError(err) {
+		jww.ERROR.Printf("Notification bot rejected batch for round %d, "+
+			"dead-lettering it: %+v", batch.GetRoundID(), err)
+		o.deadLetter(entry)
+		return nil
+	} else if err != nil {
+		return err
+	}
+	o.remove(entry, true)
+	return nil
+}
+
+// isPermanentDeliveryError returns true if the gRPC status of the error means
+// the notification bot will never accept the batch. Other errors, such as the
+// notification bot being unavailable or failing to handle the batch, are
+// retried.
+func isPermanentDeliveryError(err error) bool {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.PermissionDenied, codes.Unimplemented,
+		codes.OutOfRange:
+		return true
+	default:
+		return false
+	}
+}
+
+// deadLetter moves the batch out of the queue into the dead letter directory,
+// where it is kept for inspection.
+func (o *NotificationOutbox) deadLetter(entry outboxEntry) {
+	dir := filepath.Join(o.params.Dir, deadLetterDir)
+	err := os.MkdirAll(dir, 0700)
+	if err == nil {
+		err = os.Rename(entry.path,
+			filepath.Join(dir, filepath.Base(entry.path)))
+	}
+	if err != nil {
+		jww.ERROR.Printf("Unable to dead-letter notification batch %s, "+
+			"dropping it: %+v", entry.path, err)
+		o.remove(entry, false)
+		return
+	}
+
+	o.mux.Lock()
+	defer o.mux.Unlock()
+	o.queue = o.queue[1:]
+	o.deadLettered++
+}
+
+// remove deletes the batch from disk and the queue.
+func (o *NotificationOutbox) remove(entry outboxEntry, delivered bool) {
+	if err := os.Remove(entry.path); err != nil && !os.IsNotExist(err) {
+		jww.ERROR.Printf("Unable to remove notification batch %s: %+v",
+			entry.path, err)
+	}
+
+	o.mux.Lock()
+	defer o.mux.Unlock()
+	o.queue = o.queue[1:]
+	if delivered {
+		o.delivered++
+	} else {
+		o.dropped++
+	}
+}
+
+// writeFileSync writes the file through a temporary file which is synced to
+// disk and renamed, so the file is never left partially written.
+func writeFileSync(path string, data []byte) error {
+	tmp := path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(data); err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,224 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package gateway
+
+import (
+	"github.com/pkg/errors"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/notificationBot"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/gossip"
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// Tests that batches queued while the notification bot is down are kept
+// across outbox restarts and delivered once, in order, when it comes up.
+func TestNotificationOutbox(t *testing.T) {
+	gwID := id.NewIdFromString("TestGatewayID", id.Gateway, t)
+	gateway := StartGateway(gwID, getNextGatewayAddress(), NewImplementation(),
+		nil, nil, gossip.DefaultManagerFlags())
+	defer gateway.Shutdown()
+
+	nbAddr := getNextServerAddress()
+	nbID := &id.NotificationBot
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	// Fail quickly while the notification bot is down
+	params.MaxRetries = 1
+	params.MaxSendRetries = 1
+	host, err := connect.NewManagerTesting(t).AddHost(nbID, nbAddr, nil, params)
+	if err != nil {
+		t.Fatalf("Failed to add host: %+v", err)
+	}
+
+	outboxParams := DefaultOutboxParams(t.TempDir())
+	outboxParams.MinBackoff = 10 * time.Millisecond
+	outboxParams.MaxBackoff = 100 * time.Millisecond
+	outbox, err := gateway.NewNotificationOutbox(host, outboxParams)
+	if err != nil {
+		t.Fatalf("Failed to create outbox: %+v", err)
+	}
+	for _, roundID := range []uint64{1, 2, 2} {
+		if err = outbox.Enqueue(&pb.NotificationBatch{RoundID: roundID}); err != nil {
+			t.Fatalf("Failed to enqueue batch: %+v", err)
+		}
+	}
+
+	// Reload the queue from disk
+	outbox, err = gateway.NewNotificationOutbox(host, outboxParams)
+	if err != nil {
+		t.Fatalf("Failed to reload outbox: %+v", err)
+	}
+	if queued := outbox.Stats().Queued; queued != 3 {
+		t.Fatalf("Reloaded outbox has %d batches queued, expected 3", queued)
+	}
+
+	stop := outbox.Start()
+	defer stop()
+	for outbox.Stats().Retries == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	impl := notificationBot.NewImplementation()
+	received := make(chan uint64, 10)
+	impl.Functions.ReceiveNotificationBatch = func(
+		batch *pb.NotificationBatch, auth *connect.Auth) error {
+		received <- batch.GetRoundID()
+		return nil
+	}
+	nb := notificationBot.StartNotificationBot(nbID, nbAddr, impl, nil, nil)
+	defer nb.Shutdown()
+
+	for _, expected := range []uint64{1, 2} {
+		select {
+		case roundID := <-received:
+			if roundID != expected {
+				t.Errorf("Received round %d, expected %d", roundID, expected)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Batch for round %d was not delivered", expected)
+		}
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for outbox.Stats().Queued != 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	stats := outbox.Stats()
+	if stats.Queued != 0 || stats.Delivered != 3 || stats.OldestAge != 0 {
+		t.Errorf("Unexpected stats after delivery: %+v", stats)
+	}
+	select {
+	case roundID := <-received:
+		t.Errorf("Duplicate batch for round %d was handled", roundID)
+	default:
+	}
+
+	files, err := os.ReadDir(outboxParams.Dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 0 {
+		t.Errorf("%d files left in outbox after delivery", len(files))
+	}
+}
+
+// Tests that batches the notification bot rejects permanently are
+// dead-lettered without holding up the queue, while batches it fails to
+// handle are retried.
+func TestNotificationOutbox_DeadLetter(t *testing.T) {
+	gwID := id.NewIdFromString("TestGatewayID", id.Gateway, t)
+	gateway := StartGateway(gwID, getNextGatewayAddress(), NewImplementation(),
+		nil, nil, gossip.DefaultManagerFlags())
+	defer gateway.Shutdown()
+
+	nbAddr := getNextServerAddress()
+	nbID := &id.NotificationBot
+	impl := notificationBot.NewImplementation()
+	received := make(chan uint64, 10)
+	failures := 0
+	impl.Functions.ReceiveNotificationBatch = func(
+		batch *pb.NotificationBatch, auth *connect.Auth) error {
+		switch batch.GetRoundID() {
+		case 1:
+			return status.Error(codes.InvalidArgument, "malformed batch")
+		case 2:
+			if failures++; failures < 3 {
+				return errors.New("database unavailable")
+			}
+		}
+		received <- batch.GetRoundID()
+		return nil
+	}
+	nb := notificationBot.StartNotificationBot(nbID, nbAddr, impl, nil, nil)
+	defer nb.Shutdown()
+
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := connect.NewManagerTesting(t).AddHost(nbID, nbAddr, nil, params)
+	if err != nil {
+		t.Fatalf("Failed to add host: %+v", err)
+	}
+
+	outboxParams := DefaultOutboxParams(t.TempDir())
+	outboxParams.MinBackoff = 10 * time.Millisecond
+	outbox, err := gateway.NewNotificationOutbox(host, outboxParams)
+	if err != nil {
+		t.Fatalf("Failed to create outbox: %+v", err)
+	}
+	for _, roundID := range []uint64{1, 2, 3} {
+		if err = outbox.Enqueue(&pb.NotificationBatch{RoundID: roundID}); err != nil {
+			t.Fatalf("Failed to enqueue batch: %+v", err)
+		}
+	}
+	stop := outbox.Start()
+	defer stop()
+
+	for _, expected := range []uint64{2, 3} {
+		select {
+		case roundID := <-received:
+			if roundID != expected {
+				t.Errorf("Received round %d, expected %d", roundID, expected)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Batch for round %d was not delivered", expected)
+		}
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for outbox.Stats().Queued != 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	stats := outbox.Stats()
+	if stats.Queued != 0 || stats.Delivered != 2 || stats.DeadLettered != 1 ||
+		stats.Retries != 2 {
+		t.Errorf("Unexpected stats after delivery: %+v", stats)
+	}
+
+	dead, err := os.ReadDir(filepath.Join(outboxParams.Dir, deadLetterDir))
+	if err != nil || len(dead) != 1 {
+		t.Errorf("Expected 1 dead-lettered batch, found %d: %+v",
+			len(dead), err)
+	}
+
+	// Dead-lettered batches are not loaded into a new outbox
+	outbox, err = gateway.NewNotificationOutbox(host, outboxParams)
+	if err != nil {
+		t.Fatalf("Failed to reload outbox: %+v", err)
+	}
+	if queued := outbox.Stats().Queued; queued != 0 {
+		t.Errorf("Reloaded outbox has %d batches queued, expected 0", queued)
+	}
+}
+
+// Tests that Enqueue rejects batches once the outbox is full.
+func TestNotificationOutbox_Full(t *testing.T) {
+	gateway := &Comms{}
+	params := DefaultOutboxParams(t.TempDir())
+	params.MaxQueued = 1
+	outbox, err := gateway.NewNotificationOutbox(nil, params)
+	if err != nil {
+		t.Fatalf("Failed to create outbox: %+v", err)
+	}
+
+	if err = outbox.Enqueue(&pb.NotificationBatch{RoundID: 1}); err != nil {
+		t.Errorf("Failed to enqueue batch: %+v", err)
+	}
+	if err = outbox.Enqueue(&pb.NotificationBatch{RoundID: 2}); err == nil {
+		t.Errorf("Batch was enqueued in a full outbox")
+	}
+	if stats := outbox.Stats(); stats.Queued != 1 || stats.Dropped != 1 {
+		t.Errorf("Unexpected stats: %+v", stats)
+	}
+}
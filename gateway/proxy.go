@@ -51,7 +51,8 @@ func (g *Comms) SendRequestClientKey(host *connect.Host,
 	return result, ptypes.UnmarshalAny(resultMsg, result)
 }
 
-// Gateway -> Gateway forward client PutMessage.
+// Gateway -> Gateway forward client PutMessage. If the gateway rejects the
+// message, the response is returned with a *mixmessages.SlotRejectedError.
 func (g *Comms) SendPutMessageProxy(host *connect.Host, messages *pb.GatewaySlot, timeout time.Duration) (*pb.GatewaySlotResponse, error) {
 
 	// Create the Send Function
@@ -84,7 +85,7 @@ func (g *Comms) SendPutMessageProxy(host *connect.Host, messages *pb.GatewaySlot
 	jww.TRACE.Printf("Sending client PutMessage: %+v", messages)
 	resultMsg, err := g.Send(host, f)
 	if err != nil {
-		return nil, err
+		return pb.CheckSlotError(err)
 	}
 
 	// Marshall the result
@@ -92,7 +93,8 @@ func (g *Comms) SendPutMessageProxy(host *connect.Host, messages *pb.GatewaySlot
 	return result, ptypes.UnmarshalAny(resultMsg, result)
 }
 
-// Gateway -> Gateway forward client PutManyMessages.
+// Gateway -> Gateway forward client PutManyMessages. If the gateway rejects
+// the messages, the response is returned with a *mixmessages.SlotRejectedError.
 func (g *Comms) SendPutManyMessagesProxy(host *connect.Host, messages *pb.GatewaySlots, timeout time.Duration) (*pb.GatewaySlotResponse, error) {
 
 	// Create the Send Function
@@ -125,7 +127,7 @@ func (g *Comms) SendPutManyMessagesProxy(host *connect.Host, messages *pb.Gatewa
 	jww.TRACE.Printf("Sending client PutMessage: %+v", messages)
 	resultMsg, err := g.Send(host, f)
 	if err != nil {
-		return nil, err
+		return pb.CheckSlotError(err)
 	}
 
 	// Marshall the result
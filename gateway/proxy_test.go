@@ -70,6 +70,60 @@ func TestComms_SendPutManyMessages(t *testing.T) {
 	}
 }
 
+// Tests that proxied messages rejected by the receiving gateway are returned
+// with a SlotRejectedError.
+func TestComms_SendPutMessageProxy_Rejected(t *testing.T) {
+	gwAddress1 := getNextGatewayAddress()
+	gwAddress2 := getNextGatewayAddress()
+	testID1 := id.NewIdFromString("test1", id.Gateway, t)
+	testID2 := id.NewIdFromString("test2", id.Gateway, t)
+	rejection := &pb.GatewaySlotResponse{
+		RoundID:   5,
+		Rejection: pb.SlotRejection_RATE_LIMITED,
+		Error:     "rate limited",
+	}
+	impl := NewImplementation()
+	impl.Functions.PutMessageProxy = func(message *pb.GatewaySlot,
+		auth *connect.Auth) (*pb.GatewaySlotResponse, error) {
+		return rejection, nil
+	}
+	impl.Functions.PutManyMessagesProxy = func(msgs *pb.GatewaySlots,
+		auth *connect.Auth) (*pb.GatewaySlotResponse, error) {
+		return rejection, nil
+	}
+	gw1 := StartGateway(testID1, gwAddress1, NewImplementation(), nil, nil,
+		gossip.DefaultManagerFlags())
+	gw2 := StartGateway(testID2, gwAddress2, impl, nil, nil,
+		gossip.DefaultManagerFlags())
+	defer gw1.Shutdown()
+	defer gw2.Shutdown()
+	manager := connect.NewManagerTesting(t)
+
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(testID1, gwAddress2, nil, params)
+	if err != nil {
+		t.Fatalf("Failed to add host to manager: %+v", err)
+	}
+
+	resp, err := gw1.SendPutMessageProxy(host, &pb.GatewaySlot{}, 2*time.Minute)
+	if rejected, ok := err.(*pb.SlotRejectedError); !ok ||
+		rejected.Reason != pb.SlotRejection_RATE_LIMITED {
+		t.Errorf("SendPutMessageProxy: Expected SlotRejectedError, "+
+			"received: %+v", err)
+	}
+	if resp.GetRoundID() != 5 {
+		t.Errorf("SendPutMessageProxy: Response not returned with the "+
+			"rejection: %+v", resp)
+	}
+
+	_, err = gw1.SendPutManyMessagesProxy(host, &pb.GatewaySlots{}, 2*time.Minute)
+	if _, ok := err.(*pb.SlotRejectedError); !ok {
+		t.Errorf("SendPutManyMessagesProxy: Expected SlotRejectedError, "+
+			"received: %+v", err)
+	}
+}
+
 // Smoke test.
 func TestComms_SendRequestNonce(t *testing.T) {
 	gwAddress1 := getNextGatewayAddress()
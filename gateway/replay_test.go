@@ -0,0 +1,86 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package gateway
+
+import (
+	"bytes"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/recorder"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/gossip"
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/protobuf/proto"
+	"testing"
+	"time"
+)
+
+// Tests that traffic recorded on a running gateway replays into a new
+// Handler with the same messages.
+func TestReplayCapture(t *testing.T) {
+	gwAddress1 := getNextGatewayAddress()
+	gwAddress2 := getNextGatewayAddress()
+	testID1 := id.NewIdFromString("test1", id.Gateway, t)
+	testID2 := id.NewIdFromString("test2", id.Gateway, t)
+	gw1 := StartGateway(testID1, gwAddress1, NewImplementation(), nil, nil,
+		gossip.DefaultManagerFlags())
+	gw2 := StartGateway(testID2, gwAddress2, NewImplementation(), nil, nil,
+		gossip.DefaultManagerFlags())
+	defer gw1.Shutdown()
+	defer gw2.Shutdown()
+
+	var capture bytes.Buffer
+	rec, err := recorder.NewRecorder(&capture, recorder.NewKeyMaterialRedactor())
+	if err != nil {
+		t.Fatalf("Failed to create recorder: %+v", err)
+	}
+	gw2.SetRecorder(rec)
+
+	manager := connect.NewManagerTesting(t)
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(testID1, gwAddress2, nil, params)
+	if err != nil {
+		t.Fatalf("Failed to add host to manager: %+v", err)
+	}
+
+	slot := &pb.GatewaySlot{RoundID: 42, Message: &pb.Slot{PayloadA: []byte("A")}}
+	_, err = gw1.SendPutMessageProxy(host, slot, 2*time.Minute)
+	if err != nil {
+		t.Fatalf("SendPutMessageProxy produced an error: %+v", err)
+	}
+	gw2.SetRecorder(nil)
+
+	var replayed []*pb.GatewaySlot
+	impl := NewImplementation()
+	impl.Functions.PutMessageProxy = func(message *pb.GatewaySlot,
+		auth *connect.Auth) (*pb.GatewaySlotResponse, error) {
+		replayed = append(replayed, message)
+		return &pb.GatewaySlotResponse{}, nil
+	}
+
+	pc, err := connect.CreateCommClient(testID2, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to create comms: %+v", err)
+	}
+	results, err := ReplayCapture(pc, impl, &capture)
+	if err != nil {
+		t.Fatalf("ReplayCapture produced an error: %+v", err)
+	}
+
+	if len(results) != 1 || len(replayed) != 1 {
+		t.Fatalf("Expected one replayed call, got %d results and %d calls",
+			len(results), len(replayed))
+	}
+	if !proto.Equal(replayed[0], slot) {
+		t.Errorf("Unexpected replayed slot.\nexpected: %v\nreceived: %v",
+			slot, replayed[0])
+	}
+	if !results[0].Matches() {
+		t.Errorf("Replay does not match recording: %+v", results[0])
+	}
+}
@@ -0,0 +1,411 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the replication of round messages between the gateways of a team
+
+package gateway
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"encoding/binary"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/network"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/crypto/signature/rsa"
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sync"
+	"time"
+)
+
+// ReplicationParams configures the replication of rounds between gateways.
+type ReplicationParams struct {
+	// Number of other gateways in the round's team each round is pushed to
+	Replicas int
+
+	// Timeout of each push to and pull from another gateway
+	Timeout time.Duration
+}
+
+// DefaultReplicationParams returns the default ReplicationParams.
+func DefaultReplicationParams() ReplicationParams {
+	return ReplicationParams{
+		Replicas: 2,
+		Timeout:  30 * time.Second,
+	}
+}
+
+// RoundTopologySource returns the topology of a round, the IDs of its nodes
+// in the order they process its batch.
+type RoundTopologySource func(roundID uint64) ([]*id.ID, error)
+
+// Replicator pushes the messages of rounds downloaded by the gateway to other
+// gateways in the round's team, and pulls rounds the gateway does not hold
+// from them. Every replica is signed by the gateway which downloaded the
+// batch, the gateway of the last node in the round's topology, and verified
+// against the digests of its slots.
+type Replicator struct {
+	comms      *Comms
+	key        *rsa.PrivateKey
+	keys       GossipKeySource
+	topologies RoundTopologySource
+	params     ReplicationParams
+}
+
+// NewReplicator returns a Replicator signing with the gateway's key and
+// verifying replicas against keys and the topologies of their rounds. Hosts
+// must be added for the gateways replicas are pushed to and pulled from, with
+// authentication enabled. The gateway rejects replicas pushed to it until a
+// Replicator is created, after which they are verified by the latest one.
+func (g *Comms) NewReplicator(key *rsa.PrivateKey, keys GossipKeySource,
+	topologies RoundTopologySource, params ReplicationParams) *Replicator {
+	r := &Replicator{
+		comms:      g,
+		key:        key,
+		keys:       keys,
+		topologies: topologies,
+		params:     params,
+	}
+	g.replicatorMux.Lock()
+	g.replicator = r
+	g.replicatorMux.Unlock()
+	return r
+}
+
+// Push signs the round's messages and pushes them to the gateway's replicas
+// in the round's topology, returning the replica for the gateway to serve to
+// RequestRoundReplica. The replica is returned along with an error if any of
+// the pushes failed.
+func (r *Replicator) Push(round *pb.RoundMessages) (*pb.RoundReplica, error) {
+	topology, err := r.topologies(round.GetRoundId())
+	if err != nil {
+		return nil, errors.WithMessagef(err,
+			"Unable to get topology of round %d", round.GetRoundId())
+	}
+	if origin := batchGateway(topology); !origin.Cmp(r.comms.GetId()) {
+		return nil, errors.Errorf("Round %d is replicated by %s, the "+
+			"gateway which downloaded its batch", round.GetRoundId(), origin)
+	}
+	replica, err := NewRoundReplica(r.key, r.comms.GetId(), round)
+	if err != nil {
+		return nil, err
+	}
+
+	replicas := ReplicaGateways(topology, r.comms.GetId(), r.params.Replicas)
+	var wg sync.WaitGroup
+	errs := make([]error, len(replicas))
+	for i, gwID := range replicas {
+		wg.Add(1)
+		go func(i int, gwID *id.ID) {
+			defer wg.Done()
+			host, ok := r.comms.GetHost(gwID)
+			if !ok {
+				errs[i] = errors.Errorf("No host for gateway %s", gwID)
+				return
+			}
+			_, errs[i] = r.comms.SendReplicateRound(host, replica,
+				r.params.Timeout)
+		}(i, gwID)
+	}
+	wg.Wait()
+
+	var failed []error
+	for i, err := range errs {
+		if err != nil {
+			failed = append(failed, errors.WithMessagef(err,
+				"Failed to push to %s", replicas[i]))
+		}
+	}
+	if len(failed) > 0 {
+		return replica, errors.Errorf("Failed to push round %d to %d of %d "+
+			"replicas: %+v", round.GetRoundId(), len(failed), len(replicas),
+			failed)
+	}
+	return replica, nil
+}
+
+// Pull requests the round from the other gateways in its topology, returning
+// the first verified replica.
+func (r *Replicator) Pull(roundID uint64) (*pb.RoundReplica, error) {
+	topology, err := r.topologies(roundID)
+	if err != nil {
+		return nil, errors.WithMessagef(err,
+			"Unable to get topology of round %d", roundID)
+	}
+
+	var errs []error
+	for _, gwID := range teamGateways(topology) {
+		if gwID.Cmp(r.comms.GetId()) {
+			continue
+		}
+		host, ok := r.comms.GetHost(gwID)
+		if !ok {
+			continue
+		}
+
+		replica, err := r.comms.SendRequestRoundReplica(host,
+			&pb.GetRoundReplica{RoundId: roundID}, r.params.Timeout)
+		if err == nil && replica.GetRound().GetRoundId() != roundID {
+			err = errors.Errorf("Returned round %d",
+				replica.GetRound().GetRoundId())
+		}
+		if err == nil {
+			err = VerifyRoundReplica(replica, topology, r.keys)
+		}
+		if err != nil {
+			jww.DEBUG.Printf("Unable to pull round %d from %s: %+v", roundID,
+				gwID, err)
+			errs = append(errs, errors.WithMessagef(err,
+				"Failed to pull from %s", gwID))
+			continue
+		}
+		return replica, nil
+	}
+	return nil, errors.Errorf("No gateway returned a valid replica of round "+
+		"%d: %+v", roundID, errs)
+}
+
+// Verify checks the replica is signed by the gateway which downloaded the
+// batch of its round and its slots match their digests.
+func (r *Replicator) Verify(replica *pb.RoundReplica) error {
+	roundID := replica.GetRound().GetRoundId()
+	topology, err := r.topologies(roundID)
+	if err != nil {
+		return errors.WithMessagef(err,
+			"Unable to get topology of round %d", roundID)
+	}
+	return VerifyRoundReplica(replica, topology, r.keys)
+}
+
+// verifyPushedReplica checks the replica was pushed by the gateway which
+// downloaded the batch of its round and is verified by the gateway's
+// Replicator.
+func (g *Comms) verifyPushedReplica(replica *pb.RoundReplica,
+	auth *connect.Auth) error {
+	if !auth.IsAuthenticated {
+		return connect.AuthError(auth.Sender.GetId())
+	}
+	g.replicatorMux.RLock()
+	r := g.replicator
+	g.replicatorMux.RUnlock()
+	if r == nil {
+		return status.Error(codes.Unavailable,
+			"gateway is not accepting round replicas")
+	}
+
+	if !bytes.Equal(auth.Sender.GetId().Marshal(), replica.GetOrigin()) {
+		return errors.Errorf("Replica of round %d pushed by %s rather "+
+			"than its origin", replica.GetRound().GetRoundId(),
+			auth.Sender.GetId())
+	}
+	return r.Verify(replica)
+}
+
+// NewRoundReplica returns a replica of the round's messages signed by the
+// gateway which downloaded the batch.
+func NewRoundReplica(key *rsa.PrivateKey, origin *id.ID,
+	round *pb.RoundMessages) (*pb.RoundReplica, error) {
+	replica := &pb.RoundReplica{
+		Round:   round,
+		Digests: make([][]byte, len(round.GetMessages())),
+		Origin:  origin.Marshal(),
+	}
+	for i, slot := range round.GetMessages() {
+		replica.Digests[i] = network.GenerateReplicaSlotDigest(
+			round.GetRoundId(), slot)
+	}
+
+	var err error
+	replica.Signature, err = rsa.Sign(rand.Reader, key, crypto.SHA256,
+		hashRoundReplica(replica), nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to sign replica of round %d",
+			round.GetRoundId())
+	}
+	return replica, nil
+}
+
+// VerifyRoundReplica checks the replica is from the gateway which downloaded
+// the batch of its round, the gateway of the last node in the topology, is
+// signed by it, using its key from keys, and its slots match their digests.
+func VerifyRoundReplica(replica *pb.RoundReplica, topology []*id.ID,
+	keys GossipKeySource) error {
+	round := replica.GetRound()
+	if round == nil {
+		return errors.New("Replica has no round")
+	}
+	if len(topology) == 0 {
+		return errors.Errorf("Round %d has no topology", round.GetRoundId())
+	}
+	if len(replica.GetDigests()) != len(round.GetMessages()) {
+		return errors.Errorf("Replica of round %d has %d digests for %d "+
+			"slots", round.GetRoundId(), len(replica.GetDigests()),
+			len(round.GetMessages()))
+	}
+	for i, slot := range round.GetMessages() {
+		digest := network.GenerateReplicaSlotDigest(round.GetRoundId(), slot)
+		if !bytes.Equal(digest, replica.GetDigests()[i]) {
+			return errors.Errorf("Slot %d of round %d does not match its "+
+				"digest", i, round.GetRoundId())
+		}
+	}
+
+	origin, err := id.Unmarshal(replica.GetOrigin())
+	if err != nil {
+		return errors.WithMessage(err, "Invalid origin")
+	}
+	if expected := batchGateway(topology); !origin.Cmp(expected) {
+		return errors.Errorf("Replica of round %d is from %s, not %s which "+
+			"downloaded its batch", round.GetRoundId(), origin, expected)
+	}
+	key, err := keys(origin)
+	if err != nil {
+		return errors.WithMessagef(err, "Unable to get key of %s", origin)
+	}
+	err = rsa.Verify(key, crypto.SHA256, hashRoundReplica(replica),
+		replica.GetSignature(), nil)
+	return errors.WithMessagef(err, "Invalid signature of round %d from %s",
+		round.GetRoundId(), origin)
+}
+
+// ReplicaGateways returns the k gateways after the origin in the topology,
+// which is ordered by node, that its rounds are replicated to.
+func ReplicaGateways(topology []*id.ID, origin *id.ID, k int) []*id.ID {
+	gateways := teamGateways(topology)
+	start := 0
+	for i, gwID := range gateways {
+		if gwID.Cmp(origin) {
+			start = i + 1
+			break
+		}
+	}
+
+	var replicas []*id.ID
+	for i := 0; i < len(gateways) && len(replicas) < k; i++ {
+		gwID := gateways[(start+i)%len(gateways)]
+		if !gwID.Cmp(origin) {
+			replicas = append(replicas, gwID)
+		}
+	}
+	return replicas
+}
+
+// batchGateway returns the ID of the gateway which downloads the batch of a
+// round, the gateway of the last node in its topology.
+func batchGateway(topology []*id.ID) *id.ID {
+	if len(topology) == 0 {
+		return &id.ID{}
+	}
+	gwID := topology[len(topology)-1].DeepCopy()
+	gwID.SetType(id.Gateway)
+	return gwID
+}
+
+// teamGateways returns the IDs of the gateways of the nodes in the topology.
+func teamGateways(topology []*id.ID) []*id.ID {
+	gateways := make([]*id.ID, len(topology))
+	for i, nodeID := range topology {
+		gateways[i] = nodeID.DeepCopy()
+		gateways[i].SetType(id.Gateway)
+	}
+	return gateways
+}
+
+// hashRoundReplica returns the hash of the replica which is signed.
+func hashRoundReplica(replica *pb.RoundReplica) []byte {
+	h := crypto.SHA256.New()
+	roundID := make([]byte, 8)
+	binary.BigEndian.PutUint64(roundID, replica.GetRound().GetRoundId())
+	h.Write(roundID)
+	h.Write(replica.GetOrigin())
+	for _, digest := range replica.GetDigests() {
+		h.Write(digest)
+	}
+	return h.Sum(nil)
+}
+
+// SendReplicateRound pushes a replica of a round's messages to the gateway.
+// The gateway only accepts replicas from the gateway which downloaded the
+// batch of their round.
+func (g *Comms) SendReplicateRound(host *connect.Host,
+	replica *pb.RoundReplica, timeout time.Duration) (*messages.Ack, error) {
+
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContextWithTimeout(timeout)
+		defer cancel()
+
+		// Pack the message for server
+		authMsg, err := g.PackAuthenticatedMessage(replica, host, false)
+		if err != nil {
+			return nil, errors.New(err.Error())
+		}
+
+		// Send the message
+		resultMsg, err := pb.NewGatewayClient(conn.GetGrpcConn()).
+			ReplicateRound(ctx, authMsg)
+		if err != nil {
+			return nil, errors.New(err.Error())
+		}
+
+		return ptypes.MarshalAny(resultMsg)
+	}
+
+	// Execute the Send function
+	jww.TRACE.Printf("Sending ReplicateRound for round %d",
+		replica.GetRound().GetRoundId())
+	resultMsg, err := g.Send(host, f)
+	if err != nil {
+		return nil, err
+	}
+
+	// Marshall the result
+	result := &messages.Ack{}
+	return result, ptypes.UnmarshalAny(resultMsg, result)
+}
+
+// SendRequestRoundReplica requests the gateway's replica of a round's
+// messages.
+func (g *Comms) SendRequestRoundReplica(host *connect.Host,
+	msg *pb.GetRoundReplica, timeout time.Duration) (*pb.RoundReplica, error) {
+
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContextWithTimeout(timeout)
+		defer cancel()
+
+		// Send the message
+		resultMsg, err := pb.NewGatewayClient(conn.GetGrpcConn()).
+			RequestRoundReplica(ctx, msg)
+		if err != nil {
+			return nil, errors.New(err.Error())
+		}
+
+		return ptypes.MarshalAny(resultMsg)
+	}
+
+	// Execute the Send function
+	jww.TRACE.Printf("Sending RequestRoundReplica: %+v", msg)
+	resultMsg, err := g.Send(host, f)
+	if err != nil {
+		return nil, err
+	}
+
+	// Marshall the result
+	result := &pb.RoundReplica{}
+	return result, ptypes.UnmarshalAny(resultMsg, result)
+}
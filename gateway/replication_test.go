@@ -0,0 +1,244 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package gateway
+
+import (
+	"crypto/rand"
+	"github.com/pkg/errors"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/testkeys"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/gossip"
+	"gitlab.com/xx_network/crypto/signature/rsa"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/ndf"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Tests that a round pushed by the gateway which downloaded it can be pulled
+// from its replica once the gateway is offline, and that the replica rejects
+// rounds pushed by other gateways.
+func TestReplicator(t *testing.T) {
+	key, err := rsa.LoadPrivateKeyFromPem(testkeys.GetGatewayKey())
+	if err != nil {
+		t.Fatalf("Failed to load key: %+v", err)
+	}
+
+	// The origin's node is last in the topology, so its gateway downloads
+	// the batch, and the replica's node follows it
+	var topology, gwIDs []*id.ID
+	def := &ndf.NetworkDefinition{}
+	for _, name := range []string{"replica", "puller", "origin"} {
+		nodeID := id.NewIdFromString(name, id.Node, t)
+		gwID := nodeID.DeepCopy()
+		gwID.SetType(id.Gateway)
+		topology = append(topology, nodeID)
+		gwIDs = append(gwIDs, gwID)
+		def.Gateways = append(def.Gateways, ndf.Gateway{
+			ID:             gwID.Bytes(),
+			TlsCertificate: string(testkeys.GetGatewayCert()),
+		})
+	}
+	const replicaGw, pullerGw, originGw = 0, 1, 2
+	keys := NdfGossipKeys(func() *ndf.NetworkDefinition { return def })
+	topologies := func(roundID uint64) ([]*id.ID, error) {
+		return topology, nil
+	}
+	params := DefaultReplicationParams()
+	params.Replicas = 1
+
+	// The replica stores the rounds pushed to it
+	var mux sync.Mutex
+	stored := make(map[uint64]*pb.RoundReplica)
+	impl := NewImplementation()
+	impl.Functions.ReplicateRound = func(msg *pb.RoundReplica) error {
+		mux.Lock()
+		defer mux.Unlock()
+		stored[msg.GetRound().GetRoundId()] = msg
+		return nil
+	}
+	impl.Functions.RequestRoundReplica = func(
+		msg *pb.GetRoundReplica) (*pb.RoundReplica, error) {
+		mux.Lock()
+		defer mux.Unlock()
+		replica, ok := stored[msg.GetRoundId()]
+		if !ok {
+			return nil, errors.Errorf("No round %d", msg.GetRoundId())
+		}
+		return replica, nil
+	}
+
+	var gateways []*Comms
+	var replicators []*Replicator
+	var addresses []string
+	for i, gwID := range gwIDs {
+		handler := Handler(NewImplementation())
+		if i == replicaGw {
+			handler = impl
+		}
+		addresses = append(addresses, getNextGatewayAddress())
+		gw := StartGateway(gwID, addresses[i], handler, nil, nil,
+			gossip.DefaultManagerFlags())
+		defer gw.Shutdown()
+		// Gateways authenticate each other without TLS keys
+		gw.DisableAuth()
+		gateways = append(gateways, gw)
+		replicators = append(replicators,
+			gw.NewReplicator(key, keys, topologies, params))
+	}
+
+	hostParams := connect.GetDefaultHostParams()
+	hostParams.MaxRetries = 1
+	hostParams.MaxSendRetries = 1
+	for _, gw := range gateways {
+		for i, gwID := range gwIDs {
+			if !gwID.Cmp(gw.GetId()) {
+				_, err = gw.AddHost(gwID, addresses[i], nil, hostParams)
+				if err != nil {
+					t.Fatalf("Failed to add host: %+v", err)
+				}
+			}
+		}
+	}
+
+	round := &pb.RoundMessages{
+		RoundId: 42,
+		Messages: []*pb.Slot{
+			{Index: 0, PayloadA: []byte("payloadA0"), PayloadB: []byte("payloadB0")},
+			{Index: 1, PayloadA: []byte("payloadA1"), PayloadB: []byte("payloadB1")},
+		},
+	}
+
+	// Only the origin may push the round, even with a replica it signed
+	if _, err = replicators[pullerGw].Push(round); err == nil {
+		t.Errorf("Pushed a round downloaded by another gateway")
+	}
+	forged, err := NewRoundReplica(key, gwIDs[originGw], round)
+	if err != nil {
+		t.Fatalf("Failed to create replica: %+v", err)
+	}
+	host, _ := gateways[pullerGw].GetHost(gwIDs[replicaGw])
+	_, err = gateways[pullerGw].SendReplicateRound(host, forged, params.Timeout)
+	if err == nil || !strings.Contains(err.Error(), "rather than its origin") {
+		t.Errorf("Expected replica to reject a round pushed by a gateway "+
+			"other than its origin, received: %v", err)
+	}
+
+	_, err = replicators[originGw].Push(round)
+	if err != nil {
+		t.Fatalf("Failed to push round: %+v", err)
+	}
+	mux.Lock()
+	_, ok := stored[round.RoundId]
+	mux.Unlock()
+	if !ok {
+		t.Fatalf("Round was not pushed to its replica")
+	}
+
+	gateways[originGw].Shutdown()
+	replica, err := replicators[pullerGw].Pull(round.RoundId)
+	if err != nil {
+		t.Fatalf("Failed to pull round: %+v", err)
+	}
+	if !reflect.DeepEqual(replica.GetRound().GetMessages()[1].GetPayloadA(),
+		round.Messages[1].PayloadA) {
+		t.Errorf("Pulled round does not match the pushed round")
+	}
+	if !reflect.DeepEqual(replica.GetOrigin(), gwIDs[originGw].Marshal()) {
+		t.Errorf("Pulled round is from %v, expected %s", replica.GetOrigin(),
+			gwIDs[originGw])
+	}
+}
+
+// Tests that VerifyRoundReplica rejects replicas with altered slots, signed
+// with a key other than the origin's, or from a gateway other than the one
+// which downloaded the batch.
+func TestVerifyRoundReplica(t *testing.T) {
+	key, err := rsa.LoadPrivateKeyFromPem(testkeys.GetGatewayKey())
+	if err != nil {
+		t.Fatalf("Failed to load key: %+v", err)
+	}
+	wrongKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %+v", err)
+	}
+	topology := []*id.ID{
+		id.NewIdFromString("other", id.Node, t),
+		id.NewIdFromString("origin", id.Node, t),
+	}
+	origin := id.NewIdFromString("origin", id.Gateway, t)
+	keys := func(gwID *id.ID) (*rsa.PublicKey, error) {
+		return key.GetPublic(), nil
+	}
+	newRound := func() *pb.RoundMessages {
+		return &pb.RoundMessages{
+			RoundId:  7,
+			Messages: []*pb.Slot{{PayloadA: []byte("payloadA")}},
+		}
+	}
+
+	replica, err := NewRoundReplica(key, origin, newRound())
+	if err != nil {
+		t.Fatalf("Failed to create replica: %+v", err)
+	}
+	if err = VerifyRoundReplica(replica, topology, keys); err != nil {
+		t.Errorf("Failed to verify replica: %+v", err)
+	}
+
+	replica.Round.Messages[0].PayloadA = []byte("altered")
+	if err = VerifyRoundReplica(replica, topology, keys); err == nil {
+		t.Errorf("Verified replica with an altered slot")
+	}
+
+	replica, err = NewRoundReplica(wrongKey, origin, newRound())
+	if err != nil {
+		t.Fatalf("Failed to create replica: %+v", err)
+	}
+	if err = VerifyRoundReplica(replica, topology, keys); err == nil {
+		t.Errorf("Verified replica signed with the wrong key")
+	}
+
+	replica, err = NewRoundReplica(key,
+		id.NewIdFromString("other", id.Gateway, t), newRound())
+	if err != nil {
+		t.Fatalf("Failed to create replica: %+v", err)
+	}
+	if err = VerifyRoundReplica(replica, topology, keys); err == nil {
+		t.Errorf("Verified replica from a gateway which did not download " +
+			"the batch")
+	}
+}
+
+// Tests that ReplicaGateways returns the gateways after the origin in the
+// topology, wrapping around.
+func TestReplicaGateways(t *testing.T) {
+	var topology []*id.ID
+	for _, name := range []string{"a", "b", "c", "d"} {
+		topology = append(topology, id.NewIdFromString(name, id.Node, t))
+	}
+	origin := topology[2].DeepCopy()
+	origin.SetType(id.Gateway)
+
+	replicas := ReplicaGateways(topology, origin, 2)
+	expected := []*id.ID{topology[3].DeepCopy(), topology[0].DeepCopy()}
+	for _, gwID := range expected {
+		gwID.SetType(id.Gateway)
+	}
+	if !reflect.DeepEqual(replicas, expected) {
+		t.Errorf("Unexpected replicas.\nexpected: %v\nreceived: %v",
+			expected, replicas)
+	}
+
+	if replicas = ReplicaGateways(topology, origin, 10); len(replicas) != 3 {
+		t.Errorf("Returned %d replicas, expected the 3 other gateways",
+			len(replicas))
+	}
+}
@@ -0,0 +1,410 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the commitment over the slots of a streamed batch, used to check
+// that a batch arrived whole and in order.
+
+package mixmessages
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	protoV2 "google.golang.org/protobuf/proto"
+	"io"
+	"strconv"
+)
+
+// BatchCommitmentHeader carries the marshalled BatchCommitment of a streamed
+// batch. It is sent in the header of streams to a node and in the trailer of
+// streams from a node, as gRPC clients cannot send trailers. As a binary
+// header, gRPC encodes it for transport.
+const BatchCommitmentHeader = "batchcommitment-bin"
+
+// BatchStartIndexHeader is the index a stream from a node starts the batch at.
+// It is sent in the header so receivers can tell nodes which resume a batch
+// from the requested index from those which predate resuming and always send
+// the whole batch.
+const BatchStartIndexHeader = "batchstartindex"
+
+const (
+	// minCommitmentChunk is the smallest number of slots covered by a chunk
+	// hash of a BatchCommitment.
+	minCommitmentChunk = 256
+
+	// maxCommitmentChunks bounds the number of chunk hashes in a
+	// BatchCommitment to keep it small enough for stream metadata.
+	maxCommitmentChunks = 64
+)
+
+// BatchIntegrityError is returned when a streamed batch does not match its
+// BatchCommitment. The mismatch lies within the slots [Start, End) of the
+// batch.
+type BatchIntegrityError struct {
+	Start, End uint32
+	Reason     string
+}
+
+// Error describes the mismatching range of slots.
+func (e *BatchIntegrityError) Error() string {
+	return fmt.Sprintf("Batch integrity check failed for slots [%d, %d): %s",
+		e.Start, e.End, e.Reason)
+}
+
+// slotDigests accumulates the digests of the slots of a batch as they are
+// sent or received.
+type slotDigests [][]byte
+
+// add appends the digest of the slot at the next position in the batch.
+func (sd *slotDigests) add(slot *Slot) error {
+	data, err := protoV2.MarshalOptions{Deterministic: true}.Marshal(slot)
+	if err != nil {
+		return errors.Errorf("Could not marshal slot %d for batch "+
+			"commitment: %v", len(*sd), err)
+	}
+
+	var position [8]byte
+	binary.BigEndian.PutUint64(position[:], uint64(len(*sd)))
+
+	h := sha256.New()
+	h.Write(position[:])
+	h.Write(data)
+	*sd = append(*sd, h.Sum(nil))
+	return nil
+}
+
+// chunkHashes hashes the slot digests in chunks of chunkSize slots.
+func (sd slotDigests) chunkHashes(chunkSize uint32) [][]byte {
+	var chunks [][]byte
+	for start := 0; start < len(sd); start += int(chunkSize) {
+		end := start + int(chunkSize)
+		if end > len(sd) {
+			end = len(sd)
+		}
+		h := sha256.New()
+		for _, digest := range sd[start:end] {
+			h.Write(digest)
+		}
+		chunks = append(chunks, h.Sum(nil))
+	}
+	return chunks
+}
+
+// commit builds the BatchCommitment over the slot digests.
+func (sd slotDigests) commit() *BatchCommitment {
+	chunkSize := uint32((len(sd) + maxCommitmentChunks - 1) / maxCommitmentChunks)
+	if chunkSize < minCommitmentChunk {
+		chunkSize = minCommitmentChunk
+	}
+	return &BatchCommitment{
+		SlotCount:   uint32(len(sd)),
+		ChunkSize:   chunkSize,
+		ChunkHashes: sd.chunkHashes(chunkSize),
+	}
+}
+
+// verify checks the slot digests against the commitment. A
+// *BatchIntegrityError naming the first mismatching range is returned if they
+// do not match.
+func (sd slotDigests) verify(c *BatchCommitment) error {
+	received := uint32(len(sd))
+	if c.ChunkSize == 0 {
+		return &BatchIntegrityError{0, received, "commitment has no chunk size"}
+	}
+
+	chunks := sd.chunkHashes(c.ChunkSize)
+	for i := 0; i < len(chunks) && i < len(c.ChunkHashes); i++ {
+		if bytes.Equal(chunks[i], c.ChunkHashes[i]) {
+			continue
+		}
+
+		start := uint32(i) * c.ChunkSize
+		if received != c.SlotCount {
+			end := received
+			if c.SlotCount > end {
+				end = c.SlotCount
+			}
+			return &BatchIntegrityError{start, end, fmt.Sprintf(
+				"expected %d slots, received %d", c.SlotCount, received)}
+		}
+
+		end := start + c.ChunkSize
+		if end > received {
+			end = received
+		}
+		return &BatchIntegrityError{start, end, "slots do not match commitment"}
+	}
+
+	if received != c.SlotCount || len(chunks) != len(c.ChunkHashes) {
+		start, end := received, c.SlotCount
+		if start > end {
+			start, end = end, start
+		}
+		return &BatchIntegrityError{start, end, fmt.Sprintf(
+			"expected %d slots, received %d", c.SlotCount, received)}
+	}
+
+	return nil
+}
+
+// NewBatchCommitment returns the BatchCommitment over the slots of a batch.
+func NewBatchCommitment(slots []*Slot) (*BatchCommitment, error) {
+	digests := make(slotDigests, 0, len(slots))
+	for _, slot := range slots {
+		if err := digests.add(slot); err != nil {
+			return nil, err
+		}
+	}
+	return digests.commit(), nil
+}
+
+// Verify checks that the slots match the commitment, returning a
+// *BatchIntegrityError if they do not.
+func (m *BatchCommitment) Verify(slots []*Slot) error {
+	digests := make(slotDigests, 0, len(slots))
+	for _, slot := range slots {
+		if err := digests.add(slot); err != nil {
+			return err
+		}
+	}
+	return digests.verify(m)
+}
+
+// encodeBatchCommitment encodes the commitment for stream metadata.
+func encodeBatchCommitment(c *BatchCommitment) (string, error) {
+	data, err := proto.Marshal(c)
+	if err != nil {
+		return "", errors.Errorf("Could not marshal batch commitment: %v", err)
+	}
+	return string(data), nil
+}
+
+// decodeBatchCommitment decodes the commitment from stream metadata. Returns
+// nil if the metadata has no commitment.
+func decodeBatchCommitment(md metadata.MD) (*BatchCommitment, error) {
+	values := md.Get(BatchCommitmentHeader)
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	c := &BatchCommitment{}
+	if err := proto.Unmarshal([]byte(values[0]), c); err != nil {
+		return nil, errors.Errorf("Could not unmarshal batch commitment: %v",
+			err)
+	}
+	return c, nil
+}
+
+/* -------------------- Streams to a node -------------------- */
+
+// VerifySlotStream checks the slots of a stream against the BatchCommitment
+// in its header before they are handled. The slots are read in full and a
+// stream returning the verified slots is returned in place of the original.
+// Streams from senders which do not send a commitment are returned as is.
+func VerifySlotStream(stream SlotStreamServer) (SlotStreamServer, error) {
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	c, err := decodeBatchCommitment(md)
+	if err != nil {
+		return nil, err
+	} else if c == nil {
+		jww.DEBUG.Printf("Batch stream has no commitment, not verifying")
+		return stream, nil
+	}
+
+	var slots []*Slot
+	var digests slotDigests
+	for {
+		slot, err := stream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		if err = digests.add(slot); err != nil {
+			return nil, err
+		}
+		slots = append(slots, slot)
+	}
+
+	if err = digests.verify(c); err != nil {
+		return nil, err
+	}
+
+	return &verifiedSlotStream{SlotStreamServer: stream, slots: slots}, nil
+}
+
+// verifiedSlotStream returns slots which have already been received and
+// verified.
+type verifiedSlotStream struct {
+	SlotStreamServer
+	slots []*Slot
+}
+
+// Recv returns the next verified slot, or io.EOF once all have been returned.
+func (s *verifiedSlotStream) Recv() (*Slot, error) {
+	if len(s.slots) == 0 {
+		return nil, io.EOF
+	}
+	slot := s.slots[0]
+	s.slots = s.slots[1:]
+	return slot, nil
+}
+
+/* -------------------- Streams from a node -------------------- */
+
+// SlotSendStream is the server side of a stream of slots sent by a node, such
+// as Node_DownloadMixedBatchServer.
+type SlotSendStream interface {
+	Send(*Slot) error
+	grpc.ServerStream
+}
+
+// CommittingSlotStream records the slots sent on a stream so their
+// BatchCommitment can be sent in the stream trailer.
+type CommittingSlotStream struct {
+	SlotSendStream
+	start   uint32
+	digests slotDigests
+}
+
+// NewCommittingSlotStream wraps the stream to record the slots sent on it.
+// Slots before index start of the batch are committed to but not sent, so a
+// stream resuming a batch still commits to the whole batch.
+func NewCommittingSlotStream(stream SlotSendStream,
+	start uint32) *CommittingSlotStream {
+	err := stream.SetHeader(metadata.Pairs(BatchStartIndexHeader,
+		strconv.FormatUint(uint64(start), 10)))
+	if err != nil {
+		jww.WARN.Printf("Could not set batch start index header: %v", err)
+	}
+	return &CommittingSlotStream{SlotSendStream: stream, start: start}
+}
+
+// Send adds the slot to the commitment and sends it if it is at or after the
+// start index of the stream.
+func (s *CommittingSlotStream) Send(slot *Slot) error {
+	position := uint32(len(s.digests))
+	if err := s.digests.add(slot); err != nil {
+		return err
+	}
+	if position < s.start {
+		return nil
+	}
+	return s.SlotSendStream.Send(slot)
+}
+
+// SetCommitmentTrailer sets the commitment over every slot sent as the stream
+// trailer. It must be called before the stream handler returns.
+func (s *CommittingSlotStream) SetCommitmentTrailer() error {
+	commitment, err := encodeBatchCommitment(s.digests.commit())
+	if err != nil {
+		return err
+	}
+	s.SetTrailer(metadata.Pairs(BatchCommitmentHeader, commitment))
+	return nil
+}
+
+// SlotRecvStream is the client side of a stream of slots sent by a node, such
+// as Node_DownloadMixedBatchClient.
+type SlotRecvStream interface {
+	Recv() (*Slot, error)
+	grpc.ClientStream
+}
+
+// VerifyingSlotStream records the slots received on a stream so they can be
+// checked against the BatchCommitment in its trailer.
+type VerifyingSlotStream struct {
+	SlotRecvStream
+	digests slotDigests
+
+	// Number of slots at the start of a resumed stream which were already
+	// received
+	skip uint32
+}
+
+// NewVerifyingSlotStream wraps the stream to record the slots received on it.
+func NewVerifyingSlotStream(stream SlotRecvStream) *VerifyingSlotStream {
+	return &VerifyingSlotStream{SlotRecvStream: stream}
+}
+
+// Received returns the number of slots received, which is the start index to
+// resume the batch from.
+func (s *VerifyingSlotStream) Received() uint32 {
+	return uint32(len(s.digests))
+}
+
+// Resume continues receiving the batch on a new stream, requested from the
+// index returned by Received. The slots already received are verified
+// together with those from the new stream against its commitment. Nodes which
+// predate resuming send the batch from the start, so the slots already
+// received are skipped.
+func (s *VerifyingSlotStream) Resume(stream SlotRecvStream) error {
+	s.SlotRecvStream = stream
+	s.skip = 0
+
+	md, err := stream.Header()
+	if err != nil {
+		return err
+	}
+	start := uint64(0)
+	if values := md.Get(BatchStartIndexHeader); len(values) > 0 {
+		start, err = strconv.ParseUint(values[0], 10, 32)
+		if err != nil {
+			return errors.Errorf("Invalid batch start index: %v", err)
+		}
+	} else {
+		jww.WARN.Printf("Node does not resume batches, skipping the %d "+
+			"slots already received", s.Received())
+	}
+	if start > uint64(s.Received()) {
+		return errors.Errorf("Resumed batch starts at slot %d after the %d "+
+			"slots received", start, s.Received())
+	}
+	s.skip = s.Received() - uint32(start)
+	return nil
+}
+
+// Recv receives the next slot and adds it to those to be verified. Once the
+// stream ends, the slots received are verified against the commitment in the
+// trailer; if they do not match, a *BatchIntegrityError is returned in place
+// of io.EOF. Streams from nodes which do not send a commitment are not
+// verified.
+func (s *VerifyingSlotStream) Recv() (*Slot, error) {
+	for ; s.skip > 0; s.skip-- {
+		if _, err := s.SlotRecvStream.Recv(); err != nil {
+			return nil, err
+		}
+	}
+
+	slot, err := s.SlotRecvStream.Recv()
+	if err == io.EOF {
+		c, decodeErr := decodeBatchCommitment(s.Trailer())
+		if decodeErr != nil {
+			return nil, decodeErr
+		} else if c == nil {
+			jww.DEBUG.Printf("Batch stream has no commitment, not verifying")
+			return nil, io.EOF
+		}
+		if verifyErr := s.digests.verify(c); verifyErr != nil {
+			return nil, verifyErr
+		}
+		return nil, io.EOF
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err = s.digests.add(slot); err != nil {
+		return nil, err
+	}
+	return slot, nil
+}
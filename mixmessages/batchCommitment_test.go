@@ -0,0 +1,201 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package mixmessages
+
+import (
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"io"
+	"testing"
+)
+
+// makeCommitmentSlots returns numSlots distinct slots.
+func makeCommitmentSlots(numSlots int) []*Slot {
+	slots := make([]*Slot, numSlots)
+	for i := range slots {
+		slots[i] = &Slot{Index: uint32(i), PayloadA: []byte{byte(i), 1}}
+	}
+	return slots
+}
+
+// Tests that a BatchCommitment verifies the slots it was made over and
+// survives encoding into stream metadata.
+func TestBatchCommitment_Verify(t *testing.T) {
+	for _, numSlots := range []int{0, 1, 300, 20000} {
+		slots := makeCommitmentSlots(numSlots)
+		c, err := NewBatchCommitment(slots)
+		if err != nil {
+			t.Fatalf("NewBatchCommitment produced an error: %+v", err)
+		}
+		if len(c.ChunkHashes) > maxCommitmentChunks {
+			t.Errorf("Commitment over %d slots has %d chunks, more than %d",
+				numSlots, len(c.ChunkHashes), maxCommitmentChunks)
+		}
+
+		encoded, err := encodeBatchCommitment(c)
+		if err != nil {
+			t.Fatalf("encodeBatchCommitment produced an error: %+v", err)
+		}
+		md := metadata.Pairs(BatchCommitmentHeader, encoded)
+		decoded, err := decodeBatchCommitment(md)
+		if err != nil {
+			t.Fatalf("decodeBatchCommitment produced an error: %+v", err)
+		}
+		if err = decoded.Verify(slots); err != nil {
+			t.Errorf("Slots failed to verify against their commitment: %+v",
+				err)
+		}
+	}
+}
+
+// Tests that altered, dropped, duplicated and reordered slots produce a
+// BatchIntegrityError naming the range containing the mismatch.
+func TestBatchCommitment_Verify_Mismatch(t *testing.T) {
+	const numSlots = 600
+	c, err := NewBatchCommitment(makeCommitmentSlots(numSlots))
+	if err != nil {
+		t.Fatalf("NewBatchCommitment produced an error: %+v", err)
+	}
+
+	tests := []struct {
+		name       string
+		modify     func(slots []*Slot) []*Slot
+		start, end uint32
+	}{
+		{"altered", func(slots []*Slot) []*Slot {
+			slots[300].PayloadA = []byte("altered")
+			return slots
+		}, 256, 512},
+		{"altered last", func(slots []*Slot) []*Slot {
+			slots[599].PayloadA = []byte("altered")
+			return slots
+		}, 512, 600},
+		{"dropped", func(slots []*Slot) []*Slot {
+			return append(slots[:10], slots[11:]...)
+		}, 0, 600},
+		{"duplicated", func(slots []*Slot) []*Slot {
+			return append(slots, slots[599])
+		}, 512, 601},
+		{"truncated", func(slots []*Slot) []*Slot {
+			return slots[:512]
+		}, 512, 600},
+		{"reordered", func(slots []*Slot) []*Slot {
+			slots[520], slots[521] = slots[521], slots[520]
+			return slots
+		}, 512, 600},
+	}
+
+	for _, tt := range tests {
+		slots := tt.modify(makeCommitmentSlots(numSlots))
+		err = c.Verify(slots)
+
+		var integrityErr *BatchIntegrityError
+		if !errors.As(err, &integrityErr) {
+			t.Errorf("Expected BatchIntegrityError for %s batch, received: %v",
+				tt.name, err)
+			continue
+		}
+		if integrityErr.Start != tt.start || integrityErr.End != tt.end {
+			t.Errorf("Unexpected range for %s batch."+
+				"\nexpected: [%d, %d)\nreceived: [%d, %d)", tt.name,
+				tt.start, tt.end, integrityErr.Start, integrityErr.End)
+		}
+	}
+}
+
+// slotRecvStream is a SlotRecvStream replaying slots, failing after failAfter
+// of them, with the given header.
+type slotRecvStream struct {
+	grpc.ClientStream
+	slots     []*Slot
+	failAfter int
+	header    metadata.MD
+}
+
+func (s *slotRecvStream) Header() (metadata.MD, error) { return s.header, nil }
+func (s *slotRecvStream) Trailer() metadata.MD         { return metadata.MD{} }
+func (s *slotRecvStream) Recv() (*Slot, error) {
+	if s.failAfter == 0 {
+		return nil, errors.New("stream failed")
+	}
+	if len(s.slots) == 0 {
+		return nil, io.EOF
+	}
+	s.failAfter--
+	slot := s.slots[0]
+	s.slots = s.slots[1:]
+	return slot, nil
+}
+
+// Tests that a resumed VerifyingSlotStream does not duplicate slots, both
+// from nodes which start at the requested index and from nodes which predate
+// resuming and send the whole batch again.
+func TestVerifyingSlotStream_Resume(t *testing.T) {
+	const numSlots, failAfter = 10, 4
+	slots := makeCommitmentSlots(numSlots)
+
+	tests := []struct {
+		name   string
+		header metadata.MD
+		slots  []*Slot
+	}{
+		{"resuming node",
+			metadata.Pairs(BatchStartIndexHeader, "4"), slots[failAfter:]},
+		{"old node", metadata.MD{}, slots},
+	}
+
+	for _, tt := range tests {
+		stream := NewVerifyingSlotStream(&slotRecvStream{
+			slots: slots, failAfter: failAfter})
+		var received []*Slot
+		recv := func() error {
+			for {
+				slot, err := stream.Recv()
+				if err != nil {
+					return err
+				}
+				received = append(received, slot)
+			}
+		}
+		if err := recv(); err == nil || err == io.EOF {
+			t.Fatalf("Expected first stream to fail for %s, received: %v",
+				tt.name, err)
+		}
+
+		err := stream.Resume(&slotRecvStream{
+			slots: tt.slots, failAfter: -1, header: tt.header})
+		if err != nil {
+			t.Fatalf("Failed to resume for %s: %+v", tt.name, err)
+		}
+		if err = recv(); err != io.EOF {
+			t.Fatalf("Expected EOF for %s, received: %v", tt.name, err)
+		}
+
+		if len(received) != numSlots {
+			t.Fatalf("Received %d slots for %s, expected %d",
+				len(received), tt.name, numSlots)
+		}
+		for i, slot := range received {
+			if slot.Index != uint32(i) {
+				t.Errorf("Slot %d for %s has index %d", i, tt.name, slot.Index)
+			}
+		}
+	}
+}
+
+// Tests that resuming fails when the node starts the batch after the slots
+// already received.
+func TestVerifyingSlotStream_Resume_Gap(t *testing.T) {
+	stream := NewVerifyingSlotStream(&slotRecvStream{failAfter: 0})
+	err := stream.Resume(&slotRecvStream{
+		header: metadata.Pairs(BatchStartIndexHeader, "3")})
+	if err == nil {
+		t.Error("Resumed a batch starting after the slots received")
+	}
+}
@@ -0,0 +1,624 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains logic for splitting a streamed batch of slots across parallel
+// streams and reassembling it in order on the receiver.
+
+package mixmessages
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/xx_network/comms/messages"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Headers used to negotiate and identify the shards of a streamed batch. They
+// are sent alongside the batch header, such as PostPhaseHeader.
+const (
+	// ShardTransferHeader identifies the batch a shard belongs to
+	ShardTransferHeader = "batchshardtransfer"
+	// ShardCountHeader is the number of shards requested by the sender on
+	// shard 0 and the number accepted by the receiver in its response header
+	ShardCountHeader = "batchshardcount"
+	// ShardIndexHeader is the index of the shard carried by a stream
+	ShardIndexHeader = "batchshardindex"
+	// ShardSizeHeader is the number of slots carried by a stream
+	ShardSizeHeader = "batchshardsize"
+)
+
+const (
+	// DefaultMaxBatchShards is the number of shards a receiver accepts
+	// unless set otherwise.
+	DefaultMaxBatchShards = 8
+
+	// ShardNegotiationTimeout is how long a sender waits for the receiver to
+	// accept a shard count. Receivers which predate sharding never answer, so
+	// the whole batch is then sent on the first stream.
+	ShardNegotiationTimeout = 5 * time.Second
+
+	// ShardFallbackDuration is how long batches to a receiver which did not
+	// accept a shard count are sent unsharded without negotiating, so
+	// receivers which predate sharding only delay one batch in that time.
+	ShardFallbackDuration = time.Hour
+
+	// maxShardBuffer caps the number of slots buffered for a shard which has
+	// not yet been reached by the reassembled stream.
+	maxShardBuffer = 1 << 16
+)
+
+// SlotStreamClient is the client side of a stream of slots acknowledged once
+// closed, such as Node_StreamPostPhaseClient or Node_FinishRealtimeClient.
+type SlotStreamClient interface {
+	Send(*Slot) error
+	CloseAndRecv() (*messages.Ack, error)
+	grpc.ClientStream
+}
+
+// SlotStreamServer is the server side of a stream of slots acknowledged once
+// closed, such as Node_StreamPostPhaseServer or Node_FinishRealtimeServer.
+type SlotStreamServer interface {
+	SendAndClose(*messages.Ack) error
+	Recv() (*Slot, error)
+	grpc.ServerStream
+}
+
+// SlotStreamOpener opens a new stream of slots using the given context, which
+// carries the metadata of the stream.
+type SlotStreamOpener func(ctx context.Context) (SlotStreamClient, error)
+
+// ShardRange returns the range of slot indices [start, end) carried by the
+// given shard when a batch of batchSize slots is split into shards.
+func ShardRange(batchSize, shard, shards int) (start, end int) {
+	return shard * batchSize / shards, (shard + 1) * batchSize / shards
+}
+
+// BatchSharding splits batches of slots across parallel streams when sending
+// and reassembles them when receiving. The zero value sends unsharded and
+// accepts up to DefaultMaxBatchShards shards.
+type BatchSharding struct {
+	mux       sync.Mutex
+	shards    int
+	maxShards int
+	transfers map[string]*shardTransfer
+
+	// Time until which batches to each receiver are sent unsharded
+	unsharded map[string]time.Time
+}
+
+// SetBatchShards sets the number of parallel streams batches are sent over.
+// The receiver may accept fewer. Values below 2 disable sharding.
+func (bs *BatchSharding) SetBatchShards(shards int) {
+	bs.mux.Lock()
+	defer bs.mux.Unlock()
+	bs.shards = shards
+}
+
+// SetMaxBatchShards sets the maximum number of shards accepted for a batch
+// being received. Values below 2 disable sharding.
+func (bs *BatchSharding) SetMaxBatchShards(maxShards int) {
+	bs.mux.Lock()
+	defer bs.mux.Unlock()
+	if maxShards < 1 {
+		maxShards = 1
+	}
+	bs.maxShards = maxShards
+}
+
+// getShardsFor returns the number of shards batches are sent to the receiver
+// over, which is one while it is falling back to unsharded.
+func (bs *BatchSharding) getShardsFor(receiver string) int {
+	bs.mux.Lock()
+	defer bs.mux.Unlock()
+	if until, ok := bs.unsharded[receiver]; ok {
+		if time.Now().Before(until) {
+			return 1
+		}
+		delete(bs.unsharded, receiver)
+	}
+	return bs.shards
+}
+
+// setUnsharded sends batches to the receiver unsharded for the
+// ShardFallbackDuration.
+func (bs *BatchSharding) setUnsharded(receiver string) {
+	bs.mux.Lock()
+	defer bs.mux.Unlock()
+	if bs.unsharded == nil {
+		bs.unsharded = make(map[string]time.Time)
+	}
+	bs.unsharded[receiver] = time.Now().Add(ShardFallbackDuration)
+}
+
+// getMaxShards returns the number of shards accepted for a batch.
+func (bs *BatchSharding) getMaxShards() int {
+	bs.mux.Lock()
+	defer bs.mux.Unlock()
+	if bs.maxShards == 0 {
+		return DefaultMaxBatchShards
+	}
+	return bs.maxShards
+}
+
+/* ------------------------------ Sending ------------------------------ */
+
+// SendSlots streams the slots and a BatchCommitment over them using streams
+// opened with the context, which must carry the batch header. If sharding is
+// enabled, the slots are split by index range across as many parallel streams
+// as the receiver accepts. receiver identifies the receiving host, so that
+// receivers which do not negotiate sharding are remembered.
+// Returns the acknowledgement of the receiver.
+func (bs *BatchSharding) SendSlots(ctx context.Context, receiver string,
+	open SlotStreamOpener, slots []*Slot) (*messages.Ack, error) {
+	// Commit to the slots so the receiver can verify the batch arrived whole
+	commitment, err := NewBatchCommitment(slots)
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := encodeBatchCommitment(commitment)
+	if err != nil {
+		return nil, err
+	}
+	ctx = metadata.AppendToOutgoingContext(ctx, BatchCommitmentHeader, encoded)
+
+	shards := bs.getShardsFor(receiver)
+	if shards > len(slots) {
+		shards = len(slots)
+	}
+	if shards < 2 {
+		stream, err := open(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return sendShard(stream, slots, 0)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	transfer := make([]byte, 16)
+	if _, err := rand.Read(transfer); err != nil {
+		return nil, errors.Errorf("Could not generate shard transfer ID: %v",
+			err)
+	}
+	transferID := base64.StdEncoding.EncodeToString(transfer)
+
+	// Open the first shard and find out how many shards are accepted
+	first, err := open(metadata.AppendToOutgoingContext(ctx,
+		ShardTransferHeader, transferID,
+		ShardCountHeader, strconv.Itoa(shards),
+		ShardIndexHeader, "0"))
+	if err != nil {
+		return nil, err
+	}
+	var negotiated bool
+	shards, negotiated = negotiateShards(first, shards)
+	if !negotiated {
+		bs.setUnsharded(receiver)
+	}
+
+	streams := make([]SlotStreamClient, shards)
+	streams[0] = first
+	for i := 1; i < shards; i++ {
+		start, end := ShardRange(len(slots), i, shards)
+		streams[i], err = open(metadata.AppendToOutgoingContext(ctx,
+			ShardTransferHeader, transferID,
+			ShardCountHeader, strconv.Itoa(shards),
+			ShardIndexHeader, strconv.Itoa(i),
+			ShardSizeHeader, strconv.Itoa(end-start)))
+		if err != nil {
+			return nil, errors.WithMessagef(err, "Could not open shard %d/%d",
+				i, shards)
+		}
+	}
+
+	acks := make([]*messages.Ack, shards)
+	var failure error
+	var once sync.Once
+	var wg sync.WaitGroup
+	for i := range streams {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			start, end := ShardRange(len(slots), i, shards)
+			var err error
+			acks[i], err = sendShard(streams[i], slots[start:end], start)
+			if err != nil {
+				// Only the first failure is reported, as it causes the
+				// other shards to be abandoned
+				once.Do(func() {
+					failure = errors.WithMessagef(err, "Shard %d/%d "+
+						"carrying slots [%d, %d) failed", i, shards, start, end)
+					cancel()
+				})
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if failure != nil {
+		return nil, failure
+	}
+
+	return acks[0], nil
+}
+
+// negotiateShards waits for the receiver to accept a number of shards on the
+// first stream and returns it. Receivers which do not answer in time, or do
+// not support sharding, get one shard and false is returned.
+func negotiateShards(first SlotStreamClient, requested int) (int, bool) {
+	headerChan := make(chan metadata.MD, 1)
+	go func() {
+		md, err := first.Header()
+		if err != nil {
+			md = nil
+		}
+		headerChan <- md
+	}()
+
+	timer := time.NewTimer(ShardNegotiationTimeout)
+	defer timer.Stop()
+
+	var md metadata.MD
+	select {
+	case md = <-headerChan:
+	case <-timer.C:
+		jww.WARN.Printf("Receiver did not negotiate batch shards within "+
+			"%s, sending unsharded", ShardNegotiationTimeout)
+		return 1, false
+	}
+
+	values := md.Get(ShardCountHeader)
+	if len(values) == 0 {
+		return 1, false
+	}
+	accepted, err := strconv.Atoi(values[0])
+	if err != nil || accepted < 1 {
+		jww.WARN.Printf("Receiver accepted invalid shard count %q, "+
+			"sending unsharded", values[0])
+		return 1, true
+	}
+	if accepted > requested {
+		return requested, true
+	}
+	return accepted, true
+}
+
+// sendShard streams the slots and returns the acknowledgement of the
+// receiver. offset is the index of the first slot within the batch.
+func sendShard(stream SlotStreamClient, slots []*Slot, offset int) (
+	*messages.Ack, error) {
+	for i, slot := range slots {
+		if err := stream.Send(slot); err != nil {
+			if err == io.EOF {
+				// Attempt to read an error
+				eofAck, eofErr := stream.CloseAndRecv()
+				if eofErr != nil {
+					err = errors.Wrap(err, eofErr.Error())
+				} else {
+					err = errors.Wrap(err, eofAck.Error)
+				}
+			}
+			return nil, errors.Errorf("Could not stream slot %d: %v",
+				offset+i, err)
+		}
+	}
+
+	// Receive ack and cancel client streaming context
+	ack, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, errors.Errorf("Could not receive final "+
+			"acknowledgement on streaming batch: %v", err)
+	}
+
+	if ack != nil && ack.Error != "" {
+		return ack, errors.Errorf("Remote Server Error: %v", ack.Error)
+	}
+
+	return ack, nil
+}
+
+/* ------------------------------ Receiving ------------------------------ */
+
+// shardTransfer is a batch being received across several streams.
+type shardTransfer struct {
+	shards []*shardQueue
+
+	// Closed once the first shard's handler has returned, with the result
+	// to pass to the other shards
+	done chan struct{}
+	ack  *messages.Ack
+	err  error
+
+	hasFirst bool
+}
+
+// shardQueue buffers the slots of a shard after the first.
+type shardQueue struct {
+	// Closed once the stream carrying the shard has been attached
+	attached chan struct{}
+	slots    chan *Slot
+	err      error
+}
+
+// ReceiveSlots passes a stream of slots to handle. If the stream is a shard
+// of a batch, the shards are gathered by the sender and transfer ID and
+// handle is called once, on the first shard, with a stream returning the
+// slots of every shard in order. The streams of the other shards are closed
+// with the acknowledgement and error of the first once handle returns.
+//
+// sender identifies the authenticated sender of the stream so that shards
+// from different senders are never combined.
+func (bs *BatchSharding) ReceiveSlots(stream SlotStreamServer, sender string,
+	handle func(stream SlotStreamServer) error) error {
+	md, ok := metadata.FromIncomingContext(stream.Context())
+	if !ok || len(md.Get(ShardTransferHeader)) == 0 {
+		return handle(stream)
+	}
+
+	count, index, err := getShardPosition(md)
+	if err != nil {
+		return err
+	}
+	key := sender + "/" + md.Get(ShardTransferHeader)[0]
+
+	if index == 0 {
+		return bs.receiveFirstShard(stream, key, count, handle)
+	}
+
+	size := maxShardBuffer
+	if values := md.Get(ShardSizeHeader); len(values) > 0 {
+		if declared, err := strconv.Atoi(values[0]); err == nil &&
+			declared >= 0 && declared < size {
+			size = declared
+		}
+	}
+	return bs.receiveShard(stream, key, count, index, size)
+}
+
+// getShardPosition reads the shard count and index from the metadata.
+func getShardPosition(md metadata.MD) (count, index int, err error) {
+	if len(md.Get(ShardCountHeader)) == 0 ||
+		len(md.Get(ShardIndexHeader)) == 0 {
+		return 0, 0, errors.New("Batch shard is missing its count or index")
+	}
+	count, err = strconv.Atoi(md.Get(ShardCountHeader)[0])
+	if err != nil {
+		return 0, 0, errors.Errorf("Invalid batch shard count: %v", err)
+	}
+	index, err = strconv.Atoi(md.Get(ShardIndexHeader)[0])
+	if err != nil {
+		return 0, 0, errors.Errorf("Invalid batch shard index: %v", err)
+	}
+	if index < 0 || index >= count {
+		return 0, 0, errors.Errorf("Batch shard %d is out of range of %d "+
+			"shards", index, count)
+	}
+	return count, index, nil
+}
+
+// receiveFirstShard accepts a shard count and calls handle with a stream
+// which reassembles the batch.
+func (bs *BatchSharding) receiveFirstShard(stream SlotStreamServer, key string,
+	requested int, handle func(stream SlotStreamServer) error) error {
+	accepted := requested
+	if maxShards := bs.getMaxShards(); accepted > maxShards {
+		accepted = maxShards
+	}
+
+	// The sender waits for the accepted count before opening other shards
+	err := stream.SendHeader(
+		metadata.Pairs(ShardCountHeader, strconv.Itoa(accepted)))
+	if err != nil {
+		return errors.Errorf("Could not accept batch shards: %v", err)
+	}
+	if accepted == 1 {
+		return handle(stream)
+	}
+
+	t, err := bs.getTransfer(key, accepted)
+	if err != nil {
+		return err
+	}
+	bs.mux.Lock()
+	if t.hasFirst {
+		bs.mux.Unlock()
+		return errors.New("Batch shard 0 was already received")
+	}
+	t.hasFirst = true
+	bs.mux.Unlock()
+
+	reassembled := &shardedSlotStream{
+		SlotStreamServer: stream,
+		transfer:         t,
+	}
+	err = handle(reassembled)
+
+	bs.mux.Lock()
+	if bs.transfers[key] == t {
+		delete(bs.transfers, key)
+	}
+	t.ack, t.err = reassembled.ack, err
+	bs.mux.Unlock()
+	close(t.done)
+
+	return err
+}
+
+// receiveShard buffers the slots of a shard after the first until the batch
+// has been handled, then closes its stream with the same result.
+func (bs *BatchSharding) receiveShard(stream SlotStreamServer, key string,
+	count, index, size int) error {
+	t, err := bs.getTransfer(key, count)
+	if err != nil {
+		return err
+	}
+
+	q := t.shards[index]
+	bs.mux.Lock()
+	if q.slots != nil {
+		bs.mux.Unlock()
+		return errors.Errorf("Batch shard %d was already received", index)
+	}
+	q.slots = make(chan *Slot, size)
+	bs.mux.Unlock()
+	close(q.attached)
+
+	ctx := stream.Context()
+	for {
+		slot, err := stream.Recv()
+		if err != nil {
+			if err != io.EOF {
+				q.err = err
+			}
+			close(q.slots)
+			break
+		}
+
+		select {
+		case q.slots <- slot:
+		case <-t.done:
+			close(q.slots)
+			return errors.Errorf("Batch was handled before shard %d was "+
+				"received", index)
+		case <-ctx.Done():
+			q.err = ctx.Err()
+			close(q.slots)
+			bs.abandonTransfer(key, t)
+			return ctx.Err()
+		}
+	}
+
+	select {
+	case <-t.done:
+	case <-ctx.Done():
+		bs.abandonTransfer(key, t)
+		return ctx.Err()
+	}
+
+	if t.err != nil {
+		return t.err
+	}
+	ack := t.ack
+	if ack == nil {
+		ack = &messages.Ack{}
+	}
+	return stream.SendAndClose(ack)
+}
+
+// getTransfer returns the transfer for the key, creating it if needed.
+func (bs *BatchSharding) getTransfer(key string, count int) (
+	*shardTransfer, error) {
+	bs.mux.Lock()
+	defer bs.mux.Unlock()
+
+	maxShards := bs.maxShards
+	if maxShards == 0 {
+		maxShards = DefaultMaxBatchShards
+	}
+	if count > maxShards {
+		return nil, errors.Errorf("Batch shard count %d exceeds the "+
+			"accepted maximum of %d", count, maxShards)
+	}
+
+	if bs.transfers == nil {
+		bs.transfers = make(map[string]*shardTransfer)
+	}
+
+	t, exists := bs.transfers[key]
+	if !exists {
+		t = &shardTransfer{
+			shards: make([]*shardQueue, count),
+			done:   make(chan struct{}),
+		}
+		for i := range t.shards {
+			t.shards[i] = &shardQueue{attached: make(chan struct{})}
+		}
+		bs.transfers[key] = t
+	} else if len(t.shards) != count {
+		return nil, errors.Errorf("Batch shard count %d does not match "+
+			"the %d shards of its batch", count, len(t.shards))
+	}
+
+	return t, nil
+}
+
+// abandonTransfer removes a transfer whose first shard never arrived.
+func (bs *BatchSharding) abandonTransfer(key string, t *shardTransfer) {
+	bs.mux.Lock()
+	defer bs.mux.Unlock()
+	if bs.transfers[key] == t && !t.hasFirst {
+		delete(bs.transfers, key)
+	}
+}
+
+// shardedSlotStream returns the slots of every shard of a batch in order. It
+// is passed to the handler in place of the stream of the first shard and
+// satisfies each of the slot streaming server interfaces.
+type shardedSlotStream struct {
+	SlotStreamServer
+	transfer *shardTransfer
+
+	// Index of the shard being read
+	current int
+	ack     *messages.Ack
+}
+
+// Recv returns the next slot of the batch, or io.EOF once every shard has
+// been read.
+func (s *shardedSlotStream) Recv() (*Slot, error) {
+	ctx := s.Context()
+	for s.current < len(s.transfer.shards) {
+		if s.current == 0 {
+			slot, err := s.SlotStreamServer.Recv()
+			if err != io.EOF {
+				return slot, err
+			}
+			s.current++
+			continue
+		}
+
+		q := s.transfer.shards[s.current]
+		select {
+		case <-q.attached:
+		case <-ctx.Done():
+			return nil, errors.Errorf("Batch shard %d was never received: "+
+				"%v", s.current, ctx.Err())
+		}
+
+		select {
+		case slot, ok := <-q.slots:
+			if ok {
+				return slot, nil
+			}
+			if q.err != nil {
+				return nil, errors.Errorf("Could not receive batch shard "+
+					"%d: %v", s.current, q.err)
+			}
+			s.current++
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	return nil, io.EOF
+}
+
+// SendAndClose closes the stream of the first shard with the ack, which is
+// then used to close the streams of the other shards.
+func (s *shardedSlotStream) SendAndClose(ack *messages.Ack) error {
+	s.ack = ack
+	return s.SlotStreamServer.SendAndClose(ack)
+}
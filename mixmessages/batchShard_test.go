@@ -0,0 +1,66 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package mixmessages
+
+import (
+	"context"
+	"gitlab.com/xx_network/comms/messages"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"testing"
+)
+
+// oldReceiverStream is a SlotStreamClient to a receiver which predates
+// sharding and answers without a shard count.
+type oldReceiverStream struct {
+	grpc.ClientStream
+	sharded bool
+}
+
+func (s *oldReceiverStream) Send(*Slot) error { return nil }
+func (s *oldReceiverStream) CloseAndRecv() (*messages.Ack, error) {
+	return &messages.Ack{}, nil
+}
+func (s *oldReceiverStream) Header() (metadata.MD, error) {
+	return metadata.MD{}, nil
+}
+
+// Tests that batches to a receiver which does not negotiate sharding are
+// sent unsharded afterwards without negotiating again.
+func TestBatchSharding_SendSlots_Fallback(t *testing.T) {
+	bs := &BatchSharding{}
+	bs.SetBatchShards(4)
+
+	var streams []*oldReceiverStream
+	open := func(ctx context.Context) (SlotStreamClient, error) {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		s := &oldReceiverStream{sharded: len(md.Get(ShardTransferHeader)) > 0}
+		streams = append(streams, s)
+		return s, nil
+	}
+	slots := []*Slot{{Index: 0}, {Index: 1}, {Index: 2}, {Index: 3}}
+
+	for i := 0; i < 2; i++ {
+		if _, err := bs.SendSlots(context.Background(), "old", open,
+			slots); err != nil {
+			t.Fatalf("SendSlots produced an error: %+v", err)
+		}
+	}
+	if len(streams) != 2 || !streams[0].sharded || streams[1].sharded {
+		t.Errorf("Expected one sharded stream, then one unsharded stream")
+	}
+
+	// Other receivers still negotiate
+	if _, err := bs.SendSlots(context.Background(), "new", open,
+		slots); err != nil {
+		t.Fatalf("SendSlots produced an error: %+v", err)
+	}
+	if !streams[2].sharded {
+		t.Errorf("Batch to another receiver was not sharded")
+	}
+}
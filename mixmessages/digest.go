@@ -11,6 +11,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	jww "github.com/spf13/jwalterweatherman"
 	"gitlab.com/elixxir/crypto/hash"
+	gohash "hash"
 )
 
 // Function to digest Identity
@@ -22,6 +23,11 @@ func (i *Identity) Digest() []byte {
 		jww.FATAL.Panicf("Could not get hash: %+v", err)
 	}
 
+	return DigestSignable(i, GetSigningDigestVersion(), nil, h)
+}
+
+// legacyDigest is the LegacyDigest version of Digest. The nonce is unused.
+func (i *Identity) legacyDigest(_ []byte, h gohash.Hash) []byte {
 	// Marshal the message to put into the hash
 	mb, err := proto.Marshal(i)
 	if err != nil {
@@ -29,6 +35,7 @@ func (i *Identity) Digest() []byte {
 	}
 
 	// Hash the Identity data to generate the vector
+	h.Reset()
 	h.Write(mb)
 	return h.Sum(nil)
 }
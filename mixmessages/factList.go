@@ -0,0 +1,49 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the signature of a user's request to list their facts
+
+package mixmessages
+
+import (
+	"crypto"
+	"encoding/binary"
+	"github.com/pkg/errors"
+	"gitlab.com/xx_network/crypto/signature/rsa"
+	"io"
+)
+
+// Sign signs the UID and timestamp of the request with the user's RSA
+// transmission key.
+func (m *FactListRequest) Sign(rng io.Reader, key *rsa.PrivateKey) error {
+	sig, err := rsa.Sign(rng, key, crypto.SHA256, m.digest(), nil)
+	if err != nil {
+		return errors.WithMessage(err, "Failed to sign fact list request")
+	}
+	m.Signature = sig
+	return nil
+}
+
+// Verify returns an error if the request is not signed by the RSA
+// transmission key of the user.
+func (m *FactListRequest) Verify(key *rsa.PublicKey) error {
+	if key == nil {
+		return errors.New("No key to verify fact list request with")
+	}
+	err := rsa.Verify(key, crypto.SHA256, m.digest(), m.GetSignature(), nil)
+	return errors.WithMessage(err, "Invalid fact list request signature")
+}
+
+// digest hashes the UID and timestamp of the request.
+func (m *FactListRequest) digest() []byte {
+	h := crypto.SHA256.New()
+	h.Write(m.GetUID())
+	timestamp := make([]byte, 8)
+	binary.BigEndian.PutUint64(timestamp, uint64(m.GetTimestamp()))
+	h.Write(timestamp)
+	return h.Sum(nil)
+}
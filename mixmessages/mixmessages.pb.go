@@ -29,6 +29,68 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Reasons a gateway rejects messages sent to it
+type SlotRejection int32
+
+const (
+	SlotRejection_NOT_REJECTED      SlotRejection = 0
+	SlotRejection_ROUND_FULL        SlotRejection = 1
+	SlotRejection_ROUND_NOT_QUEUED  SlotRejection = 2
+	SlotRejection_BAD_MAC           SlotRejection = 3
+	SlotRejection_UNKNOWN_SENDER    SlotRejection = 4
+	SlotRejection_RATE_LIMITED      SlotRejection = 5
+	SlotRejection_PROXY_UNREACHABLE SlotRejection = 6
+)
+
+// Enum value maps for SlotRejection.
+var (
+	SlotRejection_name = map[int32]string{
+		0: "NOT_REJECTED",
+		1: "ROUND_FULL",
+		2: "ROUND_NOT_QUEUED",
+		3: "BAD_MAC",
+		4: "UNKNOWN_SENDER",
+		5: "RATE_LIMITED",
+		6: "PROXY_UNREACHABLE",
+	}
+	SlotRejection_value = map[string]int32{
+		"NOT_REJECTED":      0,
+		"ROUND_FULL":        1,
+		"ROUND_NOT_QUEUED":  2,
+		"BAD_MAC":           3,
+		"UNKNOWN_SENDER":    4,
+		"RATE_LIMITED":      5,
+		"PROXY_UNREACHABLE": 6,
+	}
+)
+
+func (x SlotRejection) Enum() *SlotRejection {
+	p := new(SlotRejection)
+	*p = x
+	return p
+}
+
+func (x SlotRejection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SlotRejection) Descriptor() protoreflect.EnumDescriptor {
+	return file_mixmessages_proto_enumTypes[0].Descriptor()
+}
+
+func (SlotRejection) Type() protoreflect.EnumType {
+	return &file_mixmessages_proto_enumTypes[0]
+}
+
+func (x SlotRejection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SlotRejection.Descriptor instead.
+func (SlotRejection) EnumDescriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{0}
+}
+
 type ClientKeyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -861,6 +923,72 @@ func (x *BatchInfo) GetBatchSize() uint32 {
 	return 0
 }
 
+// Commits to the slots of a streamed batch so the receiver can detect dropped,
+// duplicated or altered slots. Sent in the header of streams to a node and in
+// the trailer of streams from a node.
+type BatchCommitment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SlotCount   uint32   `protobuf:"varint,1,opt,name=SlotCount,proto3" json:"SlotCount,omitempty"`
+	ChunkSize   uint32   `protobuf:"varint,2,opt,name=ChunkSize,proto3" json:"ChunkSize,omitempty"` // Number of slots covered by each chunk hash
+	ChunkHashes [][]byte `protobuf:"bytes,3,rep,name=ChunkHashes,proto3" json:"ChunkHashes,omitempty"`
+}
+
+func (x *BatchCommitment) Reset() {
+	*x = BatchCommitment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCommitment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCommitment) ProtoMessage() {}
+
+func (x *BatchCommitment) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCommitment.ProtoReflect.Descriptor instead.
+func (*BatchCommitment) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BatchCommitment) GetSlotCount() uint32 {
+	if x != nil {
+		return x.SlotCount
+	}
+	return 0
+}
+
+func (x *BatchCommitment) GetChunkSize() uint32 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+func (x *BatchCommitment) GetChunkHashes() [][]byte {
+	if x != nil {
+		return x.ChunkHashes
+	}
+	return nil
+}
+
 // Used for collecting metrics on a round trip of the system
 type RoundTripPing struct {
 	state         protoimpl.MessageState
@@ -874,7 +1002,7 @@ type RoundTripPing struct {
 func (x *RoundTripPing) Reset() {
 	*x = RoundTripPing{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[13]
+		mi := &file_mixmessages_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -887,7 +1015,7 @@ func (x *RoundTripPing) String() string {
 func (*RoundTripPing) ProtoMessage() {}
 
 func (x *RoundTripPing) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[13]
+	mi := &file_mixmessages_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -900,7 +1028,7 @@ func (x *RoundTripPing) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoundTripPing.ProtoReflect.Descriptor instead.
 func (*RoundTripPing) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{13}
+	return file_mixmessages_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *RoundTripPing) GetPayload() *anypb.Any {
@@ -934,7 +1062,7 @@ type ServerPoll struct {
 func (x *ServerPoll) Reset() {
 	*x = ServerPoll{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[14]
+		mi := &file_mixmessages_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -947,7 +1075,7 @@ func (x *ServerPoll) String() string {
 func (*ServerPoll) ProtoMessage() {}
 
 func (x *ServerPoll) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[14]
+	mi := &file_mixmessages_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -960,7 +1088,7 @@ func (x *ServerPoll) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerPoll.ProtoReflect.Descriptor instead.
 func (*ServerPoll) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{14}
+	return file_mixmessages_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ServerPoll) GetFull() *NDFHash {
@@ -1026,7 +1154,7 @@ type ServerPollResponse struct {
 func (x *ServerPollResponse) Reset() {
 	*x = ServerPollResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[15]
+		mi := &file_mixmessages_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1039,7 +1167,7 @@ func (x *ServerPollResponse) String() string {
 func (*ServerPollResponse) ProtoMessage() {}
 
 func (x *ServerPollResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[15]
+	mi := &file_mixmessages_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1052,7 +1180,7 @@ func (x *ServerPollResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerPollResponse.ProtoReflect.Descriptor instead.
 func (*ServerPollResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{15}
+	return file_mixmessages_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ServerPollResponse) GetId() []byte {
@@ -1130,13 +1258,14 @@ type BatchReady struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RoundId uint64 `protobuf:"varint,1,opt,name=RoundId,proto3" json:"RoundId,omitempty"`
+	RoundId    uint64 `protobuf:"varint,1,opt,name=RoundId,proto3" json:"RoundId,omitempty"`
+	StartIndex uint32 `protobuf:"varint,2,opt,name=StartIndex,proto3" json:"StartIndex,omitempty"` // Index of the first slot to send, for resuming
 }
 
 func (x *BatchReady) Reset() {
 	*x = BatchReady{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[16]
+		mi := &file_mixmessages_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1149,7 +1278,7 @@ func (x *BatchReady) String() string {
 func (*BatchReady) ProtoMessage() {}
 
 func (x *BatchReady) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[16]
+	mi := &file_mixmessages_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1162,7 +1291,7 @@ func (x *BatchReady) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchReady.ProtoReflect.Descriptor instead.
 func (*BatchReady) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{16}
+	return file_mixmessages_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *BatchReady) GetRoundId() uint64 {
@@ -1172,6 +1301,13 @@ func (x *BatchReady) GetRoundId() uint64 {
 	return 0
 }
 
+func (x *BatchReady) GetStartIndex() uint32 {
+	if x != nil {
+		return x.StartIndex
+	}
+	return 0
+}
+
 // Used as part of Share phase for generation
 // of a multi-party Diffie-Helman key
 // Node <-> Node message
@@ -1189,7 +1325,7 @@ type SharePiece struct {
 func (x *SharePiece) Reset() {
 	*x = SharePiece{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[17]
+		mi := &file_mixmessages_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1202,7 +1338,7 @@ func (x *SharePiece) String() string {
 func (*SharePiece) ProtoMessage() {}
 
 func (x *SharePiece) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[17]
+	mi := &file_mixmessages_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1215,7 +1351,7 @@ func (x *SharePiece) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SharePiece.ProtoReflect.Descriptor instead.
 func (*SharePiece) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{17}
+	return file_mixmessages_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *SharePiece) GetPiece() []byte {
@@ -1255,7 +1391,7 @@ type RequestGatewayCert struct {
 func (x *RequestGatewayCert) Reset() {
 	*x = RequestGatewayCert{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[18]
+		mi := &file_mixmessages_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1268,7 +1404,7 @@ func (x *RequestGatewayCert) String() string {
 func (*RequestGatewayCert) ProtoMessage() {}
 
 func (x *RequestGatewayCert) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[18]
+	mi := &file_mixmessages_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1281,7 +1417,7 @@ func (x *RequestGatewayCert) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestGatewayCert.ProtoReflect.Descriptor instead.
 func (*RequestGatewayCert) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{18}
+	return file_mixmessages_proto_rawDescGZIP(), []int{19}
 }
 
 type GatewayCertificate struct {
@@ -1296,7 +1432,7 @@ type GatewayCertificate struct {
 func (x *GatewayCertificate) Reset() {
 	*x = GatewayCertificate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[19]
+		mi := &file_mixmessages_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1309,7 +1445,7 @@ func (x *GatewayCertificate) String() string {
 func (*GatewayCertificate) ProtoMessage() {}
 
 func (x *GatewayCertificate) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[19]
+	mi := &file_mixmessages_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1322,7 +1458,7 @@ func (x *GatewayCertificate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GatewayCertificate.ProtoReflect.Descriptor instead.
 func (*GatewayCertificate) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{19}
+	return file_mixmessages_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GatewayCertificate) GetCertificate() []byte {
@@ -1352,7 +1488,7 @@ type StreamChunk struct {
 func (x *StreamChunk) Reset() {
 	*x = StreamChunk{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[20]
+		mi := &file_mixmessages_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1365,7 +1501,7 @@ func (x *StreamChunk) String() string {
 func (*StreamChunk) ProtoMessage() {}
 
 func (x *StreamChunk) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[20]
+	mi := &file_mixmessages_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1378,7 +1514,7 @@ func (x *StreamChunk) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamChunk.ProtoReflect.Descriptor instead.
 func (*StreamChunk) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{20}
+	return file_mixmessages_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *StreamChunk) GetDatum() []byte {
@@ -1400,7 +1536,7 @@ type HistoricalRounds struct {
 func (x *HistoricalRounds) Reset() {
 	*x = HistoricalRounds{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[21]
+		mi := &file_mixmessages_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1413,7 +1549,7 @@ func (x *HistoricalRounds) String() string {
 func (*HistoricalRounds) ProtoMessage() {}
 
 func (x *HistoricalRounds) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[21]
+	mi := &file_mixmessages_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1426,7 +1562,7 @@ func (x *HistoricalRounds) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HistoricalRounds.ProtoReflect.Descriptor instead.
 func (*HistoricalRounds) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{21}
+	return file_mixmessages_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *HistoricalRounds) GetRounds() []uint64 {
@@ -1448,7 +1584,7 @@ type HistoricalRoundsResponse struct {
 func (x *HistoricalRoundsResponse) Reset() {
 	*x = HistoricalRoundsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[22]
+		mi := &file_mixmessages_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1461,7 +1597,7 @@ func (x *HistoricalRoundsResponse) String() string {
 func (*HistoricalRoundsResponse) ProtoMessage() {}
 
 func (x *HistoricalRoundsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[22]
+	mi := &file_mixmessages_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1474,7 +1610,7 @@ func (x *HistoricalRoundsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HistoricalRoundsResponse.ProtoReflect.Descriptor instead.
 func (*HistoricalRoundsResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{22}
+	return file_mixmessages_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *HistoricalRoundsResponse) GetRounds() []*RoundInfo {
@@ -1496,7 +1632,7 @@ type GetMessagesBatch struct {
 func (x *GetMessagesBatch) Reset() {
 	*x = GetMessagesBatch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[23]
+		mi := &file_mixmessages_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1509,7 +1645,7 @@ func (x *GetMessagesBatch) String() string {
 func (*GetMessagesBatch) ProtoMessage() {}
 
 func (x *GetMessagesBatch) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[23]
+	mi := &file_mixmessages_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1522,7 +1658,7 @@ func (x *GetMessagesBatch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMessagesBatch.ProtoReflect.Descriptor instead.
 func (*GetMessagesBatch) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{23}
+	return file_mixmessages_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetMessagesBatch) GetRequests() []*GetMessages {
@@ -1551,7 +1687,7 @@ type GetMessagesResponseBatch struct {
 func (x *GetMessagesResponseBatch) Reset() {
 	*x = GetMessagesResponseBatch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[24]
+		mi := &file_mixmessages_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1564,7 +1700,7 @@ func (x *GetMessagesResponseBatch) String() string {
 func (*GetMessagesResponseBatch) ProtoMessage() {}
 
 func (x *GetMessagesResponseBatch) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[24]
+	mi := &file_mixmessages_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1577,7 +1713,7 @@ func (x *GetMessagesResponseBatch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMessagesResponseBatch.ProtoReflect.Descriptor instead.
 func (*GetMessagesResponseBatch) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{24}
+	return file_mixmessages_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GetMessagesResponseBatch) GetResults() []*GetMessagesResponse {
@@ -1610,7 +1746,7 @@ type GetMessages struct {
 func (x *GetMessages) Reset() {
 	*x = GetMessages{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[25]
+		mi := &file_mixmessages_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1623,7 +1759,7 @@ func (x *GetMessages) String() string {
 func (*GetMessages) ProtoMessage() {}
 
 func (x *GetMessages) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[25]
+	mi := &file_mixmessages_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1636,7 +1772,7 @@ func (x *GetMessages) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMessages.ProtoReflect.Descriptor instead.
 func (*GetMessages) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{25}
+	return file_mixmessages_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetMessages) GetClientID() []byte {
@@ -1673,7 +1809,7 @@ type GetMessagesResponse struct {
 func (x *GetMessagesResponse) Reset() {
 	*x = GetMessagesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[26]
+		mi := &file_mixmessages_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1686,7 +1822,7 @@ func (x *GetMessagesResponse) String() string {
 func (*GetMessagesResponse) ProtoMessage() {}
 
 func (x *GetMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[26]
+	mi := &file_mixmessages_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1699,7 +1835,7 @@ func (x *GetMessagesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMessagesResponse.ProtoReflect.Descriptor instead.
 func (*GetMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{26}
+	return file_mixmessages_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetMessagesResponse) GetMessages() []*Slot {
@@ -1729,7 +1865,7 @@ type RoundMessages struct {
 func (x *RoundMessages) Reset() {
 	*x = RoundMessages{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[27]
+		mi := &file_mixmessages_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1742,7 +1878,7 @@ func (x *RoundMessages) String() string {
 func (*RoundMessages) ProtoMessage() {}
 
 func (x *RoundMessages) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[27]
+	mi := &file_mixmessages_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1755,7 +1891,7 @@ func (x *RoundMessages) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoundMessages.ProtoReflect.Descriptor instead.
 func (*RoundMessages) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{27}
+	return file_mixmessages_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *RoundMessages) GetRoundId() uint64 {
@@ -1772,32 +1908,37 @@ func (x *RoundMessages) GetMessages() []*Slot {
 	return nil
 }
 
-// The message for clients to poll the gateway for Message IDs
-type IDList struct {
+// Copy of a round's messages held by another gateway in the round's team.
+// The gateway which downloaded the batch signs the digests of its slots, so
+// replicas can prove they hold the authentic batch.
+type RoundReplica struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IDs []string `protobuf:"bytes,1,rep,name=IDs,proto3" json:"IDs,omitempty"`
+	Round     *RoundMessages `protobuf:"bytes,1,opt,name=Round,proto3" json:"Round,omitempty"`
+	Digests   [][]byte       `protobuf:"bytes,2,rep,name=Digests,proto3" json:"Digests,omitempty"` // Digest of each slot in Round.Messages
+	Origin    []byte         `protobuf:"bytes,3,opt,name=Origin,proto3" json:"Origin,omitempty"`   // ID of the gateway which downloaded the batch
+	Signature []byte         `protobuf:"bytes,4,opt,name=Signature,proto3" json:"Signature,omitempty"`
 }
 
-func (x *IDList) Reset() {
-	*x = IDList{}
+func (x *RoundReplica) Reset() {
+	*x = RoundReplica{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[28]
+		mi := &file_mixmessages_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IDList) String() string {
+func (x *RoundReplica) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IDList) ProtoMessage() {}
+func (*RoundReplica) ProtoMessage() {}
 
-func (x *IDList) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[28]
+func (x *RoundReplica) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1808,59 +1949,65 @@ func (x *IDList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IDList.ProtoReflect.Descriptor instead.
-func (*IDList) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use RoundReplica.ProtoReflect.Descriptor instead.
+func (*RoundReplica) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *IDList) GetIDs() []string {
+func (x *RoundReplica) GetRound() *RoundMessages {
 	if x != nil {
-		return x.IDs
+		return x.Round
 	}
 	return nil
 }
 
-// Represents a single encrypted message in a batch
-type Slot struct {
+func (x *RoundReplica) GetDigests() [][]byte {
+	if x != nil {
+		return x.Digests
+	}
+	return nil
+}
+
+func (x *RoundReplica) GetOrigin() []byte {
+	if x != nil {
+		return x.Origin
+	}
+	return nil
+}
+
+func (x *RoundReplica) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// Gateway -> Gateway request for a replica of a round's messages
+type GetRoundReplica struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Index in batch this slot belongs in
-	Index uint32 `protobuf:"varint,1,opt,name=Index,proto3" json:"Index,omitempty"`
-	// Precomputation fields
-	EncryptedPayloadAKeys       []byte `protobuf:"bytes,2,opt,name=EncryptedPayloadAKeys,proto3" json:"EncryptedPayloadAKeys,omitempty"`
-	EncryptedPayloadBKeys       []byte `protobuf:"bytes,3,opt,name=EncryptedPayloadBKeys,proto3" json:"EncryptedPayloadBKeys,omitempty"`
-	PartialPayloadACypherText   []byte `protobuf:"bytes,4,opt,name=PartialPayloadACypherText,proto3" json:"PartialPayloadACypherText,omitempty"`
-	PartialPayloadBCypherText   []byte `protobuf:"bytes,5,opt,name=PartialPayloadBCypherText,proto3" json:"PartialPayloadBCypherText,omitempty"`
-	PartialRoundPublicCypherKey []byte `protobuf:"bytes,6,opt,name=PartialRoundPublicCypherKey,proto3" json:"PartialRoundPublicCypherKey,omitempty"`
-	// Realtime/client fields
-	SenderID      []byte   `protobuf:"bytes,7,opt,name=SenderID,proto3" json:"SenderID,omitempty"`                    // 256 bit Sender Id
-	PayloadA      []byte   `protobuf:"bytes,8,opt,name=PayloadA,proto3" json:"PayloadA,omitempty"`                    // Len(Prime) bit length payload A (contains part of encrypted payload)
-	PayloadB      []byte   `protobuf:"bytes,9,opt,name=PayloadB,proto3" json:"PayloadB,omitempty"`                    // Len(Prime) bit length payload B (contains part of encrypted payload, and associated data)
-	Salt          []byte   `protobuf:"bytes,10,opt,name=Salt,proto3" json:"Salt,omitempty"`                           // Salt to identify message key
-	KMACs         [][]byte `protobuf:"bytes,11,rep,name=KMACs,proto3" json:"KMACs,omitempty"`                         // Individual Key MAC for each node in network
-	Ed25519       []byte   `protobuf:"bytes,12,opt,name=Ed25519,proto3" json:"Ed25519,omitempty"`                     // Client ephemeral ed25519 key, if applicable
-	EphemeralKeys []bool   `protobuf:"varint,13,rep,packed,name=EphemeralKeys,proto3" json:"EphemeralKeys,omitempty"` // true for each node which used ephemeral key generation
+	RoundId uint64 `protobuf:"varint,1,opt,name=RoundId,proto3" json:"RoundId,omitempty"`
 }
 
-func (x *Slot) Reset() {
-	*x = Slot{}
+func (x *GetRoundReplica) Reset() {
+	*x = GetRoundReplica{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[29]
+		mi := &file_mixmessages_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Slot) String() string {
+func (x *GetRoundReplica) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Slot) ProtoMessage() {}
+func (*GetRoundReplica) ProtoMessage() {}
 
-func (x *Slot) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[29]
+func (x *GetRoundReplica) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1871,33 +2018,144 @@ func (x *Slot) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Slot.ProtoReflect.Descriptor instead.
-func (*Slot) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use GetRoundReplica.ProtoReflect.Descriptor instead.
+func (*GetRoundReplica) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *Slot) GetIndex() uint32 {
+func (x *GetRoundReplica) GetRoundId() uint64 {
 	if x != nil {
-		return x.Index
+		return x.RoundId
 	}
 	return 0
 }
 
-func (x *Slot) GetEncryptedPayloadAKeys() []byte {
-	if x != nil {
-		return x.EncryptedPayloadAKeys
-	}
-	return nil
+// The message for clients to poll the gateway for Message IDs
+type IDList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IDs []string `protobuf:"bytes,1,rep,name=IDs,proto3" json:"IDs,omitempty"`
 }
 
-func (x *Slot) GetEncryptedPayloadBKeys() []byte {
-	if x != nil {
-		return x.EncryptedPayloadBKeys
+func (x *IDList) Reset() {
+	*x = IDList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Slot) GetPartialPayloadACypherText() []byte {
+func (x *IDList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IDList) ProtoMessage() {}
+
+func (x *IDList) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IDList.ProtoReflect.Descriptor instead.
+func (*IDList) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *IDList) GetIDs() []string {
+	if x != nil {
+		return x.IDs
+	}
+	return nil
+}
+
+// Represents a single encrypted message in a batch
+type Slot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Index in batch this slot belongs in
+	Index uint32 `protobuf:"varint,1,opt,name=Index,proto3" json:"Index,omitempty"`
+	// Precomputation fields
+	EncryptedPayloadAKeys       []byte `protobuf:"bytes,2,opt,name=EncryptedPayloadAKeys,proto3" json:"EncryptedPayloadAKeys,omitempty"`
+	EncryptedPayloadBKeys       []byte `protobuf:"bytes,3,opt,name=EncryptedPayloadBKeys,proto3" json:"EncryptedPayloadBKeys,omitempty"`
+	PartialPayloadACypherText   []byte `protobuf:"bytes,4,opt,name=PartialPayloadACypherText,proto3" json:"PartialPayloadACypherText,omitempty"`
+	PartialPayloadBCypherText   []byte `protobuf:"bytes,5,opt,name=PartialPayloadBCypherText,proto3" json:"PartialPayloadBCypherText,omitempty"`
+	PartialRoundPublicCypherKey []byte `protobuf:"bytes,6,opt,name=PartialRoundPublicCypherKey,proto3" json:"PartialRoundPublicCypherKey,omitempty"`
+	// Realtime/client fields
+	SenderID      []byte   `protobuf:"bytes,7,opt,name=SenderID,proto3" json:"SenderID,omitempty"`                    // 256 bit Sender Id
+	PayloadA      []byte   `protobuf:"bytes,8,opt,name=PayloadA,proto3" json:"PayloadA,omitempty"`                    // Len(Prime) bit length payload A (contains part of encrypted payload)
+	PayloadB      []byte   `protobuf:"bytes,9,opt,name=PayloadB,proto3" json:"PayloadB,omitempty"`                    // Len(Prime) bit length payload B (contains part of encrypted payload, and associated data)
+	Salt          []byte   `protobuf:"bytes,10,opt,name=Salt,proto3" json:"Salt,omitempty"`                           // Salt to identify message key
+	KMACs         [][]byte `protobuf:"bytes,11,rep,name=KMACs,proto3" json:"KMACs,omitempty"`                         // Individual Key MAC for each node in network
+	Ed25519       []byte   `protobuf:"bytes,12,opt,name=Ed25519,proto3" json:"Ed25519,omitempty"`                     // Client ephemeral ed25519 key, if applicable
+	EphemeralKeys []bool   `protobuf:"varint,13,rep,packed,name=EphemeralKeys,proto3" json:"EphemeralKeys,omitempty"` // true for each node which used ephemeral key generation
+}
+
+func (x *Slot) Reset() {
+	*x = Slot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Slot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Slot) ProtoMessage() {}
+
+func (x *Slot) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Slot.ProtoReflect.Descriptor instead.
+func (*Slot) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Slot) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *Slot) GetEncryptedPayloadAKeys() []byte {
+	if x != nil {
+		return x.EncryptedPayloadAKeys
+	}
+	return nil
+}
+
+func (x *Slot) GetEncryptedPayloadBKeys() []byte {
+	if x != nil {
+		return x.EncryptedPayloadBKeys
+	}
+	return nil
+}
+
+func (x *Slot) GetPartialPayloadACypherText() []byte {
 	if x != nil {
 		return x.PartialPayloadACypherText
 	}
@@ -1996,7 +2254,7 @@ type GatewayPoll struct {
 func (x *GatewayPoll) Reset() {
 	*x = GatewayPoll{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[30]
+		mi := &file_mixmessages_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2009,7 +2267,7 @@ func (x *GatewayPoll) String() string {
 func (*GatewayPoll) ProtoMessage() {}
 
 func (x *GatewayPoll) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[30]
+	mi := &file_mixmessages_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2022,7 +2280,7 @@ func (x *GatewayPoll) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GatewayPoll.ProtoReflect.Descriptor instead.
 func (*GatewayPoll) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{30}
+	return file_mixmessages_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *GatewayPoll) GetPartial() *NDFHash {
@@ -2108,7 +2366,7 @@ type GatewayPollResponse struct {
 func (x *GatewayPollResponse) Reset() {
 	*x = GatewayPollResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[31]
+		mi := &file_mixmessages_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2121,7 +2379,7 @@ func (x *GatewayPollResponse) String() string {
 func (*GatewayPollResponse) ProtoMessage() {}
 
 func (x *GatewayPollResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[31]
+	mi := &file_mixmessages_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2134,7 +2392,7 @@ func (x *GatewayPollResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GatewayPollResponse.ProtoReflect.Descriptor instead.
 func (*GatewayPollResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{31}
+	return file_mixmessages_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *GatewayPollResponse) GetPartialNDF() *NDF {
@@ -2207,7 +2465,7 @@ type ClientBlooms struct {
 func (x *ClientBlooms) Reset() {
 	*x = ClientBlooms{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[32]
+		mi := &file_mixmessages_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2220,7 +2478,7 @@ func (x *ClientBlooms) String() string {
 func (*ClientBlooms) ProtoMessage() {}
 
 func (x *ClientBlooms) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[32]
+	mi := &file_mixmessages_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2233,7 +2491,7 @@ func (x *ClientBlooms) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClientBlooms.ProtoReflect.Descriptor instead.
 func (*ClientBlooms) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{32}
+	return file_mixmessages_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ClientBlooms) GetPeriod() int64 {
@@ -2271,7 +2529,7 @@ type ClientBloom struct {
 func (x *ClientBloom) Reset() {
 	*x = ClientBloom{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[33]
+		mi := &file_mixmessages_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2284,7 +2542,7 @@ func (x *ClientBloom) String() string {
 func (*ClientBloom) ProtoMessage() {}
 
 func (x *ClientBloom) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[33]
+	mi := &file_mixmessages_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2297,7 +2555,7 @@ func (x *ClientBloom) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClientBloom.ProtoReflect.Descriptor instead.
 func (*ClientBloom) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{33}
+	return file_mixmessages_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ClientBloom) GetFilter() []byte {
@@ -2337,7 +2595,7 @@ type GatewaySlots struct {
 func (x *GatewaySlots) Reset() {
 	*x = GatewaySlots{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[34]
+		mi := &file_mixmessages_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2350,7 +2608,7 @@ func (x *GatewaySlots) String() string {
 func (*GatewaySlots) ProtoMessage() {}
 
 func (x *GatewaySlots) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[34]
+	mi := &file_mixmessages_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2363,7 +2621,7 @@ func (x *GatewaySlots) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GatewaySlots.ProtoReflect.Descriptor instead.
 func (*GatewaySlots) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{34}
+	return file_mixmessages_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *GatewaySlots) GetMessages() []*GatewaySlot {
@@ -2410,7 +2668,7 @@ type GatewaySlot struct {
 func (x *GatewaySlot) Reset() {
 	*x = GatewaySlot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[35]
+		mi := &file_mixmessages_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2423,7 +2681,7 @@ func (x *GatewaySlot) String() string {
 func (*GatewaySlot) ProtoMessage() {}
 
 func (x *GatewaySlot) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[35]
+	mi := &file_mixmessages_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2436,7 +2694,7 @@ func (x *GatewaySlot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GatewaySlot.ProtoReflect.Descriptor instead.
 func (*GatewaySlot) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{35}
+	return file_mixmessages_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *GatewaySlot) GetMessage() *Slot {
@@ -2474,20 +2732,26 @@ func (x *GatewaySlot) GetIpAddr() string {
 	return ""
 }
 
-// Gateway -> Client authentication response
+// Gateway -> Client authentication response. Messages the gateway rejects
+// with a reason fail with a gRPC status error whose details hold this
+// response, with accepted unset and the Rejection set.
 type GatewaySlotResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Accepted bool   `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
-	RoundID  uint64 `protobuf:"varint,2,opt,name=RoundID,proto3" json:"RoundID,omitempty"`
+	Accepted         bool          `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	RoundID          uint64        `protobuf:"varint,2,opt,name=RoundID,proto3" json:"RoundID,omitempty"`
+	Rejection        SlotRejection `protobuf:"varint,3,opt,name=Rejection,proto3,enum=mixmessages.SlotRejection" json:"Rejection,omitempty"` // Why the messages were not accepted
+	RetryAfter       int64         `protobuf:"varint,4,opt,name=RetryAfter,proto3" json:"RetryAfter,omitempty"`                              // Nanoseconds to wait before sending again
+	SuggestedRoundID uint64        `protobuf:"varint,5,opt,name=SuggestedRoundID,proto3" json:"SuggestedRoundID,omitempty"`                  // Round to send to instead, zero if none
+	Error            string        `protobuf:"bytes,6,opt,name=Error,proto3" json:"Error,omitempty"`                                         // Description of the rejection
 }
 
 func (x *GatewaySlotResponse) Reset() {
 	*x = GatewaySlotResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[36]
+		mi := &file_mixmessages_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2500,7 +2764,7 @@ func (x *GatewaySlotResponse) String() string {
 func (*GatewaySlotResponse) ProtoMessage() {}
 
 func (x *GatewaySlotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[36]
+	mi := &file_mixmessages_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2513,7 +2777,7 @@ func (x *GatewaySlotResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GatewaySlotResponse.ProtoReflect.Descriptor instead.
 func (*GatewaySlotResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{36}
+	return file_mixmessages_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *GatewaySlotResponse) GetAccepted() bool {
@@ -2530,6 +2794,34 @@ func (x *GatewaySlotResponse) GetRoundID() uint64 {
 	return 0
 }
 
+func (x *GatewaySlotResponse) GetRejection() SlotRejection {
+	if x != nil {
+		return x.Rejection
+	}
+	return SlotRejection_NOT_REJECTED
+}
+
+func (x *GatewaySlotResponse) GetRetryAfter() int64 {
+	if x != nil {
+		return x.RetryAfter
+	}
+	return 0
+}
+
+func (x *GatewaySlotResponse) GetSuggestedRoundID() uint64 {
+	if x != nil {
+		return x.SuggestedRoundID
+	}
+	return 0
+}
+
+func (x *GatewaySlotResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 // Gateway -> Gateway gossip of all Sender IDs in a Batch
 type BatchSenders struct {
 	state         protoimpl.MessageState
@@ -2544,7 +2836,7 @@ type BatchSenders struct {
 func (x *BatchSenders) Reset() {
 	*x = BatchSenders{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[37]
+		mi := &file_mixmessages_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2557,7 +2849,7 @@ func (x *BatchSenders) String() string {
 func (*BatchSenders) ProtoMessage() {}
 
 func (x *BatchSenders) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[37]
+	mi := &file_mixmessages_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2570,7 +2862,7 @@ func (x *BatchSenders) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchSenders.ProtoReflect.Descriptor instead.
 func (*BatchSenders) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{37}
+	return file_mixmessages_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *BatchSenders) GetSenderIds() [][]byte {
@@ -2608,7 +2900,7 @@ type Recipients struct {
 func (x *Recipients) Reset() {
 	*x = Recipients{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[38]
+		mi := &file_mixmessages_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2621,7 +2913,7 @@ func (x *Recipients) String() string {
 func (*Recipients) ProtoMessage() {}
 
 func (x *Recipients) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[38]
+	mi := &file_mixmessages_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2634,7 +2926,7 @@ func (x *Recipients) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Recipients.ProtoReflect.Descriptor instead.
 func (*Recipients) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{38}
+	return file_mixmessages_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *Recipients) GetRecipientIds() [][]byte {
@@ -2658,32 +2950,36 @@ func (x *Recipients) GetRoundTS() uint64 {
 	return 0
 }
 
-// Server -> Permissioning message for whether a node has been registered
-type RegisteredNodeConfirmation struct {
+// Node -> Permissioning request to replace the credentials it registered with,
+// signed with its current key
+type NodeCredentialRotation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsRegistered bool `protobuf:"varint,1,opt,name=IsRegistered,proto3" json:"IsRegistered,omitempty"`
+	Salt           []byte `protobuf:"bytes,1,opt,name=Salt,proto3" json:"Salt,omitempty"`
+	ServerTlsCert  string `protobuf:"bytes,2,opt,name=ServerTlsCert,proto3" json:"ServerTlsCert,omitempty"`
+	GatewayTlsCert string `protobuf:"bytes,3,opt,name=GatewayTlsCert,proto3" json:"GatewayTlsCert,omitempty"`
+	Timestamp      int64  `protobuf:"varint,4,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"` // Unix nanoseconds
 }
 
-func (x *RegisteredNodeConfirmation) Reset() {
-	*x = RegisteredNodeConfirmation{}
+func (x *NodeCredentialRotation) Reset() {
+	*x = NodeCredentialRotation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[39]
+		mi := &file_mixmessages_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RegisteredNodeConfirmation) String() string {
+func (x *NodeCredentialRotation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisteredNodeConfirmation) ProtoMessage() {}
+func (*NodeCredentialRotation) ProtoMessage() {}
 
-func (x *RegisteredNodeConfirmation) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[39]
+func (x *NodeCredentialRotation) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2694,43 +2990,65 @@ func (x *RegisteredNodeConfirmation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisteredNodeConfirmation.ProtoReflect.Descriptor instead.
-func (*RegisteredNodeConfirmation) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use NodeCredentialRotation.ProtoReflect.Descriptor instead.
+func (*NodeCredentialRotation) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *RegisteredNodeConfirmation) GetIsRegistered() bool {
+func (x *NodeCredentialRotation) GetSalt() []byte {
 	if x != nil {
-		return x.IsRegistered
+		return x.Salt
 	}
-	return false
+	return nil
 }
 
-type RegisteredNodeCheck struct {
+func (x *NodeCredentialRotation) GetServerTlsCert() string {
+	if x != nil {
+		return x.ServerTlsCert
+	}
+	return ""
+}
+
+func (x *NodeCredentialRotation) GetGatewayTlsCert() string {
+	if x != nil {
+		return x.GatewayTlsCert
+	}
+	return ""
+}
+
+func (x *NodeCredentialRotation) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// Node -> Permissioning request to leave the network, signed with its key
+type NodeDeregistration struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID []byte `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Timestamp int64 `protobuf:"varint,1,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"` // Unix nanoseconds
 }
 
-func (x *RegisteredNodeCheck) Reset() {
-	*x = RegisteredNodeCheck{}
+func (x *NodeDeregistration) Reset() {
+	*x = NodeDeregistration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[40]
+		mi := &file_mixmessages_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RegisteredNodeCheck) String() string {
+func (x *NodeDeregistration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisteredNodeCheck) ProtoMessage() {}
+func (*NodeDeregistration) ProtoMessage() {}
 
-func (x *RegisteredNodeCheck) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[40]
+func (x *NodeDeregistration) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2741,44 +3059,139 @@ func (x *RegisteredNodeCheck) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisteredNodeCheck.ProtoReflect.Descriptor instead.
-func (*RegisteredNodeCheck) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use NodeDeregistration.ProtoReflect.Descriptor instead.
+func (*NodeDeregistration) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *RegisteredNodeCheck) GetID() []byte {
+func (x *NodeDeregistration) GetTimestamp() int64 {
 	if x != nil {
-		return x.ID
+		return x.Timestamp
 	}
-	return nil
+	return 0
 }
 
-// The ndf Hash used to compare ndf on permissioning and client
-type NDFHash struct {
+// Server -> Permissioning message for whether a node has been registered
+type RegisteredNodeConfirmation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hash []byte `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	IsRegistered bool `protobuf:"varint,1,opt,name=IsRegistered,proto3" json:"IsRegistered,omitempty"`
 }
 
-func (x *NDFHash) Reset() {
-	*x = NDFHash{}
+func (x *RegisteredNodeConfirmation) Reset() {
+	*x = RegisteredNodeConfirmation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[41]
+		mi := &file_mixmessages_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NDFHash) String() string {
+func (x *RegisteredNodeConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisteredNodeConfirmation) ProtoMessage() {}
+
+func (x *RegisteredNodeConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisteredNodeConfirmation.ProtoReflect.Descriptor instead.
+func (*RegisteredNodeConfirmation) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RegisteredNodeConfirmation) GetIsRegistered() bool {
+	if x != nil {
+		return x.IsRegistered
+	}
+	return false
+}
+
+type RegisteredNodeCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID []byte `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *RegisteredNodeCheck) Reset() {
+	*x = RegisteredNodeCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisteredNodeCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisteredNodeCheck) ProtoMessage() {}
+
+func (x *RegisteredNodeCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisteredNodeCheck.ProtoReflect.Descriptor instead.
+func (*RegisteredNodeCheck) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RegisteredNodeCheck) GetID() []byte {
+	if x != nil {
+		return x.ID
+	}
+	return nil
+}
+
+// The ndf Hash used to compare ndf on permissioning and client
+type NDFHash struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash []byte `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+}
+
+func (x *NDFHash) Reset() {
+	*x = NDFHash{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NDFHash) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
 func (*NDFHash) ProtoMessage() {}
 
 func (x *NDFHash) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[41]
+	mi := &file_mixmessages_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2791,7 +3204,7 @@ func (x *NDFHash) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NDFHash.ProtoReflect.Descriptor instead.
 func (*NDFHash) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{41}
+	return file_mixmessages_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *NDFHash) GetHash() []byte {
@@ -2817,7 +3230,7 @@ type NDF struct {
 func (x *NDF) Reset() {
 	*x = NDF{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[42]
+		mi := &file_mixmessages_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2830,7 +3243,7 @@ func (x *NDF) String() string {
 func (*NDF) ProtoMessage() {}
 
 func (x *NDF) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[42]
+	mi := &file_mixmessages_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2843,7 +3256,7 @@ func (x *NDF) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NDF.ProtoReflect.Descriptor instead.
 func (*NDF) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{42}
+	return file_mixmessages_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *NDF) GetNdf() []byte {
@@ -2882,7 +3295,7 @@ type NodeRegistration struct {
 func (x *NodeRegistration) Reset() {
 	*x = NodeRegistration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[43]
+		mi := &file_mixmessages_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2895,7 +3308,7 @@ func (x *NodeRegistration) String() string {
 func (*NodeRegistration) ProtoMessage() {}
 
 func (x *NodeRegistration) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[43]
+	mi := &file_mixmessages_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2908,7 +3321,7 @@ func (x *NodeRegistration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeRegistration.ProtoReflect.Descriptor instead.
 func (*NodeRegistration) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{43}
+	return file_mixmessages_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *NodeRegistration) GetSalt() []byte {
@@ -2977,12 +3390,15 @@ type ClientRegistration struct {
 	RegistrationCode            string `protobuf:"bytes,1,opt,name=RegistrationCode,proto3" json:"RegistrationCode,omitempty"`
 	ClientTransmissionRSAPubKey string `protobuf:"bytes,2,opt,name=ClientTransmissionRSAPubKey,proto3" json:"ClientTransmissionRSAPubKey,omitempty"`
 	ClientReceptionRSAPubKey    string `protobuf:"bytes,3,opt,name=ClientReceptionRSAPubKey,proto3" json:"ClientReceptionRSAPubKey,omitempty"`
+	// Proof-of-work challenge nonce and its solution, when required
+	ChallengeNonce    []byte `protobuf:"bytes,4,opt,name=ChallengeNonce,proto3" json:"ChallengeNonce,omitempty"`
+	ChallengeSolution []byte `protobuf:"bytes,5,opt,name=ChallengeSolution,proto3" json:"ChallengeSolution,omitempty"`
 }
 
 func (x *ClientRegistration) Reset() {
 	*x = ClientRegistration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[44]
+		mi := &file_mixmessages_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2995,7 +3411,7 @@ func (x *ClientRegistration) String() string {
 func (*ClientRegistration) ProtoMessage() {}
 
 func (x *ClientRegistration) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[44]
+	mi := &file_mixmessages_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3008,7 +3424,7 @@ func (x *ClientRegistration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClientRegistration.ProtoReflect.Descriptor instead.
 func (*ClientRegistration) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{44}
+	return file_mixmessages_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *ClientRegistration) GetRegistrationCode() string {
@@ -3032,34 +3448,44 @@ func (x *ClientRegistration) GetClientReceptionRSAPubKey() string {
 	return ""
 }
 
-// ClientRegistrationConfirmation to confirm registration with Clients
-// ClientRegistrar -> Client (Response to ClientRegistration)
-type ClientRegistrationConfirmation struct {
+func (x *ClientRegistration) GetChallengeNonce() []byte {
+	if x != nil {
+		return x.ChallengeNonce
+	}
+	return nil
+}
+
+func (x *ClientRegistration) GetChallengeSolution() []byte {
+	if x != nil {
+		return x.ChallengeSolution
+	}
+	return nil
+}
+
+// Client -> ClientRegistrar
+type RegistrationChallengeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	RSAPubKey string `protobuf:"bytes,1,opt,name=RSAPubKey,proto3" json:"RSAPubKey,omitempty"`
-	Timestamp int64  `protobuf:"varint,2,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
 }
 
-func (x *ClientRegistrationConfirmation) Reset() {
-	*x = ClientRegistrationConfirmation{}
+func (x *RegistrationChallengeRequest) Reset() {
+	*x = RegistrationChallengeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[45]
+		mi := &file_mixmessages_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClientRegistrationConfirmation) String() string {
+func (x *RegistrationChallengeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClientRegistrationConfirmation) ProtoMessage() {}
+func (*RegistrationChallengeRequest) ProtoMessage() {}
 
-func (x *ClientRegistrationConfirmation) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[45]
+func (x *RegistrationChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3070,51 +3496,42 @@ func (x *ClientRegistrationConfirmation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClientRegistrationConfirmation.ProtoReflect.Descriptor instead.
-func (*ClientRegistrationConfirmation) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{45}
-}
-
-func (x *ClientRegistrationConfirmation) GetRSAPubKey() string {
-	if x != nil {
-		return x.RSAPubKey
-	}
-	return ""
-}
-
-func (x *ClientRegistrationConfirmation) GetTimestamp() int64 {
-	if x != nil {
-		return x.Timestamp
-	}
-	return 0
+// Deprecated: Use RegistrationChallengeRequest.ProtoReflect.Descriptor instead.
+func (*RegistrationChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{50}
 }
 
-type SignedRegistrationConfirmation struct {
+// Proof-of-work challenge. A solution is valid if the hash of the nonce, the
+// client's transmission RSA public key and the solution begins with
+// Difficulty zero bits.
+// ClientRegistrar -> Client (Response to RegistrationChallengeRequest)
+type RegistrationChallenge struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClientRegistrationConfirmation []byte                 `protobuf:"bytes,1,opt,name=ClientRegistrationConfirmation,proto3" json:"ClientRegistrationConfirmation,omitempty"`
-	RegistrarSignature             *messages.RSASignature `protobuf:"bytes,2,opt,name=RegistrarSignature,proto3" json:"RegistrarSignature,omitempty"`
+	Nonce      []byte `protobuf:"bytes,1,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Difficulty uint32 `protobuf:"varint,2,opt,name=Difficulty,proto3" json:"Difficulty,omitempty"`
+	Expiry     int64  `protobuf:"varint,3,opt,name=Expiry,proto3" json:"Expiry,omitempty"`
 }
 
-func (x *SignedRegistrationConfirmation) Reset() {
-	*x = SignedRegistrationConfirmation{}
+func (x *RegistrationChallenge) Reset() {
+	*x = RegistrationChallenge{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[46]
+		mi := &file_mixmessages_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SignedRegistrationConfirmation) String() string {
+func (x *RegistrationChallenge) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignedRegistrationConfirmation) ProtoMessage() {}
+func (*RegistrationChallenge) ProtoMessage() {}
 
-func (x *SignedRegistrationConfirmation) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[46]
+func (x *RegistrationChallenge) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3125,52 +3542,58 @@ func (x *SignedRegistrationConfirmation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignedRegistrationConfirmation.ProtoReflect.Descriptor instead.
-func (*SignedRegistrationConfirmation) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use RegistrationChallenge.ProtoReflect.Descriptor instead.
+func (*RegistrationChallenge) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *SignedRegistrationConfirmation) GetClientRegistrationConfirmation() []byte {
+func (x *RegistrationChallenge) GetNonce() []byte {
 	if x != nil {
-		return x.ClientRegistrationConfirmation
+		return x.Nonce
 	}
 	return nil
 }
 
-func (x *SignedRegistrationConfirmation) GetRegistrarSignature() *messages.RSASignature {
+func (x *RegistrationChallenge) GetDifficulty() uint32 {
 	if x != nil {
-		return x.RegistrarSignature
+		return x.Difficulty
 	}
-	return nil
+	return 0
 }
 
-type SignedClientRegistrationConfirmations struct {
+func (x *RegistrationChallenge) GetExpiry() int64 {
+	if x != nil {
+		return x.Expiry
+	}
+	return 0
+}
+
+// Client -> ClientRegistrar
+type ClientRegistrationBatch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClientTransmissionConfirmation *SignedRegistrationConfirmation `protobuf:"bytes,1,opt,name=ClientTransmissionConfirmation,proto3" json:"ClientTransmissionConfirmation,omitempty"`
-	ClientReceptionConfirmation    *SignedRegistrationConfirmation `protobuf:"bytes,2,opt,name=ClientReceptionConfirmation,proto3" json:"ClientReceptionConfirmation,omitempty"`
-	Error                          string                          `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+	Registrations []*ClientRegistration `protobuf:"bytes,1,rep,name=Registrations,proto3" json:"Registrations,omitempty"`
 }
 
-func (x *SignedClientRegistrationConfirmations) Reset() {
-	*x = SignedClientRegistrationConfirmations{}
+func (x *ClientRegistrationBatch) Reset() {
+	*x = ClientRegistrationBatch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[47]
+		mi := &file_mixmessages_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SignedClientRegistrationConfirmations) String() string {
+func (x *ClientRegistrationBatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignedClientRegistrationConfirmations) ProtoMessage() {}
+func (*ClientRegistrationBatch) ProtoMessage() {}
 
-func (x *SignedClientRegistrationConfirmations) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[47]
+func (x *ClientRegistrationBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3181,58 +3604,46 @@ func (x *SignedClientRegistrationConfirmations) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignedClientRegistrationConfirmations.ProtoReflect.Descriptor instead.
-func (*SignedClientRegistrationConfirmations) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{47}
-}
-
-func (x *SignedClientRegistrationConfirmations) GetClientTransmissionConfirmation() *SignedRegistrationConfirmation {
-	if x != nil {
-		return x.ClientTransmissionConfirmation
-	}
-	return nil
+// Deprecated: Use ClientRegistrationBatch.ProtoReflect.Descriptor instead.
+func (*ClientRegistrationBatch) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *SignedClientRegistrationConfirmations) GetClientReceptionConfirmation() *SignedRegistrationConfirmation {
+func (x *ClientRegistrationBatch) GetRegistrations() []*ClientRegistration {
 	if x != nil {
-		return x.ClientReceptionConfirmation
+		return x.Registrations
 	}
 	return nil
 }
 
-func (x *SignedClientRegistrationConfirmations) GetError() string {
-	if x != nil {
-		return x.Error
-	}
-	return ""
-}
-
-// ClientVersion contains a version string for the client
-type ClientVersion struct {
+// Confirmations in the order of the registrations in the batch. Registrations
+// which were rejected have their Error set.
+// ClientRegistrar -> Client (Response to ClientRegistrationBatch)
+type SignedClientRegistrationConfirmationsBatch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Version string `protobuf:"bytes,1,opt,name=Version,proto3" json:"Version,omitempty"`
+	Confirmations []*SignedClientRegistrationConfirmations `protobuf:"bytes,1,rep,name=Confirmations,proto3" json:"Confirmations,omitempty"`
 }
 
-func (x *ClientVersion) Reset() {
-	*x = ClientVersion{}
+func (x *SignedClientRegistrationConfirmationsBatch) Reset() {
+	*x = SignedClientRegistrationConfirmationsBatch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[48]
+		mi := &file_mixmessages_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClientVersion) String() string {
+func (x *SignedClientRegistrationConfirmationsBatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClientVersion) ProtoMessage() {}
+func (*SignedClientRegistrationConfirmationsBatch) ProtoMessage() {}
 
-func (x *ClientVersion) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[48]
+func (x *SignedClientRegistrationConfirmationsBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3243,33 +3654,256 @@ func (x *ClientVersion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClientVersion.ProtoReflect.Descriptor instead.
-func (*ClientVersion) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use SignedClientRegistrationConfirmationsBatch.ProtoReflect.Descriptor instead.
+func (*SignedClientRegistrationConfirmationsBatch) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *ClientVersion) GetVersion() string {
+func (x *SignedClientRegistrationConfirmationsBatch) GetConfirmations() []*SignedClientRegistrationConfirmations {
 	if x != nil {
-		return x.Version
+		return x.Confirmations
 	}
-	return ""
+	return nil
 }
 
-// Unified Server->Permissioning polling message
-type PermissioningPoll struct {
+// ClientRegistrationConfirmation to confirm registration with Clients
+// ClientRegistrar -> Client (Response to ClientRegistration)
+type ClientRegistrationConfirmation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Full           *NDFHash       `protobuf:"bytes,1,opt,name=Full,proto3" json:"Full,omitempty"`
-	Partial        *NDFHash       `protobuf:"bytes,2,opt,name=Partial,proto3" json:"Partial,omitempty"`
-	LastUpdate     uint64         `protobuf:"varint,3,opt,name=LastUpdate,proto3" json:"LastUpdate,omitempty"`
-	Activity       uint32         `protobuf:"varint,4,opt,name=Activity,proto3" json:"Activity,omitempty"`
-	Error          *RoundError    `protobuf:"bytes,5,opt,name=Error,proto3" json:"Error,omitempty"` // Only Populated in the event of errors
-	GatewayAddress string         `protobuf:"bytes,6,opt,name=gatewayAddress,proto3" json:"gatewayAddress,omitempty"`
-	GatewayVersion string         `protobuf:"bytes,7,opt,name=gatewayVersion,proto3" json:"gatewayVersion,omitempty"`
-	ServerAddress  string         `protobuf:"bytes,8,opt,name=serverAddress,proto3" json:"serverAddress,omitempty"`
-	ServerVersion  string         `protobuf:"bytes,9,opt,name=serverVersion,proto3" json:"serverVersion,omitempty"`
+	RSAPubKey string `protobuf:"bytes,1,opt,name=RSAPubKey,proto3" json:"RSAPubKey,omitempty"`
+	Timestamp int64  `protobuf:"varint,2,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+}
+
+func (x *ClientRegistrationConfirmation) Reset() {
+	*x = ClientRegistrationConfirmation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientRegistrationConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientRegistrationConfirmation) ProtoMessage() {}
+
+func (x *ClientRegistrationConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientRegistrationConfirmation.ProtoReflect.Descriptor instead.
+func (*ClientRegistrationConfirmation) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ClientRegistrationConfirmation) GetRSAPubKey() string {
+	if x != nil {
+		return x.RSAPubKey
+	}
+	return ""
+}
+
+func (x *ClientRegistrationConfirmation) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type SignedRegistrationConfirmation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientRegistrationConfirmation []byte                 `protobuf:"bytes,1,opt,name=ClientRegistrationConfirmation,proto3" json:"ClientRegistrationConfirmation,omitempty"`
+	RegistrarSignature             *messages.RSASignature `protobuf:"bytes,2,opt,name=RegistrarSignature,proto3" json:"RegistrarSignature,omitempty"`
+}
+
+func (x *SignedRegistrationConfirmation) Reset() {
+	*x = SignedRegistrationConfirmation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignedRegistrationConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignedRegistrationConfirmation) ProtoMessage() {}
+
+func (x *SignedRegistrationConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignedRegistrationConfirmation.ProtoReflect.Descriptor instead.
+func (*SignedRegistrationConfirmation) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SignedRegistrationConfirmation) GetClientRegistrationConfirmation() []byte {
+	if x != nil {
+		return x.ClientRegistrationConfirmation
+	}
+	return nil
+}
+
+func (x *SignedRegistrationConfirmation) GetRegistrarSignature() *messages.RSASignature {
+	if x != nil {
+		return x.RegistrarSignature
+	}
+	return nil
+}
+
+type SignedClientRegistrationConfirmations struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientTransmissionConfirmation *SignedRegistrationConfirmation `protobuf:"bytes,1,opt,name=ClientTransmissionConfirmation,proto3" json:"ClientTransmissionConfirmation,omitempty"`
+	ClientReceptionConfirmation    *SignedRegistrationConfirmation `protobuf:"bytes,2,opt,name=ClientReceptionConfirmation,proto3" json:"ClientReceptionConfirmation,omitempty"`
+	Error                          string                          `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *SignedClientRegistrationConfirmations) Reset() {
+	*x = SignedClientRegistrationConfirmations{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignedClientRegistrationConfirmations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignedClientRegistrationConfirmations) ProtoMessage() {}
+
+func (x *SignedClientRegistrationConfirmations) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignedClientRegistrationConfirmations.ProtoReflect.Descriptor instead.
+func (*SignedClientRegistrationConfirmations) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SignedClientRegistrationConfirmations) GetClientTransmissionConfirmation() *SignedRegistrationConfirmation {
+	if x != nil {
+		return x.ClientTransmissionConfirmation
+	}
+	return nil
+}
+
+func (x *SignedClientRegistrationConfirmations) GetClientReceptionConfirmation() *SignedRegistrationConfirmation {
+	if x != nil {
+		return x.ClientReceptionConfirmation
+	}
+	return nil
+}
+
+func (x *SignedClientRegistrationConfirmations) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// ClientVersion contains a version string for the client
+type ClientVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version string `protobuf:"bytes,1,opt,name=Version,proto3" json:"Version,omitempty"`
+}
+
+func (x *ClientVersion) Reset() {
+	*x = ClientVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientVersion) ProtoMessage() {}
+
+func (x *ClientVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientVersion.ProtoReflect.Descriptor instead.
+func (*ClientVersion) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ClientVersion) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+// Unified Server->Permissioning polling message
+type PermissioningPoll struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Full           *NDFHash       `protobuf:"bytes,1,opt,name=Full,proto3" json:"Full,omitempty"`
+	Partial        *NDFHash       `protobuf:"bytes,2,opt,name=Partial,proto3" json:"Partial,omitempty"`
+	LastUpdate     uint64         `protobuf:"varint,3,opt,name=LastUpdate,proto3" json:"LastUpdate,omitempty"`
+	Activity       uint32         `protobuf:"varint,4,opt,name=Activity,proto3" json:"Activity,omitempty"`
+	Error          *RoundError    `protobuf:"bytes,5,opt,name=Error,proto3" json:"Error,omitempty"` // Only Populated in the event of errors
+	GatewayAddress string         `protobuf:"bytes,6,opt,name=gatewayAddress,proto3" json:"gatewayAddress,omitempty"`
+	GatewayVersion string         `protobuf:"bytes,7,opt,name=gatewayVersion,proto3" json:"gatewayVersion,omitempty"`
+	ServerAddress  string         `protobuf:"bytes,8,opt,name=serverAddress,proto3" json:"serverAddress,omitempty"`
+	ServerVersion  string         `protobuf:"bytes,9,opt,name=serverVersion,proto3" json:"serverVersion,omitempty"`
 	ClientErrors   []*ClientError `protobuf:"bytes,10,rep,name=ClientErrors,proto3" json:"ClientErrors,omitempty"` // Client error information
 	Ed25519        []byte         `protobuf:"bytes,11,opt,name=Ed25519,proto3" json:"Ed25519,omitempty"`
 }
@@ -3277,7 +3911,7 @@ type PermissioningPoll struct {
 func (x *PermissioningPoll) Reset() {
 	*x = PermissioningPoll{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[49]
+		mi := &file_mixmessages_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3290,7 +3924,7 @@ func (x *PermissioningPoll) String() string {
 func (*PermissioningPoll) ProtoMessage() {}
 
 func (x *PermissioningPoll) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[49]
+	mi := &file_mixmessages_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3303,7 +3937,7 @@ func (x *PermissioningPoll) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PermissioningPoll.ProtoReflect.Descriptor instead.
 func (*PermissioningPoll) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{49}
+	return file_mixmessages_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *PermissioningPoll) GetFull() *NDFHash {
@@ -3398,7 +4032,7 @@ type ClientError struct {
 func (x *ClientError) Reset() {
 	*x = ClientError{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[50]
+		mi := &file_mixmessages_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3411,7 +4045,7 @@ func (x *ClientError) String() string {
 func (*ClientError) ProtoMessage() {}
 
 func (x *ClientError) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[50]
+	mi := &file_mixmessages_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3424,7 +4058,7 @@ func (x *ClientError) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClientError.ProtoReflect.Descriptor instead.
 func (*ClientError) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{50}
+	return file_mixmessages_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *ClientError) GetClientId() []byte {
@@ -3466,7 +4100,7 @@ type PermissionPollResponse struct {
 func (x *PermissionPollResponse) Reset() {
 	*x = PermissionPollResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[51]
+		mi := &file_mixmessages_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3479,7 +4113,7 @@ func (x *PermissionPollResponse) String() string {
 func (*PermissionPollResponse) ProtoMessage() {}
 
 func (x *PermissionPollResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[51]
+	mi := &file_mixmessages_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3492,7 +4126,7 @@ func (x *PermissionPollResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PermissionPollResponse.ProtoReflect.Descriptor instead.
 func (*PermissionPollResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{51}
+	return file_mixmessages_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *PermissionPollResponse) GetFullNDF() *NDF {
@@ -3562,7 +4196,7 @@ type RegisterTokenRequest struct {
 func (x *RegisterTokenRequest) Reset() {
 	*x = RegisterTokenRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[52]
+		mi := &file_mixmessages_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3575,7 +4209,7 @@ func (x *RegisterTokenRequest) String() string {
 func (*RegisterTokenRequest) ProtoMessage() {}
 
 func (x *RegisterTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[52]
+	mi := &file_mixmessages_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3588,7 +4222,7 @@ func (x *RegisterTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterTokenRequest.ProtoReflect.Descriptor instead.
 func (*RegisterTokenRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{52}
+	return file_mixmessages_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *RegisterTokenRequest) GetApp() string {
@@ -3662,7 +4296,7 @@ type UnregisterTokenRequest struct {
 func (x *UnregisterTokenRequest) Reset() {
 	*x = UnregisterTokenRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[53]
+		mi := &file_mixmessages_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3675,7 +4309,7 @@ func (x *UnregisterTokenRequest) String() string {
 func (*UnregisterTokenRequest) ProtoMessage() {}
 
 func (x *UnregisterTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[53]
+	mi := &file_mixmessages_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3688,7 +4322,7 @@ func (x *UnregisterTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnregisterTokenRequest.ProtoReflect.Descriptor instead.
 func (*UnregisterTokenRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{53}
+	return file_mixmessages_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *UnregisterTokenRequest) GetApp() string {
@@ -3737,7 +4371,7 @@ type UnregisterTrackedIdRequest struct {
 func (x *UnregisterTrackedIdRequest) Reset() {
 	*x = UnregisterTrackedIdRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[54]
+		mi := &file_mixmessages_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3750,7 +4384,7 @@ func (x *UnregisterTrackedIdRequest) String() string {
 func (*UnregisterTrackedIdRequest) ProtoMessage() {}
 
 func (x *UnregisterTrackedIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[54]
+	mi := &file_mixmessages_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3763,7 +4397,7 @@ func (x *UnregisterTrackedIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnregisterTrackedIdRequest.ProtoReflect.Descriptor instead.
 func (*UnregisterTrackedIdRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{54}
+	return file_mixmessages_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *UnregisterTrackedIdRequest) GetRequest() *TrackedIntermediaryIdRequest {
@@ -3786,7 +4420,7 @@ type RegisterTrackedIdRequest struct {
 func (x *RegisterTrackedIdRequest) Reset() {
 	*x = RegisterTrackedIdRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[55]
+		mi := &file_mixmessages_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3799,7 +4433,7 @@ func (x *RegisterTrackedIdRequest) String() string {
 func (*RegisterTrackedIdRequest) ProtoMessage() {}
 
 func (x *RegisterTrackedIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[55]
+	mi := &file_mixmessages_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3812,7 +4446,7 @@ func (x *RegisterTrackedIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterTrackedIdRequest.ProtoReflect.Descriptor instead.
 func (*RegisterTrackedIdRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{55}
+	return file_mixmessages_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *RegisterTrackedIdRequest) GetRequest() *TrackedIntermediaryIdRequest {
@@ -3850,7 +4484,7 @@ type TrackedIntermediaryIdRequest struct {
 func (x *TrackedIntermediaryIdRequest) Reset() {
 	*x = TrackedIntermediaryIdRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[56]
+		mi := &file_mixmessages_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3863,7 +4497,7 @@ func (x *TrackedIntermediaryIdRequest) String() string {
 func (*TrackedIntermediaryIdRequest) ProtoMessage() {}
 
 func (x *TrackedIntermediaryIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[56]
+	mi := &file_mixmessages_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3876,7 +4510,7 @@ func (x *TrackedIntermediaryIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TrackedIntermediaryIdRequest.ProtoReflect.Descriptor instead.
 func (*TrackedIntermediaryIdRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{56}
+	return file_mixmessages_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *TrackedIntermediaryIdRequest) GetTrackedIntermediaryID() [][]byte {
@@ -3907,39 +4541,33 @@ func (x *TrackedIntermediaryIdRequest) GetSignature() []byte {
 	return nil
 }
 
-// Registration token to be associated with the client
-type NotificationRegisterRequest struct {
+type ListRegistrationsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token                 string `protobuf:"bytes,1,opt,name=Token,proto3" json:"Token,omitempty"`
-	IntermediaryId        []byte `protobuf:"bytes,2,opt,name=IntermediaryId,proto3" json:"IntermediaryId,omitempty"`
-	TransmissionRsa       []byte `protobuf:"bytes,3,opt,name=TransmissionRsa,proto3" json:"TransmissionRsa,omitempty"`
-	TransmissionSalt      []byte `protobuf:"bytes,4,opt,name=TransmissionSalt,proto3" json:"TransmissionSalt,omitempty"`
-	TransmissionRsaSig    []byte `protobuf:"bytes,5,opt,name=TransmissionRsaSig,proto3" json:"TransmissionRsaSig,omitempty"`
-	IIDTransmissionRsaSig []byte `protobuf:"bytes,6,opt,name=IIDTransmissionRsaSig,proto3" json:"IIDTransmissionRsaSig,omitempty"`
-	// Timestamp in which user registered with the network (ie permissioning)
-	RegistrationTimestamp int64 `protobuf:"varint,7,opt,name=RegistrationTimestamp,proto3" json:"RegistrationTimestamp,omitempty"`
+	TransmissionRsaPem []byte `protobuf:"bytes,1,opt,name=TransmissionRsaPem,proto3" json:"TransmissionRsaPem,omitempty"`
+	RequestTimestamp   int64  `protobuf:"varint,2,opt,name=RequestTimestamp,proto3" json:"RequestTimestamp,omitempty"`
+	Signature          []byte `protobuf:"bytes,3,opt,name=Signature,proto3" json:"Signature,omitempty"`
 }
 
-func (x *NotificationRegisterRequest) Reset() {
-	*x = NotificationRegisterRequest{}
+func (x *ListRegistrationsRequest) Reset() {
+	*x = ListRegistrationsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[57]
+		mi := &file_mixmessages_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NotificationRegisterRequest) String() string {
+func (x *ListRegistrationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NotificationRegisterRequest) ProtoMessage() {}
+func (*ListRegistrationsRequest) ProtoMessage() {}
 
-func (x *NotificationRegisterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[57]
+func (x *ListRegistrationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3950,86 +4578,59 @@ func (x *NotificationRegisterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NotificationRegisterRequest.ProtoReflect.Descriptor instead.
-func (*NotificationRegisterRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{57}
-}
-
-func (x *NotificationRegisterRequest) GetToken() string {
-	if x != nil {
-		return x.Token
-	}
-	return ""
-}
-
-func (x *NotificationRegisterRequest) GetIntermediaryId() []byte {
-	if x != nil {
-		return x.IntermediaryId
-	}
-	return nil
-}
-
-func (x *NotificationRegisterRequest) GetTransmissionRsa() []byte {
-	if x != nil {
-		return x.TransmissionRsa
-	}
-	return nil
+// Deprecated: Use ListRegistrationsRequest.ProtoReflect.Descriptor instead.
+func (*ListRegistrationsRequest) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *NotificationRegisterRequest) GetTransmissionSalt() []byte {
+func (x *ListRegistrationsRequest) GetTransmissionRsaPem() []byte {
 	if x != nil {
-		return x.TransmissionSalt
+		return x.TransmissionRsaPem
 	}
 	return nil
 }
 
-func (x *NotificationRegisterRequest) GetTransmissionRsaSig() []byte {
+func (x *ListRegistrationsRequest) GetRequestTimestamp() int64 {
 	if x != nil {
-		return x.TransmissionRsaSig
+		return x.RequestTimestamp
 	}
-	return nil
+	return 0
 }
 
-func (x *NotificationRegisterRequest) GetIIDTransmissionRsaSig() []byte {
+func (x *ListRegistrationsRequest) GetSignature() []byte {
 	if x != nil {
-		return x.IIDTransmissionRsaSig
+		return x.Signature
 	}
 	return nil
 }
 
-func (x *NotificationRegisterRequest) GetRegistrationTimestamp() int64 {
-	if x != nil {
-		return x.RegistrationTimestamp
-	}
-	return 0
-}
-
-type NotificationUnregisterRequest struct {
+type RefreshRegistrationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IntermediaryId        []byte `protobuf:"bytes,1,opt,name=IntermediaryId,proto3" json:"IntermediaryId,omitempty"`
-	IIDTransmissionRsaSig []byte `protobuf:"bytes,2,opt,name=IIDTransmissionRsaSig,proto3" json:"IIDTransmissionRsaSig,omitempty"`
+	TransmissionRsaPem []byte `protobuf:"bytes,1,opt,name=TransmissionRsaPem,proto3" json:"TransmissionRsaPem,omitempty"`
+	RequestTimestamp   int64  `protobuf:"varint,2,opt,name=RequestTimestamp,proto3" json:"RequestTimestamp,omitempty"`
+	Signature          []byte `protobuf:"bytes,3,opt,name=Signature,proto3" json:"Signature,omitempty"`
 }
 
-func (x *NotificationUnregisterRequest) Reset() {
-	*x = NotificationUnregisterRequest{}
+func (x *RefreshRegistrationRequest) Reset() {
+	*x = RefreshRegistrationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[58]
+		mi := &file_mixmessages_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NotificationUnregisterRequest) String() string {
+func (x *RefreshRegistrationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NotificationUnregisterRequest) ProtoMessage() {}
+func (*RefreshRegistrationRequest) ProtoMessage() {}
 
-func (x *NotificationUnregisterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[58]
+func (x *RefreshRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4040,51 +4641,61 @@ func (x *NotificationUnregisterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NotificationUnregisterRequest.ProtoReflect.Descriptor instead.
-func (*NotificationUnregisterRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use RefreshRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*RefreshRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *NotificationUnregisterRequest) GetIntermediaryId() []byte {
+func (x *RefreshRegistrationRequest) GetTransmissionRsaPem() []byte {
 	if x != nil {
-		return x.IntermediaryId
+		return x.TransmissionRsaPem
 	}
 	return nil
 }
 
-func (x *NotificationUnregisterRequest) GetIIDTransmissionRsaSig() []byte {
+func (x *RefreshRegistrationRequest) GetRequestTimestamp() int64 {
 	if x != nil {
-		return x.IIDTransmissionRsaSig
+		return x.RequestTimestamp
+	}
+	return 0
+}
+
+func (x *RefreshRegistrationRequest) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
 	}
 	return nil
 }
 
-// The list of user IDS for notification polling
-type UserIdList struct {
+// A token registered to a user. Registrations expire at Expiry, in Unix
+// nanoseconds, unless refreshed.
+type TokenRegistration struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IDs [][]byte `protobuf:"bytes,1,rep,name=IDs,proto3" json:"IDs,omitempty"`
+	App    string `protobuf:"bytes,1,opt,name=App,proto3" json:"App,omitempty"`
+	Token  string `protobuf:"bytes,2,opt,name=Token,proto3" json:"Token,omitempty"`
+	Expiry int64  `protobuf:"varint,3,opt,name=Expiry,proto3" json:"Expiry,omitempty"`
 }
 
-func (x *UserIdList) Reset() {
-	*x = UserIdList{}
+func (x *TokenRegistration) Reset() {
+	*x = TokenRegistration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[59]
+		mi := &file_mixmessages_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserIdList) String() string {
+func (x *TokenRegistration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserIdList) ProtoMessage() {}
+func (*TokenRegistration) ProtoMessage() {}
 
-func (x *UserIdList) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[59]
+func (x *TokenRegistration) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4095,45 +4706,60 @@ func (x *UserIdList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserIdList.ProtoReflect.Descriptor instead.
-func (*UserIdList) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use TokenRegistration.ProtoReflect.Descriptor instead.
+func (*TokenRegistration) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *UserIdList) GetIDs() [][]byte {
+func (x *TokenRegistration) GetApp() string {
 	if x != nil {
-		return x.IDs
+		return x.App
 	}
-	return nil
+	return ""
 }
 
-// Notification data transmitted to the notification bot from gateway
-type NotificationBatch struct {
+func (x *TokenRegistration) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *TokenRegistration) GetExpiry() int64 {
+	if x != nil {
+		return x.Expiry
+	}
+	return 0
+}
+
+// A tracked intermediary ID registered to a user. Registrations expire at
+// Expiry, in Unix nanoseconds, unless refreshed.
+type TrackedIdRegistration struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RoundID       uint64              `protobuf:"varint,1,opt,name=roundID,proto3" json:"roundID,omitempty"`
-	Notifications []*NotificationData `protobuf:"bytes,2,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	TrackedIntermediaryID []byte `protobuf:"bytes,1,opt,name=TrackedIntermediaryID,proto3" json:"TrackedIntermediaryID,omitempty"`
+	Expiry                int64  `protobuf:"varint,2,opt,name=Expiry,proto3" json:"Expiry,omitempty"`
 }
 
-func (x *NotificationBatch) Reset() {
-	*x = NotificationBatch{}
+func (x *TrackedIdRegistration) Reset() {
+	*x = TrackedIdRegistration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[60]
+		mi := &file_mixmessages_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NotificationBatch) String() string {
+func (x *TrackedIdRegistration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NotificationBatch) ProtoMessage() {}
+func (*TrackedIdRegistration) ProtoMessage() {}
 
-func (x *NotificationBatch) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[60]
+func (x *TrackedIdRegistration) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4144,53 +4770,52 @@ func (x *NotificationBatch) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NotificationBatch.ProtoReflect.Descriptor instead.
-func (*NotificationBatch) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use TrackedIdRegistration.ProtoReflect.Descriptor instead.
+func (*TrackedIdRegistration) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *NotificationBatch) GetRoundID() uint64 {
+func (x *TrackedIdRegistration) GetTrackedIntermediaryID() []byte {
 	if x != nil {
-		return x.RoundID
+		return x.TrackedIntermediaryID
 	}
-	return 0
+	return nil
 }
 
-func (x *NotificationBatch) GetNotifications() []*NotificationData {
+func (x *TrackedIdRegistration) GetExpiry() int64 {
 	if x != nil {
-		return x.Notifications
+		return x.Expiry
 	}
-	return nil
+	return 0
 }
 
-// Data for a single notification
-type NotificationData struct {
+// The tokens and tracked IDs registered to a user
+type RegistrationList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EphemeralID int64  `protobuf:"varint,1,opt,name=ephemeralID,proto3" json:"ephemeralID,omitempty"`
-	IdentityFP  []byte `protobuf:"bytes,2,opt,name=identityFP,proto3" json:"identityFP,omitempty"`
-	MessageHash []byte `protobuf:"bytes,3,opt,name=messageHash,proto3" json:"messageHash,omitempty"`
+	Tokens     []*TokenRegistration     `protobuf:"bytes,1,rep,name=Tokens,proto3" json:"Tokens,omitempty"`
+	TrackedIDs []*TrackedIdRegistration `protobuf:"bytes,2,rep,name=TrackedIDs,proto3" json:"TrackedIDs,omitempty"`
 }
 
-func (x *NotificationData) Reset() {
-	*x = NotificationData{}
+func (x *RegistrationList) Reset() {
+	*x = RegistrationList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[61]
+		mi := &file_mixmessages_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NotificationData) String() string {
+func (x *RegistrationList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NotificationData) ProtoMessage() {}
+func (*RegistrationList) ProtoMessage() {}
 
-func (x *NotificationData) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[61]
+func (x *RegistrationList) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4201,61 +4826,58 @@ func (x *NotificationData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NotificationData.ProtoReflect.Descriptor instead.
-func (*NotificationData) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{61}
-}
-
-func (x *NotificationData) GetEphemeralID() int64 {
-	if x != nil {
-		return x.EphemeralID
-	}
-	return 0
+// Deprecated: Use RegistrationList.ProtoReflect.Descriptor instead.
+func (*RegistrationList) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *NotificationData) GetIdentityFP() []byte {
+func (x *RegistrationList) GetTokens() []*TokenRegistration {
 	if x != nil {
-		return x.IdentityFP
+		return x.Tokens
 	}
 	return nil
 }
 
-func (x *NotificationData) GetMessageHash() []byte {
+func (x *RegistrationList) GetTrackedIDs() []*TrackedIdRegistration {
 	if x != nil {
-		return x.MessageHash
+		return x.TrackedIDs
 	}
 	return nil
 }
 
-// Holds information for a user requesting a channel lease from UD
-type ChannelLeaseRequest struct {
+// Registration token to be associated with the client
+type NotificationRegisterRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID                 []byte `protobuf:"bytes,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
-	UserEd25519PubKey      []byte `protobuf:"bytes,2,opt,name=UserEd25519PubKey,proto3" json:"UserEd25519PubKey,omitempty"`
-	Timestamp              int64  `protobuf:"varint,3,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
-	UserPubKeyRSASignature []byte `protobuf:"bytes,4,opt,name=UserPubKeyRSASignature,proto3" json:"UserPubKeyRSASignature,omitempty"`
+	Token                 string `protobuf:"bytes,1,opt,name=Token,proto3" json:"Token,omitempty"`
+	IntermediaryId        []byte `protobuf:"bytes,2,opt,name=IntermediaryId,proto3" json:"IntermediaryId,omitempty"`
+	TransmissionRsa       []byte `protobuf:"bytes,3,opt,name=TransmissionRsa,proto3" json:"TransmissionRsa,omitempty"`
+	TransmissionSalt      []byte `protobuf:"bytes,4,opt,name=TransmissionSalt,proto3" json:"TransmissionSalt,omitempty"`
+	TransmissionRsaSig    []byte `protobuf:"bytes,5,opt,name=TransmissionRsaSig,proto3" json:"TransmissionRsaSig,omitempty"`
+	IIDTransmissionRsaSig []byte `protobuf:"bytes,6,opt,name=IIDTransmissionRsaSig,proto3" json:"IIDTransmissionRsaSig,omitempty"`
+	// Timestamp in which user registered with the network (ie permissioning)
+	RegistrationTimestamp int64 `protobuf:"varint,7,opt,name=RegistrationTimestamp,proto3" json:"RegistrationTimestamp,omitempty"`
 }
 
-func (x *ChannelLeaseRequest) Reset() {
-	*x = ChannelLeaseRequest{}
+func (x *NotificationRegisterRequest) Reset() {
+	*x = NotificationRegisterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[62]
+		mi := &file_mixmessages_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChannelLeaseRequest) String() string {
+func (x *NotificationRegisterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelLeaseRequest) ProtoMessage() {}
+func (*NotificationRegisterRequest) ProtoMessage() {}
 
-func (x *ChannelLeaseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[62]
+func (x *NotificationRegisterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4266,67 +4888,86 @@ func (x *ChannelLeaseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelLeaseRequest.ProtoReflect.Descriptor instead.
-func (*ChannelLeaseRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use NotificationRegisterRequest.ProtoReflect.Descriptor instead.
+func (*NotificationRegisterRequest) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *ChannelLeaseRequest) GetUserID() []byte {
+func (x *NotificationRegisterRequest) GetToken() string {
 	if x != nil {
-		return x.UserID
+		return x.Token
 	}
-	return nil
+	return ""
 }
 
-func (x *ChannelLeaseRequest) GetUserEd25519PubKey() []byte {
+func (x *NotificationRegisterRequest) GetIntermediaryId() []byte {
 	if x != nil {
-		return x.UserEd25519PubKey
+		return x.IntermediaryId
 	}
 	return nil
 }
 
-func (x *ChannelLeaseRequest) GetTimestamp() int64 {
+func (x *NotificationRegisterRequest) GetTransmissionRsa() []byte {
 	if x != nil {
-		return x.Timestamp
+		return x.TransmissionRsa
 	}
-	return 0
+	return nil
 }
 
-func (x *ChannelLeaseRequest) GetUserPubKeyRSASignature() []byte {
+func (x *NotificationRegisterRequest) GetTransmissionSalt() []byte {
 	if x != nil {
-		return x.UserPubKeyRSASignature
+		return x.TransmissionSalt
 	}
 	return nil
 }
 
-// Contains UD response to a ChannelLeaseRequest, including lease & signature
-type ChannelLeaseResponse struct {
+func (x *NotificationRegisterRequest) GetTransmissionRsaSig() []byte {
+	if x != nil {
+		return x.TransmissionRsaSig
+	}
+	return nil
+}
+
+func (x *NotificationRegisterRequest) GetIIDTransmissionRsaSig() []byte {
+	if x != nil {
+		return x.IIDTransmissionRsaSig
+	}
+	return nil
+}
+
+func (x *NotificationRegisterRequest) GetRegistrationTimestamp() int64 {
+	if x != nil {
+		return x.RegistrationTimestamp
+	}
+	return 0
+}
+
+type NotificationUnregisterRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Lease                   int64  `protobuf:"varint,1,opt,name=Lease,proto3" json:"Lease,omitempty"`
-	UserEd25519PubKey       []byte `protobuf:"bytes,2,opt,name=UserEd25519PubKey,proto3" json:"UserEd25519PubKey,omitempty"`
-	UDLeaseEd25519Signature []byte `protobuf:"bytes,3,opt,name=UDLeaseEd25519Signature,proto3" json:"UDLeaseEd25519Signature,omitempty"`
+	IntermediaryId        []byte `protobuf:"bytes,1,opt,name=IntermediaryId,proto3" json:"IntermediaryId,omitempty"`
+	IIDTransmissionRsaSig []byte `protobuf:"bytes,2,opt,name=IIDTransmissionRsaSig,proto3" json:"IIDTransmissionRsaSig,omitempty"`
 }
 
-func (x *ChannelLeaseResponse) Reset() {
-	*x = ChannelLeaseResponse{}
+func (x *NotificationUnregisterRequest) Reset() {
+	*x = NotificationUnregisterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[63]
+		mi := &file_mixmessages_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChannelLeaseResponse) String() string {
+func (x *NotificationUnregisterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelLeaseResponse) ProtoMessage() {}
+func (*NotificationUnregisterRequest) ProtoMessage() {}
 
-func (x *ChannelLeaseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[63]
+func (x *NotificationUnregisterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4337,59 +4978,51 @@ func (x *ChannelLeaseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelLeaseResponse.ProtoReflect.Descriptor instead.
-func (*ChannelLeaseResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{63}
-}
-
-func (x *ChannelLeaseResponse) GetLease() int64 {
-	if x != nil {
-		return x.Lease
-	}
-	return 0
+// Deprecated: Use NotificationUnregisterRequest.ProtoReflect.Descriptor instead.
+func (*NotificationUnregisterRequest) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *ChannelLeaseResponse) GetUserEd25519PubKey() []byte {
+func (x *NotificationUnregisterRequest) GetIntermediaryId() []byte {
 	if x != nil {
-		return x.UserEd25519PubKey
+		return x.IntermediaryId
 	}
 	return nil
 }
 
-func (x *ChannelLeaseResponse) GetUDLeaseEd25519Signature() []byte {
+func (x *NotificationUnregisterRequest) GetIIDTransmissionRsaSig() []byte {
 	if x != nil {
-		return x.UDLeaseEd25519Signature
+		return x.IIDTransmissionRsaSig
 	}
 	return nil
 }
 
-// UsernameValidationRequest is the message a user sends to UD to
-// for validation that they own their username
-type UsernameValidationRequest struct {
+// The list of user IDS for notification polling
+type UserIdList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId []byte `protobuf:"bytes,3,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	IDs [][]byte `protobuf:"bytes,1,rep,name=IDs,proto3" json:"IDs,omitempty"`
 }
 
-func (x *UsernameValidationRequest) Reset() {
-	*x = UsernameValidationRequest{}
+func (x *UserIdList) Reset() {
+	*x = UserIdList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[64]
+		mi := &file_mixmessages_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UsernameValidationRequest) String() string {
+func (x *UserIdList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UsernameValidationRequest) ProtoMessage() {}
+func (*UserIdList) ProtoMessage() {}
 
-func (x *UsernameValidationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[64]
+func (x *UserIdList) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4400,47 +5033,45 @@ func (x *UsernameValidationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UsernameValidationRequest.ProtoReflect.Descriptor instead.
-func (*UsernameValidationRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use UserIdList.ProtoReflect.Descriptor instead.
+func (*UserIdList) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *UsernameValidationRequest) GetUserId() []byte {
+func (x *UserIdList) GetIDs() [][]byte {
 	if x != nil {
-		return x.UserId
+		return x.IDs
 	}
 	return nil
 }
 
-// UsernameValidation is the response to a UsernameValidationRequest.
-// This contains a signature from a UD server on the data within the request message.
-type UsernameValidation struct {
+// Notification data transmitted to the notification bot from gateway
+type NotificationBatch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Signature             []byte `protobuf:"bytes,1,opt,name=Signature,proto3" json:"Signature,omitempty"`
-	Username              string `protobuf:"bytes,2,opt,name=Username,proto3" json:"Username,omitempty"`
-	ReceptionPublicKeyPem []byte `protobuf:"bytes,3,opt,name=ReceptionPublicKeyPem,proto3" json:"ReceptionPublicKeyPem,omitempty"`
+	RoundID       uint64              `protobuf:"varint,1,opt,name=roundID,proto3" json:"roundID,omitempty"`
+	Notifications []*NotificationData `protobuf:"bytes,2,rep,name=notifications,proto3" json:"notifications,omitempty"`
 }
 
-func (x *UsernameValidation) Reset() {
-	*x = UsernameValidation{}
+func (x *NotificationBatch) Reset() {
+	*x = NotificationBatch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[65]
+		mi := &file_mixmessages_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UsernameValidation) String() string {
+func (x *NotificationBatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UsernameValidation) ProtoMessage() {}
+func (*NotificationBatch) ProtoMessage() {}
 
-func (x *UsernameValidation) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[65]
+func (x *NotificationBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4451,64 +5082,53 @@ func (x *UsernameValidation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UsernameValidation.ProtoReflect.Descriptor instead.
-func (*UsernameValidation) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{65}
-}
-
-func (x *UsernameValidation) GetSignature() []byte {
-	if x != nil {
-		return x.Signature
-	}
-	return nil
+// Deprecated: Use NotificationBatch.ProtoReflect.Descriptor instead.
+func (*NotificationBatch) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *UsernameValidation) GetUsername() string {
+func (x *NotificationBatch) GetRoundID() uint64 {
 	if x != nil {
-		return x.Username
+		return x.RoundID
 	}
-	return ""
+	return 0
 }
 
-func (x *UsernameValidation) GetReceptionPublicKeyPem() []byte {
+func (x *NotificationBatch) GetNotifications() []*NotificationData {
 	if x != nil {
-		return x.ReceptionPublicKeyPem
+		return x.Notifications
 	}
 	return nil
 }
 
-// Holds information for a UDB user registration request
-type UDBUserRegistration struct {
+// Data for a single notification
+type NotificationData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PermissioningSignature []byte               `protobuf:"bytes,1,opt,name=PermissioningSignature,proto3" json:"PermissioningSignature,omitempty"`
-	RSAPublicPem           string               `protobuf:"bytes,2,opt,name=RSAPublicPem,proto3" json:"RSAPublicPem,omitempty"`
-	Timestamp              int64                `protobuf:"varint,3,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
-	IdentityRegistration   *Identity            `protobuf:"bytes,4,opt,name=IdentityRegistration,proto3" json:"IdentityRegistration,omitempty"`
-	IdentitySignature      []byte               `protobuf:"bytes,5,opt,name=IdentitySignature,proto3" json:"IdentitySignature,omitempty"` // (RSAPublicSign(Data.Digest()))
-	Frs                    *FactRegisterRequest `protobuf:"bytes,6,opt,name=frs,proto3" json:"frs,omitempty"`
-	UID                    []byte               `protobuf:"bytes,7,opt,name=UID,proto3" json:"UID,omitempty"`
+	EphemeralID int64  `protobuf:"varint,1,opt,name=ephemeralID,proto3" json:"ephemeralID,omitempty"`
+	IdentityFP  []byte `protobuf:"bytes,2,opt,name=identityFP,proto3" json:"identityFP,omitempty"`
+	MessageHash []byte `protobuf:"bytes,3,opt,name=messageHash,proto3" json:"messageHash,omitempty"`
 }
 
-func (x *UDBUserRegistration) Reset() {
-	*x = UDBUserRegistration{}
+func (x *NotificationData) Reset() {
+	*x = NotificationData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[66]
+		mi := &file_mixmessages_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UDBUserRegistration) String() string {
+func (x *NotificationData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UDBUserRegistration) ProtoMessage() {}
+func (*NotificationData) ProtoMessage() {}
 
-func (x *UDBUserRegistration) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[66]
+func (x *NotificationData) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4519,88 +5139,61 @@ func (x *UDBUserRegistration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UDBUserRegistration.ProtoReflect.Descriptor instead.
-func (*UDBUserRegistration) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{66}
-}
-
-func (x *UDBUserRegistration) GetPermissioningSignature() []byte {
-	if x != nil {
-		return x.PermissioningSignature
-	}
-	return nil
-}
-
-func (x *UDBUserRegistration) GetRSAPublicPem() string {
-	if x != nil {
-		return x.RSAPublicPem
-	}
-	return ""
+// Deprecated: Use NotificationData.ProtoReflect.Descriptor instead.
+func (*NotificationData) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *UDBUserRegistration) GetTimestamp() int64 {
+func (x *NotificationData) GetEphemeralID() int64 {
 	if x != nil {
-		return x.Timestamp
+		return x.EphemeralID
 	}
 	return 0
 }
 
-func (x *UDBUserRegistration) GetIdentityRegistration() *Identity {
-	if x != nil {
-		return x.IdentityRegistration
-	}
-	return nil
-}
-
-func (x *UDBUserRegistration) GetIdentitySignature() []byte {
-	if x != nil {
-		return x.IdentitySignature
-	}
-	return nil
-}
-
-func (x *UDBUserRegistration) GetFrs() *FactRegisterRequest {
+func (x *NotificationData) GetIdentityFP() []byte {
 	if x != nil {
-		return x.Frs
+		return x.IdentityFP
 	}
 	return nil
 }
 
-func (x *UDBUserRegistration) GetUID() []byte {
+func (x *NotificationData) GetMessageHash() []byte {
 	if x != nil {
-		return x.UID
+		return x.MessageHash
 	}
 	return nil
 }
 
-// Identity describes a user and their cryptographic info
-type Identity struct {
+// Holds information for a user requesting a channel lease from UD
+type ChannelLeaseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username string `protobuf:"bytes,1,opt,name=Username,proto3" json:"Username,omitempty"`
-	DhPubKey []byte `protobuf:"bytes,2,opt,name=dhPubKey,proto3" json:"dhPubKey,omitempty"`
-	Salt     []byte `protobuf:"bytes,3,opt,name=Salt,proto3" json:"Salt,omitempty"`
+	UserID                 []byte `protobuf:"bytes,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	UserEd25519PubKey      []byte `protobuf:"bytes,2,opt,name=UserEd25519PubKey,proto3" json:"UserEd25519PubKey,omitempty"`
+	Timestamp              int64  `protobuf:"varint,3,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	UserPubKeyRSASignature []byte `protobuf:"bytes,4,opt,name=UserPubKeyRSASignature,proto3" json:"UserPubKeyRSASignature,omitempty"`
 }
 
-func (x *Identity) Reset() {
-	*x = Identity{}
+func (x *ChannelLeaseRequest) Reset() {
+	*x = ChannelLeaseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[67]
+		mi := &file_mixmessages_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Identity) String() string {
+func (x *ChannelLeaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Identity) ProtoMessage() {}
+func (*ChannelLeaseRequest) ProtoMessage() {}
 
-func (x *Identity) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[67]
+func (x *ChannelLeaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4611,60 +5204,67 @@ func (x *Identity) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Identity.ProtoReflect.Descriptor instead.
-func (*Identity) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use ChannelLeaseRequest.ProtoReflect.Descriptor instead.
+func (*ChannelLeaseRequest) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *Identity) GetUsername() string {
+func (x *ChannelLeaseRequest) GetUserID() []byte {
 	if x != nil {
-		return x.Username
+		return x.UserID
 	}
-	return ""
+	return nil
 }
 
-func (x *Identity) GetDhPubKey() []byte {
+func (x *ChannelLeaseRequest) GetUserEd25519PubKey() []byte {
 	if x != nil {
-		return x.DhPubKey
+		return x.UserEd25519PubKey
 	}
 	return nil
 }
 
-func (x *Identity) GetSalt() []byte {
+func (x *ChannelLeaseRequest) GetTimestamp() int64 {
 	if x != nil {
-		return x.Salt
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ChannelLeaseRequest) GetUserPubKeyRSASignature() []byte {
+	if x != nil {
+		return x.UserPubKeyRSASignature
 	}
 	return nil
 }
 
-// Holds information for a Fact registration request
-type FactRegisterRequest struct {
+// Contains UD response to a ChannelLeaseRequest, including lease & signature
+type ChannelLeaseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UID     []byte `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
-	Fact    *Fact  `protobuf:"bytes,2,opt,name=Fact,proto3" json:"Fact,omitempty"`
-	FactSig []byte `protobuf:"bytes,3,opt,name=FactSig,proto3" json:"FactSig,omitempty"` // (RSAPublicSign(Fact.Digest()))
+	Lease                   int64  `protobuf:"varint,1,opt,name=Lease,proto3" json:"Lease,omitempty"`
+	UserEd25519PubKey       []byte `protobuf:"bytes,2,opt,name=UserEd25519PubKey,proto3" json:"UserEd25519PubKey,omitempty"`
+	UDLeaseEd25519Signature []byte `protobuf:"bytes,3,opt,name=UDLeaseEd25519Signature,proto3" json:"UDLeaseEd25519Signature,omitempty"`
 }
 
-func (x *FactRegisterRequest) Reset() {
-	*x = FactRegisterRequest{}
+func (x *ChannelLeaseResponse) Reset() {
+	*x = ChannelLeaseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[68]
+		mi := &file_mixmessages_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FactRegisterRequest) String() string {
+func (x *ChannelLeaseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FactRegisterRequest) ProtoMessage() {}
+func (*ChannelLeaseResponse) ProtoMessage() {}
 
-func (x *FactRegisterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[68]
+func (x *ChannelLeaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4675,59 +5275,59 @@ func (x *FactRegisterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FactRegisterRequest.ProtoReflect.Descriptor instead.
-func (*FactRegisterRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use ChannelLeaseResponse.ProtoReflect.Descriptor instead.
+func (*ChannelLeaseResponse) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *FactRegisterRequest) GetUID() []byte {
+func (x *ChannelLeaseResponse) GetLease() int64 {
 	if x != nil {
-		return x.UID
+		return x.Lease
 	}
-	return nil
+	return 0
 }
 
-func (x *FactRegisterRequest) GetFact() *Fact {
+func (x *ChannelLeaseResponse) GetUserEd25519PubKey() []byte {
 	if x != nil {
-		return x.Fact
+		return x.UserEd25519PubKey
 	}
 	return nil
 }
 
-func (x *FactRegisterRequest) GetFactSig() []byte {
+func (x *ChannelLeaseResponse) GetUDLeaseEd25519Signature() []byte {
 	if x != nil {
-		return x.FactSig
+		return x.UDLeaseEd25519Signature
 	}
 	return nil
 }
 
-// Fact describes a Fact, namely what it is and what type it is
-type Fact struct {
+// Holds a signed request to renew a user's current channel lease
+type ChannelLeaseRenewal struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fact     string `protobuf:"bytes,1,opt,name=Fact,proto3" json:"Fact,omitempty"`
-	FactType uint32 `protobuf:"varint,2,opt,name=FactType,proto3" json:"FactType,omitempty"`
+	Request *ChannelLeaseRequest  `protobuf:"bytes,1,opt,name=Request,proto3" json:"Request,omitempty"`
+	Lease   *ChannelLeaseResponse `protobuf:"bytes,2,opt,name=Lease,proto3" json:"Lease,omitempty"`
 }
 
-func (x *Fact) Reset() {
-	*x = Fact{}
+func (x *ChannelLeaseRenewal) Reset() {
+	*x = ChannelLeaseRenewal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[69]
+		mi := &file_mixmessages_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Fact) String() string {
+func (x *ChannelLeaseRenewal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Fact) ProtoMessage() {}
+func (*ChannelLeaseRenewal) ProtoMessage() {}
 
-func (x *Fact) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[69]
+func (x *ChannelLeaseRenewal) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4738,51 +5338,54 @@ func (x *Fact) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Fact.ProtoReflect.Descriptor instead.
-func (*Fact) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use ChannelLeaseRenewal.ProtoReflect.Descriptor instead.
+func (*ChannelLeaseRenewal) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *Fact) GetFact() string {
+func (x *ChannelLeaseRenewal) GetRequest() *ChannelLeaseRequest {
 	if x != nil {
-		return x.Fact
+		return x.Request
 	}
-	return ""
+	return nil
 }
 
-func (x *Fact) GetFactType() uint32 {
+func (x *ChannelLeaseRenewal) GetLease() *ChannelLeaseResponse {
 	if x != nil {
-		return x.FactType
+		return x.Lease
 	}
-	return 0
+	return nil
 }
 
-// FactRegisterResponse describes UDB's handling of a FactRegisterRequest request
-type FactRegisterResponse struct {
+// Holds information for a user revoking their channel lease
+type ChannelLeaseRevocation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ConfirmationID string `protobuf:"bytes,1,opt,name=ConfirmationID,proto3" json:"ConfirmationID,omitempty"`
+	UserID                 []byte `protobuf:"bytes,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	UserEd25519PubKey      []byte `protobuf:"bytes,2,opt,name=UserEd25519PubKey,proto3" json:"UserEd25519PubKey,omitempty"`
+	Timestamp              int64  `protobuf:"varint,3,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	UserPubKeyRSASignature []byte `protobuf:"bytes,4,opt,name=UserPubKeyRSASignature,proto3" json:"UserPubKeyRSASignature,omitempty"`
 }
 
-func (x *FactRegisterResponse) Reset() {
-	*x = FactRegisterResponse{}
+func (x *ChannelLeaseRevocation) Reset() {
+	*x = ChannelLeaseRevocation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[70]
+		mi := &file_mixmessages_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FactRegisterResponse) String() string {
+func (x *ChannelLeaseRevocation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FactRegisterResponse) ProtoMessage() {}
+func (*ChannelLeaseRevocation) ProtoMessage() {}
 
-func (x *FactRegisterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[70]
+func (x *ChannelLeaseRevocation) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4793,45 +5396,66 @@ func (x *FactRegisterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FactRegisterResponse.ProtoReflect.Descriptor instead.
-func (*FactRegisterResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use ChannelLeaseRevocation.ProtoReflect.Descriptor instead.
+func (*ChannelLeaseRevocation) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *FactRegisterResponse) GetConfirmationID() string {
+func (x *ChannelLeaseRevocation) GetUserID() []byte {
 	if x != nil {
-		return x.ConfirmationID
+		return x.UserID
 	}
-	return ""
+	return nil
 }
 
-// Holds information for a Fact confirmation request
-type FactConfirmRequest struct {
+func (x *ChannelLeaseRevocation) GetUserEd25519PubKey() []byte {
+	if x != nil {
+		return x.UserEd25519PubKey
+	}
+	return nil
+}
+
+func (x *ChannelLeaseRevocation) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ChannelLeaseRevocation) GetUserPubKeyRSASignature() []byte {
+	if x != nil {
+		return x.UserPubKeyRSASignature
+	}
+	return nil
+}
+
+// UsernameValidationRequest is the message a user sends to UD to
+// for validation that they own their username
+type UsernameValidationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ConfirmationID string `protobuf:"bytes,1,opt,name=ConfirmationID,proto3" json:"ConfirmationID,omitempty"`
-	Code           string `protobuf:"bytes,2,opt,name=Code,proto3" json:"Code,omitempty"`
+	UserId []byte `protobuf:"bytes,3,opt,name=UserId,proto3" json:"UserId,omitempty"`
 }
 
-func (x *FactConfirmRequest) Reset() {
-	*x = FactConfirmRequest{}
+func (x *UsernameValidationRequest) Reset() {
+	*x = UsernameValidationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[71]
+		mi := &file_mixmessages_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FactConfirmRequest) String() string {
+func (x *UsernameValidationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FactConfirmRequest) ProtoMessage() {}
+func (*UsernameValidationRequest) ProtoMessage() {}
 
-func (x *FactConfirmRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[71]
+func (x *UsernameValidationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4842,53 +5466,47 @@ func (x *FactConfirmRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FactConfirmRequest.ProtoReflect.Descriptor instead.
-func (*FactConfirmRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{71}
-}
-
-func (x *FactConfirmRequest) GetConfirmationID() string {
-	if x != nil {
-		return x.ConfirmationID
-	}
-	return ""
+// Deprecated: Use UsernameValidationRequest.ProtoReflect.Descriptor instead.
+func (*UsernameValidationRequest) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *FactConfirmRequest) GetCode() string {
+func (x *UsernameValidationRequest) GetUserId() []byte {
 	if x != nil {
-		return x.Code
+		return x.UserId
 	}
-	return ""
+	return nil
 }
 
-// Holds information for a Fact removal request
-type FactRemovalRequest struct {
+// UsernameValidation is the response to a UsernameValidationRequest.
+// This contains a signature from a UD server on the data within the request message.
+type UsernameValidation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UID         []byte `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
-	RemovalData *Fact  `protobuf:"bytes,2,opt,name=RemovalData,proto3" json:"RemovalData,omitempty"`
-	FactSig     []byte `protobuf:"bytes,3,opt,name=FactSig,proto3" json:"FactSig,omitempty"` // (RSAPublicSign(Fact.Digest()))
+	Signature             []byte `protobuf:"bytes,1,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	Username              string `protobuf:"bytes,2,opt,name=Username,proto3" json:"Username,omitempty"`
+	ReceptionPublicKeyPem []byte `protobuf:"bytes,3,opt,name=ReceptionPublicKeyPem,proto3" json:"ReceptionPublicKeyPem,omitempty"`
 }
 
-func (x *FactRemovalRequest) Reset() {
-	*x = FactRemovalRequest{}
+func (x *UsernameValidation) Reset() {
+	*x = UsernameValidation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[72]
+		mi := &file_mixmessages_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FactRemovalRequest) String() string {
+func (x *UsernameValidation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FactRemovalRequest) ProtoMessage() {}
+func (*UsernameValidation) ProtoMessage() {}
 
-func (x *FactRemovalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[72]
+func (x *UsernameValidation) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4899,58 +5517,64 @@ func (x *FactRemovalRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FactRemovalRequest.ProtoReflect.Descriptor instead.
-func (*FactRemovalRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use UsernameValidation.ProtoReflect.Descriptor instead.
+func (*UsernameValidation) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *FactRemovalRequest) GetUID() []byte {
+func (x *UsernameValidation) GetSignature() []byte {
 	if x != nil {
-		return x.UID
+		return x.Signature
 	}
 	return nil
 }
 
-func (x *FactRemovalRequest) GetRemovalData() *Fact {
+func (x *UsernameValidation) GetUsername() string {
 	if x != nil {
-		return x.RemovalData
+		return x.Username
 	}
-	return nil
+	return ""
 }
 
-func (x *FactRemovalRequest) GetFactSig() []byte {
+func (x *UsernameValidation) GetReceptionPublicKeyPem() []byte {
 	if x != nil {
-		return x.FactSig
+		return x.ReceptionPublicKeyPem
 	}
 	return nil
 }
 
-// For sending permission address Server -> Gateway
-type StrAddress struct {
+// Holds information for a UDB user registration request
+type UDBUserRegistration struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	PermissioningSignature []byte               `protobuf:"bytes,1,opt,name=PermissioningSignature,proto3" json:"PermissioningSignature,omitempty"`
+	RSAPublicPem           string               `protobuf:"bytes,2,opt,name=RSAPublicPem,proto3" json:"RSAPublicPem,omitempty"`
+	Timestamp              int64                `protobuf:"varint,3,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	IdentityRegistration   *Identity            `protobuf:"bytes,4,opt,name=IdentityRegistration,proto3" json:"IdentityRegistration,omitempty"`
+	IdentitySignature      []byte               `protobuf:"bytes,5,opt,name=IdentitySignature,proto3" json:"IdentitySignature,omitempty"` // (RSAPublicSign(Data.Digest()))
+	Frs                    *FactRegisterRequest `protobuf:"bytes,6,opt,name=frs,proto3" json:"frs,omitempty"`
+	UID                    []byte               `protobuf:"bytes,7,opt,name=UID,proto3" json:"UID,omitempty"`
 }
 
-func (x *StrAddress) Reset() {
-	*x = StrAddress{}
+func (x *UDBUserRegistration) Reset() {
+	*x = UDBUserRegistration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[73]
+		mi := &file_mixmessages_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StrAddress) String() string {
+func (x *UDBUserRegistration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StrAddress) ProtoMessage() {}
+func (*UDBUserRegistration) ProtoMessage() {}
 
-func (x *StrAddress) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[73]
+func (x *UDBUserRegistration) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4961,55 +5585,88 @@ func (x *StrAddress) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StrAddress.ProtoReflect.Descriptor instead.
-func (*StrAddress) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use UDBUserRegistration.ProtoReflect.Descriptor instead.
+func (*UDBUserRegistration) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *StrAddress) GetAddress() string {
+func (x *UDBUserRegistration) GetPermissioningSignature() []byte {
 	if x != nil {
-		return x.Address
+		return x.PermissioningSignature
+	}
+	return nil
+}
+
+func (x *UDBUserRegistration) GetRSAPublicPem() string {
+	if x != nil {
+		return x.RSAPublicPem
 	}
 	return ""
 }
 
-// Describes a round
-type RoundInfo struct {
+func (x *UDBUserRegistration) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *UDBUserRegistration) GetIdentityRegistration() *Identity {
+	if x != nil {
+		return x.IdentityRegistration
+	}
+	return nil
+}
+
+func (x *UDBUserRegistration) GetIdentitySignature() []byte {
+	if x != nil {
+		return x.IdentitySignature
+	}
+	return nil
+}
+
+func (x *UDBUserRegistration) GetFrs() *FactRegisterRequest {
+	if x != nil {
+		return x.Frs
+	}
+	return nil
+}
+
+func (x *UDBUserRegistration) GetUID() []byte {
+	if x != nil {
+		return x.UID
+	}
+	return nil
+}
+
+// Identity describes a user and their cryptographic info
+type Identity struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID                         uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	UpdateID                   uint64                 `protobuf:"varint,2,opt,name=UpdateID,proto3" json:"UpdateID,omitempty"`
-	State                      uint32                 `protobuf:"varint,3,opt,name=State,proto3" json:"State,omitempty"` // Precomp, Standby, Realtime, Completed, Failed
-	BatchSize                  uint32                 `protobuf:"varint,4,opt,name=BatchSize,proto3" json:"BatchSize,omitempty"`
-	Topology                   [][]byte               `protobuf:"bytes,5,rep,name=Topology,proto3" json:"Topology,omitempty"`             // List of NodeIDs
-	Timestamps                 []uint64               `protobuf:"varint,6,rep,packed,name=Timestamps,proto3" json:"Timestamps,omitempty"` // List of state timestamps in UTC
-	Errors                     []*RoundError          `protobuf:"bytes,7,rep,name=Errors,proto3" json:"Errors,omitempty"`                 // List of round errors which occurred
-	ClientErrors               []*ClientError         `protobuf:"bytes,8,rep,name=ClientErrors,proto3" json:"ClientErrors,omitempty"`
-	ResourceQueueTimeoutMillis uint32                 `protobuf:"varint,9,opt,name=ResourceQueueTimeoutMillis,proto3" json:"ResourceQueueTimeoutMillis,omitempty"` // Timeout for resource queue on nodes
-	Signature                  *messages.RSASignature `protobuf:"bytes,10,opt,name=Signature,proto3" json:"Signature,omitempty"`
-	AddressSpaceSize           uint32                 `protobuf:"varint,11,opt,name=AddressSpaceSize,proto3" json:"AddressSpaceSize,omitempty"`
-	EccSignature               *messages.ECCSignature `protobuf:"bytes,12,opt,name=EccSignature,proto3" json:"EccSignature,omitempty"`
+	Username string `protobuf:"bytes,1,opt,name=Username,proto3" json:"Username,omitempty"`
+	DhPubKey []byte `protobuf:"bytes,2,opt,name=dhPubKey,proto3" json:"dhPubKey,omitempty"`
+	Salt     []byte `protobuf:"bytes,3,opt,name=Salt,proto3" json:"Salt,omitempty"`
 }
 
-func (x *RoundInfo) Reset() {
-	*x = RoundInfo{}
+func (x *Identity) Reset() {
+	*x = Identity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[74]
+		mi := &file_mixmessages_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RoundInfo) String() string {
+func (x *Identity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoundInfo) ProtoMessage() {}
+func (*Identity) ProtoMessage() {}
 
-func (x *RoundInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[74]
+func (x *Identity) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5020,125 +5677,123 @@ func (x *RoundInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoundInfo.ProtoReflect.Descriptor instead.
-func (*RoundInfo) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use Identity.ProtoReflect.Descriptor instead.
+func (*Identity) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *RoundInfo) GetID() uint64 {
+func (x *Identity) GetUsername() string {
 	if x != nil {
-		return x.ID
+		return x.Username
 	}
-	return 0
+	return ""
 }
 
-func (x *RoundInfo) GetUpdateID() uint64 {
+func (x *Identity) GetDhPubKey() []byte {
 	if x != nil {
-		return x.UpdateID
+		return x.DhPubKey
 	}
-	return 0
+	return nil
 }
 
-func (x *RoundInfo) GetState() uint32 {
+func (x *Identity) GetSalt() []byte {
 	if x != nil {
-		return x.State
+		return x.Salt
 	}
-	return 0
+	return nil
 }
 
-func (x *RoundInfo) GetBatchSize() uint32 {
-	if x != nil {
-		return x.BatchSize
-	}
-	return 0
-}
+// Holds information for a Fact registration request
+type FactRegisterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *RoundInfo) GetTopology() [][]byte {
-	if x != nil {
-		return x.Topology
-	}
-	return nil
+	UID     []byte `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
+	Fact    *Fact  `protobuf:"bytes,2,opt,name=Fact,proto3" json:"Fact,omitempty"`
+	FactSig []byte `protobuf:"bytes,3,opt,name=FactSig,proto3" json:"FactSig,omitempty"` // (RSAPublicSign(Fact.Digest()))
 }
 
-func (x *RoundInfo) GetTimestamps() []uint64 {
-	if x != nil {
-		return x.Timestamps
+func (x *FactRegisterRequest) Reset() {
+	*x = FactRegisterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *RoundInfo) GetErrors() []*RoundError {
-	if x != nil {
-		return x.Errors
-	}
-	return nil
+func (x *FactRegisterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *RoundInfo) GetClientErrors() []*ClientError {
-	if x != nil {
-		return x.ClientErrors
+func (*FactRegisterRequest) ProtoMessage() {}
+
+func (x *FactRegisterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *RoundInfo) GetResourceQueueTimeoutMillis() uint32 {
-	if x != nil {
-		return x.ResourceQueueTimeoutMillis
-	}
-	return 0
+// Deprecated: Use FactRegisterRequest.ProtoReflect.Descriptor instead.
+func (*FactRegisterRequest) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *RoundInfo) GetSignature() *messages.RSASignature {
+func (x *FactRegisterRequest) GetUID() []byte {
 	if x != nil {
-		return x.Signature
+		return x.UID
 	}
 	return nil
 }
 
-func (x *RoundInfo) GetAddressSpaceSize() uint32 {
+func (x *FactRegisterRequest) GetFact() *Fact {
 	if x != nil {
-		return x.AddressSpaceSize
+		return x.Fact
 	}
-	return 0
+	return nil
 }
 
-func (x *RoundInfo) GetEccSignature() *messages.ECCSignature {
+func (x *FactRegisterRequest) GetFactSig() []byte {
 	if x != nil {
-		return x.EccSignature
+		return x.FactSig
 	}
 	return nil
 }
 
-// RoundError will be used to describe a round error
-// Passed along to gateways (level 2) and clients (level 3)
-type RoundError struct {
+// Fact describes a Fact, namely what it is and what type it is
+type Fact struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        uint64                 `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	NodeId    []byte                 `protobuf:"bytes,2,opt,name=NodeId,proto3" json:"NodeId,omitempty"`
-	Error     string                 `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
-	Signature *messages.RSASignature `protobuf:"bytes,4,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	Fact     string `protobuf:"bytes,1,opt,name=Fact,proto3" json:"Fact,omitempty"`
+	FactType uint32 `protobuf:"varint,2,opt,name=FactType,proto3" json:"FactType,omitempty"`
 }
 
-func (x *RoundError) Reset() {
-	*x = RoundError{}
+func (x *Fact) Reset() {
+	*x = Fact{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[75]
+		mi := &file_mixmessages_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RoundError) String() string {
+func (x *Fact) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoundError) ProtoMessage() {}
+func (*Fact) ProtoMessage() {}
 
-func (x *RoundError) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[75]
+func (x *Fact) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5149,62 +5804,51 @@ func (x *RoundError) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoundError.ProtoReflect.Descriptor instead.
-func (*RoundError) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{75}
-}
-
-func (x *RoundError) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *RoundError) GetNodeId() []byte {
-	if x != nil {
-		return x.NodeId
-	}
-	return nil
+// Deprecated: Use Fact.ProtoReflect.Descriptor instead.
+func (*Fact) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *RoundError) GetError() string {
+func (x *Fact) GetFact() string {
 	if x != nil {
-		return x.Error
+		return x.Fact
 	}
 	return ""
 }
 
-func (x *RoundError) GetSignature() *messages.RSASignature {
+func (x *Fact) GetFactType() uint32 {
 	if x != nil {
-		return x.Signature
+		return x.FactType
 	}
-	return nil
+	return 0
 }
 
-type EABCredentialRequest struct {
+// FactRegisterResponse describes UDB's handling of a FactRegisterRequest request
+type FactRegisterResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ConfirmationID string `protobuf:"bytes,1,opt,name=ConfirmationID,proto3" json:"ConfirmationID,omitempty"`
 }
 
-func (x *EABCredentialRequest) Reset() {
-	*x = EABCredentialRequest{}
+func (x *FactRegisterResponse) Reset() {
+	*x = FactRegisterResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[76]
+		mi := &file_mixmessages_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EABCredentialRequest) String() string {
+func (x *FactRegisterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EABCredentialRequest) ProtoMessage() {}
+func (*FactRegisterResponse) ProtoMessage() {}
 
-func (x *EABCredentialRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[76]
+func (x *FactRegisterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5215,37 +5859,45 @@ func (x *EABCredentialRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EABCredentialRequest.ProtoReflect.Descriptor instead.
-func (*EABCredentialRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use FactRegisterResponse.ProtoReflect.Descriptor instead.
+func (*FactRegisterResponse) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{86}
 }
 
-type EABCredentialResponse struct {
+func (x *FactRegisterResponse) GetConfirmationID() string {
+	if x != nil {
+		return x.ConfirmationID
+	}
+	return ""
+}
+
+// Holds information for a Fact confirmation request
+type FactConfirmRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KeyId string `protobuf:"bytes,1,opt,name=KeyId,proto3" json:"KeyId,omitempty"`
-	Key   string `protobuf:"bytes,2,opt,name=Key,proto3" json:"Key,omitempty"`
+	ConfirmationID string `protobuf:"bytes,1,opt,name=ConfirmationID,proto3" json:"ConfirmationID,omitempty"`
+	Code           string `protobuf:"bytes,2,opt,name=Code,proto3" json:"Code,omitempty"`
 }
 
-func (x *EABCredentialResponse) Reset() {
-	*x = EABCredentialResponse{}
+func (x *FactConfirmRequest) Reset() {
+	*x = FactConfirmRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[77]
+		mi := &file_mixmessages_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EABCredentialResponse) String() string {
+func (x *FactConfirmRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EABCredentialResponse) ProtoMessage() {}
+func (*FactConfirmRequest) ProtoMessage() {}
 
-func (x *EABCredentialResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[77]
+func (x *FactConfirmRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5256,53 +5908,53 @@ func (x *EABCredentialResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EABCredentialResponse.ProtoReflect.Descriptor instead.
-func (*EABCredentialResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use FactConfirmRequest.ProtoReflect.Descriptor instead.
+func (*FactConfirmRequest) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *EABCredentialResponse) GetKeyId() string {
+func (x *FactConfirmRequest) GetConfirmationID() string {
 	if x != nil {
-		return x.KeyId
+		return x.ConfirmationID
 	}
 	return ""
 }
 
-func (x *EABCredentialResponse) GetKey() string {
+func (x *FactConfirmRequest) GetCode() string {
 	if x != nil {
-		return x.Key
+		return x.Code
 	}
 	return ""
 }
 
-type AuthorizerCertRequest struct {
+// Holds information for a Fact removal request
+type FactRemovalRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GwID      []byte `protobuf:"bytes,1,opt,name=GwID,proto3" json:"GwID,omitempty"`
-	Timestamp int64  `protobuf:"varint,2,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
-	ACMEToken string `protobuf:"bytes,3,opt,name=ACMEToken,proto3" json:"ACMEToken,omitempty"`
-	Signature []byte `protobuf:"bytes,4,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	UID         []byte `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
+	RemovalData *Fact  `protobuf:"bytes,2,opt,name=RemovalData,proto3" json:"RemovalData,omitempty"`
+	FactSig     []byte `protobuf:"bytes,3,opt,name=FactSig,proto3" json:"FactSig,omitempty"` // (RSAPublicSign(Fact.Digest()))
 }
 
-func (x *AuthorizerCertRequest) Reset() {
-	*x = AuthorizerCertRequest{}
+func (x *FactRemovalRequest) Reset() {
+	*x = FactRemovalRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[78]
+		mi := &file_mixmessages_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AuthorizerCertRequest) String() string {
+func (x *FactRemovalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthorizerCertRequest) ProtoMessage() {}
+func (*FactRemovalRequest) ProtoMessage() {}
 
-func (x *AuthorizerCertRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[78]
+func (x *FactRemovalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5313,68 +5965,58 @@ func (x *AuthorizerCertRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthorizerCertRequest.ProtoReflect.Descriptor instead.
-func (*AuthorizerCertRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{78}
+// Deprecated: Use FactRemovalRequest.ProtoReflect.Descriptor instead.
+func (*FactRemovalRequest) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *AuthorizerCertRequest) GetGwID() []byte {
+func (x *FactRemovalRequest) GetUID() []byte {
 	if x != nil {
-		return x.GwID
+		return x.UID
 	}
 	return nil
 }
 
-func (x *AuthorizerCertRequest) GetTimestamp() int64 {
-	if x != nil {
-		return x.Timestamp
-	}
-	return 0
-}
-
-func (x *AuthorizerCertRequest) GetACMEToken() string {
+func (x *FactRemovalRequest) GetRemovalData() *Fact {
 	if x != nil {
-		return x.ACMEToken
+		return x.RemovalData
 	}
-	return ""
+	return nil
 }
 
-func (x *AuthorizerCertRequest) GetSignature() []byte {
+func (x *FactRemovalRequest) GetFactSig() []byte {
 	if x != nil {
-		return x.Signature
+		return x.FactSig
 	}
 	return nil
 }
 
-type AuthorizerAuth struct {
+// Holds several Fact registration requests
+type FactRegisterBatch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NodeID    []byte `protobuf:"bytes,1,opt,name=NodeID,proto3" json:"NodeID,omitempty"`
-	Salt      []byte `protobuf:"bytes,2,opt,name=Salt,proto3" json:"Salt,omitempty"`
-	PubkeyPem []byte `protobuf:"bytes,3,opt,name=PubkeyPem,proto3" json:"PubkeyPem,omitempty"`
-	TimeStamp int64  `protobuf:"varint,4,opt,name=TimeStamp,proto3" json:"TimeStamp,omitempty"`
-	Signature []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	Requests []*FactRegisterRequest `protobuf:"bytes,1,rep,name=Requests,proto3" json:"Requests,omitempty"`
 }
 
-func (x *AuthorizerAuth) Reset() {
-	*x = AuthorizerAuth{}
+func (x *FactRegisterBatch) Reset() {
+	*x = FactRegisterBatch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[79]
+		mi := &file_mixmessages_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AuthorizerAuth) String() string {
+func (x *FactRegisterBatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthorizerAuth) ProtoMessage() {}
+func (*FactRegisterBatch) ProtoMessage() {}
 
-func (x *AuthorizerAuth) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[79]
+func (x *FactRegisterBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5385,73 +6027,46 @@ func (x *AuthorizerAuth) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthorizerAuth.ProtoReflect.Descriptor instead.
-func (*AuthorizerAuth) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{79}
-}
-
-func (x *AuthorizerAuth) GetNodeID() []byte {
-	if x != nil {
-		return x.NodeID
-	}
-	return nil
-}
-
-func (x *AuthorizerAuth) GetSalt() []byte {
-	if x != nil {
-		return x.Salt
-	}
-	return nil
-}
-
-func (x *AuthorizerAuth) GetPubkeyPem() []byte {
-	if x != nil {
-		return x.PubkeyPem
-	}
-	return nil
-}
-
-func (x *AuthorizerAuth) GetTimeStamp() int64 {
-	if x != nil {
-		return x.TimeStamp
-	}
-	return 0
+// Deprecated: Use FactRegisterBatch.ProtoReflect.Descriptor instead.
+func (*FactRegisterBatch) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *AuthorizerAuth) GetSignature() []byte {
+func (x *FactRegisterBatch) GetRequests() []*FactRegisterRequest {
 	if x != nil {
-		return x.Signature
+		return x.Requests
 	}
 	return nil
 }
 
-type RsAuthenticationRequest struct {
+// Result of one request in a FactRegisterBatch. Error is set if the request
+// failed.
+type FactRegisterResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username     string `protobuf:"bytes,1,opt,name=Username,proto3" json:"Username,omitempty"`
-	PasswordHash []byte `protobuf:"bytes,2,opt,name=PasswordHash,proto3" json:"PasswordHash,omitempty"`
-	Salt         []byte `protobuf:"bytes,3,opt,name=Salt,proto3" json:"Salt,omitempty"`
+	Response *FactRegisterResponse `protobuf:"bytes,1,opt,name=Response,proto3" json:"Response,omitempty"`
+	Error    string                `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
 }
 
-func (x *RsAuthenticationRequest) Reset() {
-	*x = RsAuthenticationRequest{}
+func (x *FactRegisterResult) Reset() {
+	*x = FactRegisterResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[80]
+		mi := &file_mixmessages_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RsAuthenticationRequest) String() string {
+func (x *FactRegisterResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RsAuthenticationRequest) ProtoMessage() {}
+func (*FactRegisterResult) ProtoMessage() {}
 
-func (x *RsAuthenticationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[80]
+func (x *FactRegisterResult) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5462,58 +6077,51 @@ func (x *RsAuthenticationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RsAuthenticationRequest.ProtoReflect.Descriptor instead.
-func (*RsAuthenticationRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{80}
-}
-
-func (x *RsAuthenticationRequest) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
+// Deprecated: Use FactRegisterResult.ProtoReflect.Descriptor instead.
+func (*FactRegisterResult) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *RsAuthenticationRequest) GetPasswordHash() []byte {
+func (x *FactRegisterResult) GetResponse() *FactRegisterResponse {
 	if x != nil {
-		return x.PasswordHash
+		return x.Response
 	}
 	return nil
 }
 
-func (x *RsAuthenticationRequest) GetSalt() []byte {
+func (x *FactRegisterResult) GetError() string {
 	if x != nil {
-		return x.Salt
+		return x.Error
 	}
-	return nil
+	return ""
 }
 
-type RsAuthenticationResponse struct {
+// Results in the order of the requests in the FactRegisterBatch
+type FactRegisterBatchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token     []byte `protobuf:"bytes,1,opt,name=Token,proto3" json:"Token,omitempty"`
-	ExpiresAt int64  `protobuf:"varint,2,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
+	Results []*FactRegisterResult `protobuf:"bytes,1,rep,name=Results,proto3" json:"Results,omitempty"`
 }
 
-func (x *RsAuthenticationResponse) Reset() {
-	*x = RsAuthenticationResponse{}
+func (x *FactRegisterBatchResponse) Reset() {
+	*x = FactRegisterBatchResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[81]
+		mi := &file_mixmessages_proto_msgTypes[91]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RsAuthenticationResponse) String() string {
+func (x *FactRegisterBatchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RsAuthenticationResponse) ProtoMessage() {}
+func (*FactRegisterBatchResponse) ProtoMessage() {}
 
-func (x *RsAuthenticationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[81]
+func (x *FactRegisterBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[91]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5524,51 +6132,44 @@ func (x *RsAuthenticationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RsAuthenticationResponse.ProtoReflect.Descriptor instead.
-func (*RsAuthenticationResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{81}
+// Deprecated: Use FactRegisterBatchResponse.ProtoReflect.Descriptor instead.
+func (*FactRegisterBatchResponse) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *RsAuthenticationResponse) GetToken() []byte {
+func (x *FactRegisterBatchResponse) GetResults() []*FactRegisterResult {
 	if x != nil {
-		return x.Token
+		return x.Results
 	}
 	return nil
 }
 
-func (x *RsAuthenticationResponse) GetExpiresAt() int64 {
-	if x != nil {
-		return x.ExpiresAt
-	}
-	return 0
-}
-
-type RsReadRequest struct {
+// Holds several Fact confirmation requests
+type FactConfirmBatch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path  string `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
-	Token []byte `protobuf:"bytes,2,opt,name=Token,proto3" json:"Token,omitempty"`
+	Requests []*FactConfirmRequest `protobuf:"bytes,1,rep,name=Requests,proto3" json:"Requests,omitempty"`
 }
 
-func (x *RsReadRequest) Reset() {
-	*x = RsReadRequest{}
+func (x *FactConfirmBatch) Reset() {
+	*x = FactConfirmBatch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[82]
+		mi := &file_mixmessages_proto_msgTypes[92]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RsReadRequest) String() string {
+func (x *FactConfirmBatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RsReadRequest) ProtoMessage() {}
+func (*FactConfirmBatch) ProtoMessage() {}
 
-func (x *RsReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[82]
+func (x *FactConfirmBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[92]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5579,50 +6180,45 @@ func (x *RsReadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RsReadRequest.ProtoReflect.Descriptor instead.
-func (*RsReadRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{82}
-}
-
-func (x *RsReadRequest) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
+// Deprecated: Use FactConfirmBatch.ProtoReflect.Descriptor instead.
+func (*FactConfirmBatch) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *RsReadRequest) GetToken() []byte {
+func (x *FactConfirmBatch) GetRequests() []*FactConfirmRequest {
 	if x != nil {
-		return x.Token
+		return x.Requests
 	}
 	return nil
 }
 
-type RsLastWriteRequest struct {
+// Result of one request in a FactConfirmBatch. Error is set if the request
+// failed.
+type FactConfirmResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token []byte `protobuf:"bytes,1,opt,name=Token,proto3" json:"Token,omitempty"`
+	Error string `protobuf:"bytes,1,opt,name=Error,proto3" json:"Error,omitempty"`
 }
 
-func (x *RsLastWriteRequest) Reset() {
-	*x = RsLastWriteRequest{}
+func (x *FactConfirmResult) Reset() {
+	*x = FactConfirmResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[83]
+		mi := &file_mixmessages_proto_msgTypes[93]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RsLastWriteRequest) String() string {
+func (x *FactConfirmResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RsLastWriteRequest) ProtoMessage() {}
+func (*FactConfirmResult) ProtoMessage() {}
 
-func (x *RsLastWriteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[83]
+func (x *FactConfirmResult) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[93]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5633,43 +6229,44 @@ func (x *RsLastWriteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RsLastWriteRequest.ProtoReflect.Descriptor instead.
-func (*RsLastWriteRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{83}
+// Deprecated: Use FactConfirmResult.ProtoReflect.Descriptor instead.
+func (*FactConfirmResult) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *RsLastWriteRequest) GetToken() []byte {
+func (x *FactConfirmResult) GetError() string {
 	if x != nil {
-		return x.Token
+		return x.Error
 	}
-	return nil
+	return ""
 }
 
-type RsReadResponse struct {
+// Results in the order of the requests in the FactConfirmBatch
+type FactConfirmBatchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data []byte `protobuf:"bytes,1,opt,name=Data,proto3" json:"Data,omitempty"`
+	Results []*FactConfirmResult `protobuf:"bytes,1,rep,name=Results,proto3" json:"Results,omitempty"`
 }
 
-func (x *RsReadResponse) Reset() {
-	*x = RsReadResponse{}
+func (x *FactConfirmBatchResponse) Reset() {
+	*x = FactConfirmBatchResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[84]
+		mi := &file_mixmessages_proto_msgTypes[94]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RsReadResponse) String() string {
+func (x *FactConfirmBatchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RsReadResponse) ProtoMessage() {}
+func (*FactConfirmBatchResponse) ProtoMessage() {}
 
-func (x *RsReadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[84]
+func (x *FactConfirmBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[94]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5680,45 +6277,46 @@ func (x *RsReadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RsReadResponse.ProtoReflect.Descriptor instead.
-func (*RsReadResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{84}
+// Deprecated: Use FactConfirmBatchResponse.ProtoReflect.Descriptor instead.
+func (*FactConfirmBatchResponse) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *RsReadResponse) GetData() []byte {
+func (x *FactConfirmBatchResponse) GetResults() []*FactConfirmResult {
 	if x != nil {
-		return x.Data
+		return x.Results
 	}
 	return nil
 }
 
-type RsWriteRequest struct {
+// Holds information for a request to list the facts registered to an ID
+type FactListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path  string `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
-	Data  []byte `protobuf:"bytes,2,opt,name=Data,proto3" json:"Data,omitempty"`
-	Token []byte `protobuf:"bytes,3,opt,name=Token,proto3" json:"Token,omitempty"`
+	UID       []byte `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
+	Timestamp int64  `protobuf:"varint,2,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Signature []byte `protobuf:"bytes,3,opt,name=Signature,proto3" json:"Signature,omitempty"` // (RSAPublicSign(UID|Timestamp))
 }
 
-func (x *RsWriteRequest) Reset() {
-	*x = RsWriteRequest{}
+func (x *FactListRequest) Reset() {
+	*x = FactListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[85]
+		mi := &file_mixmessages_proto_msgTypes[95]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RsWriteRequest) String() string {
+func (x *FactListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RsWriteRequest) ProtoMessage() {}
+func (*FactListRequest) ProtoMessage() {}
 
-func (x *RsWriteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[85]
+func (x *FactListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[95]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5729,57 +6327,59 @@ func (x *RsWriteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RsWriteRequest.ProtoReflect.Descriptor instead.
-func (*RsWriteRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{85}
+// Deprecated: Use FactListRequest.ProtoReflect.Descriptor instead.
+func (*FactListRequest) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *RsWriteRequest) GetPath() string {
+func (x *FactListRequest) GetUID() []byte {
 	if x != nil {
-		return x.Path
+		return x.UID
 	}
-	return ""
+	return nil
 }
 
-func (x *RsWriteRequest) GetData() []byte {
+func (x *FactListRequest) GetTimestamp() int64 {
 	if x != nil {
-		return x.Data
+		return x.Timestamp
 	}
-	return nil
+	return 0
 }
 
-func (x *RsWriteRequest) GetToken() []byte {
+func (x *FactListRequest) GetSignature() []byte {
 	if x != nil {
-		return x.Token
+		return x.Signature
 	}
 	return nil
 }
 
-type RsReadDirResponse struct {
+// A Fact registered to an ID
+type RegisteredFact struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data []string `protobuf:"bytes,1,rep,name=Data,proto3" json:"Data,omitempty"`
+	Fact      *Fact `protobuf:"bytes,1,opt,name=Fact,proto3" json:"Fact,omitempty"`
+	Confirmed bool  `protobuf:"varint,2,opt,name=Confirmed,proto3" json:"Confirmed,omitempty"`
 }
 
-func (x *RsReadDirResponse) Reset() {
-	*x = RsReadDirResponse{}
+func (x *RegisteredFact) Reset() {
+	*x = RegisteredFact{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[86]
+		mi := &file_mixmessages_proto_msgTypes[96]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RsReadDirResponse) String() string {
+func (x *RegisteredFact) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RsReadDirResponse) ProtoMessage() {}
+func (*RegisteredFact) ProtoMessage() {}
 
-func (x *RsReadDirResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[86]
+func (x *RegisteredFact) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[96]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5790,43 +6390,51 @@ func (x *RsReadDirResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RsReadDirResponse.ProtoReflect.Descriptor instead.
-func (*RsReadDirResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{86}
+// Deprecated: Use RegisteredFact.ProtoReflect.Descriptor instead.
+func (*RegisteredFact) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *RsReadDirResponse) GetData() []string {
+func (x *RegisteredFact) GetFact() *Fact {
 	if x != nil {
-		return x.Data
+		return x.Fact
 	}
 	return nil
 }
 
-type RsTimestampResponse struct {
+func (x *RegisteredFact) GetConfirmed() bool {
+	if x != nil {
+		return x.Confirmed
+	}
+	return false
+}
+
+// FactListResponse lists the facts registered to the ID of a FactListRequest
+type FactListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Timestamp int64 `protobuf:"varint,1,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Facts []*RegisteredFact `protobuf:"bytes,1,rep,name=Facts,proto3" json:"Facts,omitempty"`
 }
 
-func (x *RsTimestampResponse) Reset() {
-	*x = RsTimestampResponse{}
+func (x *FactListResponse) Reset() {
+	*x = FactListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[87]
+		mi := &file_mixmessages_proto_msgTypes[97]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RsTimestampResponse) String() string {
+func (x *FactListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RsTimestampResponse) ProtoMessage() {}
+func (*FactListResponse) ProtoMessage() {}
 
-func (x *RsTimestampResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[87]
+func (x *FactListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[97]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5837,1396 +6445,3396 @@ func (x *RsTimestampResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RsTimestampResponse.ProtoReflect.Descriptor instead.
-func (*RsTimestampResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{87}
+// Deprecated: Use FactListResponse.ProtoReflect.Descriptor instead.
+func (*FactListResponse) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *RsTimestampResponse) GetTimestamp() int64 {
+func (x *FactListResponse) GetFacts() []*RegisteredFact {
 	if x != nil {
-		return x.Timestamp
+		return x.Facts
 	}
-	return 0
+	return nil
 }
 
-var File_mixmessages_proto protoreflect.FileDescriptor
+// For sending permission address Server -> Gateway
+type StrAddress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_mixmessages_proto_rawDesc = []byte{
-	0x0a, 0x11, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x1a, 0x33, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x78, 0x78, 0x5f,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x73, 0x2f, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0xa5, 0x02, 0x0a, 0x10, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x12, 0x73, 0x0a, 0x1e, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x1e,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a,
-	0x0a, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x34, 0x0a, 0x15, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x12, 0x26, 0x0a, 0x0e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x44, 0x48, 0x50, 0x75, 0x62, 0x4b,
-	0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x44, 0x48, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x22, 0xeb, 0x01, 0x0a, 0x1b, 0x53, 0x69, 0x67,
-	0x6e, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x43, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x10, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x54, 0x0a, 0x19, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x52, 0x53, 0x41, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52,
-	0x19, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x54, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x55, 0x73, 0x65, 0x53, 0x48, 0x41, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
-	0x55, 0x73, 0x65, 0x53, 0x48, 0x41, 0x22, 0xca, 0x01, 0x0a, 0x16, 0x53, 0x69, 0x67, 0x6e, 0x65,
-	0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x2a, 0x0a, 0x10, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x54, 0x0a,
-	0x19, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x53, 0x41, 0x53,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x19, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55,
-	0x73, 0x65, 0x53, 0x48, 0x41, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x55, 0x73, 0x65,
-	0x53, 0x48, 0x41, 0x22, 0xd5, 0x01, 0x0a, 0x11, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x45, 0x6e, 0x63,
-	0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x16, 0x45, 0x6e, 0x63,
-	0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x48,
-	0x4d, 0x41, 0x43, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x16, 0x45, 0x6e, 0x63, 0x72, 0x79,
-	0x70, 0x74, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x48, 0x4d, 0x41,
-	0x43, 0x12, 0x22, 0x0a, 0x0c, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x48, 0x50, 0x75, 0x62, 0x4b, 0x65,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x48, 0x50,
-	0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x44, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x58, 0x0a, 0x16, 0x53,
-	0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4b,
-	0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4b, 0x65,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x53, 0x69, 0x67, 0x6e, 0x65,
-	0x64, 0x4b, 0x65, 0x79, 0x73, 0x22, 0xcf, 0x01, 0x0a, 0x11, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64,
-	0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x4b,
-	0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0b, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a,
-	0x1a, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x69, 0x67, 0x6e,
-	0x65, 0x64, 0x42, 0x79, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x53, 0x41,
-	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x1a, 0x4b, 0x65, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x79, 0x47, 0x61,
-	0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x2a, 0x0a, 0x10, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x47,
-	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x10, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x49, 0x0a, 0x11, 0x50, 0x6f, 0x73, 0x74, 0x50,
-	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07,
-	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x4e, 0x75, 0x6d, 0x53, 0x6c, 0x6f,
-	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x4e, 0x75, 0x6d, 0x53, 0x6c, 0x6f,
-	0x74, 0x73, 0x22, 0x3b, 0x0a, 0x0f, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x75, 0x66, 0x66, 0x65,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x28, 0x0a, 0x0f, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x75,
-	0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f,
-	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x22,
-	0x50, 0x0a, 0x0e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
-	0x79, 0x12, 0x2c, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12,
-	0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x4b, 0x65,
-	0x79, 0x22, 0x38, 0x0a, 0x0c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x73, 0x12, 0x28, 0x0a, 0x0f, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x52, 0x6f, 0x75, 0x6e,
-	0x64, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x7c, 0x0a, 0x05, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x12, 0x2c, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x52, 0x6f, 0x75,
-	0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x68, 0x61, 0x73, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x68, 0x61, 0x73, 0x65,
-	0x12, 0x27, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x6c,
-	0x6f, 0x74, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x22, 0x53, 0x0a, 0x0e, 0x43, 0x6f, 0x6d,
-	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x6f,
-	0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x27, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x22, 0x75,
-	0x0a, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2c, 0x0a, 0x05, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69, 0x78,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x46, 0x72, 0x6f,
-	0x6d, 0x50, 0x68, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x46, 0x72,
-	0x6f, 0x6d, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x6d, 0x0a, 0x0d, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x72,
-	0x69, 0x70, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x07, 0x50,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x2c, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x22, 0xec, 0x01, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50,
-	0x6f, 0x6c, 0x6c, 0x12, 0x28, 0x0a, 0x04, 0x46, 0x75, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x4e, 0x44, 0x46, 0x48, 0x61, 0x73, 0x68, 0x52, 0x04, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x2e, 0x0a,
-	0x07, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46,
-	0x48, 0x61, 0x73, 0x68, 0x52, 0x07, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1e, 0x0a,
-	0x0a, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x67, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x67,
-	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x22, 0xe9, 0x03, 0x0a, 0x12, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f,
-	0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x07, 0x46, 0x75,
-	0x6c, 0x6c, 0x4e, 0x44, 0x46, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x69,
-	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46, 0x52, 0x07, 0x46,
-	0x75, 0x6c, 0x6c, 0x4e, 0x44, 0x46, 0x12, 0x30, 0x0a, 0x0a, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61,
-	0x6c, 0x4e, 0x44, 0x46, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x69, 0x78,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46, 0x52, 0x0a, 0x50, 0x61,
-	0x72, 0x74, 0x69, 0x61, 0x6c, 0x4e, 0x44, 0x46, 0x12, 0x30, 0x0a, 0x07, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0c, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x42, 0x61, 0x74, 0x63, 0x68, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x61, 0x64, 0x79, 0x52, 0x05,
-	0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x30, 0x0a, 0x13, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73,
-	0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x13, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x32, 0x0a, 0x14, 0x45, 0x61, 0x72, 0x6c, 0x69,
-	0x65, 0x73, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x47,
-	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x36, 0x0a, 0x16, 0x45,
-	0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x45, 0x61, 0x72,
-	0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x12, 0x2a, 0x0a, 0x10, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x45,
-	0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x22,
-	0x26, 0x0a, 0x0a, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x18, 0x0a,
-	0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
-	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x22, 0x96, 0x01, 0x0a, 0x0a, 0x53, 0x68, 0x61, 0x72,
-	0x65, 0x50, 0x69, 0x65, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x69, 0x65, 0x63, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x50, 0x69, 0x65, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0c,
-	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0c, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x34, 0x0a, 0x09, 0x53, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x53, 0x41, 0x53, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x22, 0x14, 0x0a, 0x12, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77,
-	0x61, 0x79, 0x43, 0x65, 0x72, 0x74, 0x22, 0x54, 0x0a, 0x12, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x23, 0x0a, 0x0b,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x44,
-	0x61, 0x74, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x44, 0x61, 0x74, 0x75,
-	0x6d, 0x22, 0x2a, 0x0a, 0x10, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x22, 0x4a, 0x0a,
-	0x18, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x52, 0x6f, 0x75,
-	0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x06, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x22, 0x62, 0x0a, 0x10, 0x47, 0x65, 0x74,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x34, 0x0a,
-	0x08, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x65,
-	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x08, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x6e, 0x0a,
-	0x18, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x3a, 0x0a, 0x07, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x78,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x5b, 0x0a,
-	0x0b, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e,
-	0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64,
-	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x60, 0x0a, 0x13, 0x47, 0x65,
-	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2d, 0x0a, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x12, 0x1a, 0x0a, 0x08, 0x48, 0x61, 0x73, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x08, 0x48, 0x61, 0x73, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x58, 0x0a, 0x0d,
-	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x18, 0x0a,
-	0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
-	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x08, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x1a, 0x0a, 0x06, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x10, 0x0a, 0x03, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x49,
-	0x44, 0x73, 0x22, 0x84, 0x04, 0x0a, 0x04, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x49, 0x6e, 0x64, 0x65,
-	0x78, 0x12, 0x34, 0x0a, 0x15, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x50, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x15, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x41, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x34, 0x0a, 0x15, 0x45, 0x6e, 0x63, 0x72, 0x79,
-	0x70, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x4b, 0x65, 0x79, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65,
-	0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x3c, 0x0a,
-	0x19, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x41,
-	0x43, 0x79, 0x70, 0x68, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x19, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x41, 0x43, 0x79, 0x70, 0x68, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74, 0x12, 0x3c, 0x0a, 0x19, 0x50,
-	0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x43, 0x79,
-	0x70, 0x68, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x19,
-	0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x43,
-	0x79, 0x70, 0x68, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74, 0x12, 0x40, 0x0a, 0x1b, 0x50, 0x61, 0x72,
-	0x74, 0x69, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x43,
-	0x79, 0x70, 0x68, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1b,
-	0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x43, 0x79, 0x70, 0x68, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x53,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x53,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x41, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x50, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x41, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x12,
-	0x12, 0x0a, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x53,
-	0x61, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4b, 0x4d, 0x41, 0x43, 0x73, 0x18, 0x0b, 0x20, 0x03,
-	0x28, 0x0c, 0x52, 0x05, 0x4b, 0x4d, 0x41, 0x43, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x64, 0x32,
-	0x35, 0x35, 0x31, 0x39, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x45, 0x64, 0x32, 0x35,
-	0x35, 0x31, 0x39, 0x12, 0x24, 0x0a, 0x0d, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c,
-	0x4b, 0x65, 0x79, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x08, 0x52, 0x0d, 0x45, 0x70, 0x68, 0x65,
-	0x6d, 0x65, 0x72, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x22, 0xd9, 0x02, 0x0a, 0x0b, 0x47, 0x61,
-	0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x2e, 0x0a, 0x07, 0x50, 0x61, 0x72,
-	0x74, 0x69, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x69, 0x78,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46, 0x48, 0x61, 0x73, 0x68,
-	0x52, 0x07, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x4c, 0x61, 0x73,
-	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x4c,
-	0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x65, 0x63,
-	0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b,
-	0x52, 0x65, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x45, 0x6e, 0x64, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a,
-	0x0b, 0x46, 0x61, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0b, 0x46, 0x61, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x12,
-	0x1c, 0x0a, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x26, 0x0a,
-	0x0e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0xe6, 0x02, 0x0a, 0x13, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a,
-	0x0a, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x4e, 0x44, 0x46, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x4e, 0x44, 0x46, 0x52, 0x0a, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x4e, 0x44, 0x46, 0x12,
-	0x30, 0x0a, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x6f, 0x75,
-	0x6e, 0x64, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x73, 0x52,
-	0x07, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x45, 0x61, 0x72, 0x6c,
-	0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0d, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x2a,
-	0x0a, 0x10, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45,
-	0x72, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65,
-	0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65,
-	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
-	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x47, 0x61,
-	0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0c, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x22, 0x82,
-	0x01, 0x0a, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x73, 0x12,
-	0x16, 0x0a, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x46, 0x69, 0x72, 0x73, 0x74,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0e, 0x46, 0x69, 0x72, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
-	0x32, 0x0a, 0x07, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x52, 0x07, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x73, 0x22, 0x65, 0x0a, 0x0b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f,
-	0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x46, 0x69,
-	0x72, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
-	0x46, 0x69, 0x72, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x6f,
-	0x75, 0x6e, 0x64, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
-	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x0c, 0x47,
-	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x54,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x54, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x22, 0x96, 0x01, 0x0a, 0x0b,
-	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d,
-	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52,
-	0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e,
-	0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64,
-	0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x41, 0x43, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x03, 0x4d, 0x41, 0x43, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x49, 0x70,
-	0x41, 0x64, 0x64, 0x72, 0x22, 0x4b, 0x0a, 0x13, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53,
-	0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61,
-	0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61,
-	0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64,
-	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49,
-	0x44, 0x22, 0x58, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12,
-	0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x70, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x03, 0x49, 0x70, 0x73, 0x22, 0x64, 0x0a, 0x0a, 0x52,
-	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x63,
-	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52,
-	0x0c, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x18, 0x0a,
-	0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
-	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64,
-	0x54, 0x53, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54,
-	0x53, 0x22, 0x40, 0x0a, 0x1a, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x4e,
-	0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x22, 0x0a, 0x0c, 0x49, 0x73, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x49, 0x73, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x65, 0x64, 0x22, 0x25, 0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65,
-	0x64, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x49, 0x44, 0x22, 0x1d, 0x0a, 0x07, 0x4e, 0x44,
-	0x46, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x04, 0x48, 0x61, 0x73, 0x68, 0x22, 0x4d, 0x0a, 0x03, 0x4e, 0x44, 0x46,
-	0x12, 0x10, 0x0a, 0x03, 0x4e, 0x64, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x4e,
-	0x64, 0x66, 0x12, 0x34, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x52, 0x53, 0x41, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x53,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xb0, 0x02, 0x0a, 0x10, 0x4e, 0x6f, 0x64,
-	0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
-	0x04, 0x53, 0x61, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x53, 0x61, 0x6c,
-	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x6c, 0x73, 0x43, 0x65,
-	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x54, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a,
-	0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x26, 0x0a,
-	0x0e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x54, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x54, 0x6c,
-	0x73, 0x43, 0x65, 0x72, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x47,
-	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a,
-	0x0b, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0b, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x12,
-	0x2a, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xbe, 0x01, 0x0a, 0x12,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x40,
-	0x0a, 0x1b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x1b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79,
-	0x12, 0x3a, 0x0a, 0x18, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x18, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x22, 0x5c, 0x0a, 0x1e,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
-	0x0a, 0x09, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xb0, 0x01, 0x0a, 0x1e, 0x53,
-	0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a,
-	0x1e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x12, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
-	0x61, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x53, 0x41,
-	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x12, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x72, 0x61, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xa1, 0x02,
-	0x0a, 0x25, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
-	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x73, 0x0a, 0x1e, 0x43, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x69,
-	0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x1e, 0x43, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6d, 0x0a, 0x1b,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x1b,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x22, 0x29, 0x0a, 0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xcc, 0x03, 0x0a,
-	0x11, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x6f,
-	0x6c, 0x6c, 0x12, 0x28, 0x0a, 0x04, 0x46, 0x75, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e,
-	0x44, 0x46, 0x48, 0x61, 0x73, 0x68, 0x52, 0x04, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x2e, 0x0a, 0x07,
-	0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46, 0x48,
-	0x61, 0x73, 0x68, 0x52, 0x07, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a,
-	0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
-	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x67, 0x61, 0x74, 0x65, 0x77,
-	0x61, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x26, 0x0a, 0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a,
-	0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x52, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x07, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x22, 0x57, 0x0a, 0x0b, 0x43,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x43, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x22, 0xf2, 0x02, 0x0a, 0x16, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x2a, 0x0a, 0x07, 0x46, 0x75, 0x6c, 0x6c, 0x4e, 0x44, 0x46, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e,
-	0x44, 0x46, 0x52, 0x07, 0x46, 0x75, 0x6c, 0x6c, 0x4e, 0x44, 0x46, 0x12, 0x30, 0x0a, 0x0a, 0x50,
-	0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x4e, 0x44, 0x46, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44,
-	0x46, 0x52, 0x0a, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x4e, 0x44, 0x46, 0x12, 0x30, 0x0a,
-	0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75,
-	0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12,
-	0x30, 0x0a, 0x13, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x45, 0x61,
-	0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e,
-	0x64, 0x12, 0x32, 0x0a, 0x14, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x47, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x14, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x36, 0x0a, 0x16, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73,
-	0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2a, 0x0a,
-	0x10, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72,
-	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73,
-	0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x22, 0xe6, 0x02, 0x0a, 0x14, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x41, 0x70, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x41, 0x70, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x50, 0x65, 0x6d,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x50, 0x65, 0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x61, 0x6c, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x53, 0x61, 0x6c, 0x74, 0x12, 0x34, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x40, 0x0a, 0x1b,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x72, 0x53, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x1b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x73, 0x61, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x72, 0x53, 0x69, 0x67, 0x12, 0x2a,
-	0x0a, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x26, 0x0a, 0x0e, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x22, 0xc4, 0x01, 0x0a, 0x16, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
-	0x03, 0x41, 0x70, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x41, 0x70, 0x70, 0x12,
-	0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x50, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x73, 0x61, 0x50, 0x65, 0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x12, 0x26, 0x0a, 0x0e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x61, 0x0a, 0x1a, 0x55, 0x6e, 0x72,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd7, 0x01, 0x0a,
-	0x18, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64,
-	0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x07, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x69, 0x78,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34,
-	0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x12, 0x40, 0x0a, 0x1b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x72,
-	0x53, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1b, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x72, 0x61, 0x72, 0x53, 0x69, 0x67, 0x22, 0xce, 0x01, 0x0a, 0x1c, 0x54, 0x72, 0x61, 0x63, 0x6b,
-	0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x63, 0x6b,
-	0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x15, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x44, 0x12, 0x2e, 0x0a,
-	0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61,
-	0x50, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x50, 0x65, 0x6d, 0x12, 0x2a, 0x0a,
-	0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x53, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xcd, 0x02, 0x0a, 0x1b, 0x4e, 0x6f, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x26, 0x0a,
-	0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69,
-	0x61, 0x72, 0x79, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x12,
-	0x2a, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53,
-	0x61, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x61, 0x6c, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x53, 0x69,
-	0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x53, 0x69, 0x67, 0x12, 0x34, 0x0a, 0x15, 0x49,
-	0x49, 0x44, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73,
-	0x61, 0x53, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x49, 0x49, 0x44, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x53, 0x69,
-	0x67, 0x12, 0x34, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x7d, 0x0a, 0x1d, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x64,
-	0x12, 0x34, 0x0a, 0x15, 0x49, 0x49, 0x44, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x53, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x15, 0x49, 0x49, 0x44, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x73, 0x61, 0x53, 0x69, 0x67, 0x22, 0x1e, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0c, 0x52, 0x03, 0x49, 0x44, 0x73, 0x22, 0x72, 0x0a, 0x11, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x72,
-	0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72, 0x6f,
-	0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x43, 0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d,
-	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x6e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x76, 0x0a, 0x10, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20,
-	0x0a, 0x0b, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x44,
-	0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x50, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x50,
-	0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x61,
-	0x73, 0x68, 0x22, 0xb1, 0x01, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65,
-	0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x12, 0x2c, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31,
-	0x39, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x55,
-	0x73, 0x65, 0x72, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79,
-	0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x36,
-	0x0a, 0x16, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x53, 0x41, 0x53,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x16,
-	0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x53, 0x41, 0x53, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x45, 0x64, 0x32,
-	0x35, 0x35, 0x31, 0x39, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x11, 0x55, 0x73, 0x65, 0x72, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x50, 0x75, 0x62,
-	0x4b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x17, 0x55, 0x44, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x64,
-	0x32, 0x35, 0x35, 0x31, 0x39, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x17, 0x55, 0x44, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x64, 0x32,
-	0x35, 0x35, 0x31, 0x39, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x33, 0x0a,
-	0x19, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x22, 0x84, 0x01, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x53, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x52, 0x65, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x50, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x15, 0x52, 0x65, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x50, 0x65, 0x6d, 0x22, 0xce, 0x02, 0x0a, 0x13, 0x55, 0x44,
-	0x42, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x36, 0x0a, 0x16, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x69,
-	0x6e, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x16, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67,
-	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x53, 0x41,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x65, 0x6d, 0x12, 0x1c, 0x0a,
-	0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x49, 0x0a, 0x14, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x52, 0x14, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x11, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x12, 0x32, 0x0a, 0x03, 0x66, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x03, 0x66, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x49, 0x44, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x55, 0x49, 0x44, 0x22, 0x56, 0x0a, 0x08, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x68, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x64, 0x68, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x12,
-	0x0a, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x53, 0x61,
-	0x6c, 0x74, 0x22, 0x68, 0x0a, 0x13, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x55, 0x49, 0x44, 0x12, 0x25, 0x0a, 0x04, 0x46,
-	0x61, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x52, 0x04, 0x46, 0x61,
-	0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x46, 0x61, 0x63, 0x74, 0x53, 0x69, 0x67, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x07, 0x46, 0x61, 0x63, 0x74, 0x53, 0x69, 0x67, 0x22, 0x36, 0x0a, 0x04,
-	0x46, 0x61, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x46, 0x61, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x61, 0x63, 0x74,
-	0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x46, 0x61, 0x63, 0x74,
-	0x54, 0x79, 0x70, 0x65, 0x22, 0x3e, 0x0a, 0x14, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x44, 0x22, 0x50, 0x0a, 0x12, 0x46, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x75, 0x0a, 0x12, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
-	0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x55, 0x49, 0x44, 0x12, 0x33,
-	0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x52, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x44,
-	0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x46, 0x61, 0x63, 0x74, 0x53, 0x69, 0x67, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x46, 0x61, 0x63, 0x74, 0x53, 0x69, 0x67, 0x22, 0x26, 0x0a,
-	0x0a, 0x53, 0x74, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xf4, 0x03, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x44, 0x12,
-	0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69,
-	0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53,
-	0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x18,
-	0x05, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12,
-	0x1e, 0x0a, 0x0a, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x18, 0x06, 0x20,
-	0x03, 0x28, 0x04, 0x52, 0x0a, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x12,
-	0x2f, 0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f,
-	0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73,
-	0x12, 0x3c, 0x0a, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73,
-	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x52, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x3e,
-	0x0a, 0x1a, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x1a, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x51, 0x75, 0x65, 0x75,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x34,
-	0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x53, 0x41,
-	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x70, 0x61, 0x63, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x53, 0x69, 0x7a, 0x65,
-	0x12, 0x3a, 0x0a, 0x0c, 0x45, 0x63, 0x63, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x45, 0x43, 0x43, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x0c,
-	0x45, 0x63, 0x63, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x80, 0x01, 0x0a,
-	0x0a, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x4e,
-	0x6f, 0x64, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x4e, 0x6f, 0x64,
-	0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x34, 0x0a, 0x09, 0x53, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x53, 0x41, 0x53, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22,
-	0x16, 0x0a, 0x14, 0x45, 0x41, 0x42, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3f, 0x0a, 0x15, 0x45, 0x41, 0x42, 0x43, 0x72,
-	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x4b, 0x65, 0x79, 0x22, 0x85, 0x01, 0x0a, 0x15, 0x41, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x47, 0x77, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x47, 0x77, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x22, 0x96, 0x01, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x41,
-	0x75, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x53,
-	0x61, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x12,
-	0x1c, 0x0a, 0x09, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x50, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x09, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x50, 0x65, 0x6d, 0x12, 0x1c, 0x0a,
-	0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x73,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x6d, 0x0a, 0x17, 0x52, 0x73, 0x41,
-	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x22, 0x0a, 0x0c, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x48, 0x61, 0x73, 0x68,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x22, 0x4e, 0x0a, 0x18, 0x52, 0x73, 0x41, 0x75,
-	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x78,
-	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x45,
-	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x39, 0x0a, 0x0d, 0x52, 0x73, 0x52, 0x65,
-	0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74,
-	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a,
-	0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x22, 0x2a, 0x0a, 0x12, 0x52, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x57, 0x72, 0x69,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
-	0x24, 0x0a, 0x0e, 0x52, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0x4e, 0x0a, 0x0e, 0x52, 0x73, 0x57, 0x72, 0x69, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x44,
-	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12,
-	0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x27, 0x0a, 0x11, 0x52, 0x73, 0x52, 0x65, 0x61, 0x64, 0x44,
-	0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61,
-	0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0x33,
-	0x0a, 0x13, 0x52, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x32, 0x96, 0x0a, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x3c, 0x0a, 0x09,
-	0x41, 0x73, 0x6b, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
-	0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1e, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
-	0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x3a, 0x0a,
-	0x12, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x6e, 0x6d, 0x69, 0x78, 0x65, 0x64, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x12, 0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x28, 0x01, 0x12, 0x36, 0x0a, 0x0e, 0x46, 0x69, 0x6e,
-	0x69, 0x73, 0x68, 0x52, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x11, 0x2e, 0x6d, 0x69,
-	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x1a, 0x0d,
-	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x28,
-	0x01, 0x12, 0x38, 0x0a, 0x10, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x54, 0x65, 0x73, 0x74,
-	0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x28, 0x01, 0x12, 0x3c, 0x0a, 0x09, 0x50,
-	0x6f, 0x73, 0x74, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0f, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x11, 0x2e, 0x6d,
-	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x1a,
-	0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00,
-	0x28, 0x01, 0x12, 0x54, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x75,
-	0x66, 0x66, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x1c, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x75, 0x66, 0x66,
-	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
-	0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x1e, 0x2e, 0x6d,
-	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65,
-	0x64, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44,
-	0x0a, 0x11, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41,
-	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41,
-	0x63, 0x6b, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x73, 0x75,
-	0x72, 0x65, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75,
-	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x1a, 0x19, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x22, 0x00, 0x12,
-	0x49, 0x0a, 0x04, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x12, 0x44, 0x6f,
-	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x69, 0x78, 0x65, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68,
-	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x1a, 0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53,
-	0x6c, 0x6f, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x44, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x69, 0x70, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
-	0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x3d, 0x0a,
-	0x0a, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1e, 0x2e, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x17,
-	0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x1a, 0x17, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x22, 0x00, 0x12, 0x42, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65,
-	0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0f, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50,
-	0x68, 0x61, 0x73, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
-	0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x53, 0x68,
-	0x61, 0x72, 0x65, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x2e, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x32, 0xc9, 0x07, 0x0a,
-	0x07, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x59, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x23, 0x2e, 0x6d,
-	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65,
-	0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x15, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x6f, 0x64, 0x65,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x6d,
-	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65,
-	0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a,
-	0x0a, 0x50, 0x75, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x2e, 0x6d, 0x69,
-	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x53, 0x6c, 0x6f, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0f, 0x50, 0x75, 0x74,
-	0x4d, 0x61, 0x6e, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x6d,
-	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77,
-	0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x1a, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0f, 0x50,
-	0x75, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x1e,
-	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
-	0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x20,
-	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x5a, 0x0a, 0x14, 0x50, 0x75, 0x74, 0x4d, 0x61, 0x6e, 0x79, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
-	0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x20, 0x2e, 0x6d, 0x69, 0x78,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e,
-	0x0a, 0x04, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x6c, 0x6c,
-	0x1a, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x00, 0x30, 0x01, 0x12, 0x61,
-	0x0a, 0x17, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69,
-	0x63, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x1d, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63,
-	0x61, 0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x1a, 0x25, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61,
-	0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x4f, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x1a, 0x20,
-	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x5e, 0x0a, 0x14, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x6d, 0x69, 0x78,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x1a, 0x25, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x22, 0x00, 0x12, 0x54, 0x0a, 0x0e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x6c, 0x73,
-	0x43, 0x65, 0x72, 0x74, 0x12, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x43, 0x65, 0x72, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x65, 0x72, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x22, 0x00, 0x32, 0x78, 0x0a, 0x0f, 0x43, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x72, 0x12, 0x65, 0x0a, 0x0c, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x6d, 0x69,
-	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x32, 0x2e, 0x6d,
-	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65,
-	0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x22, 0x00, 0x32, 0xb4, 0x02, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4e,
-	0x6f, 0x64, 0x65, 0x12, 0x1d, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63,
-	0x6b, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x07, 0x50, 0x6f, 0x6c, 0x6c, 0x4e, 0x64, 0x66, 0x12, 0x14,
-	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46,
-	0x48, 0x61, 0x73, 0x68, 0x1a, 0x10, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x04, 0x50, 0x6f, 0x6c, 0x6c,
-	0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68,
-	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x1a, 0x23, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50,
-	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x6d,
-	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x1a, 0x27,
-	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x32, 0xbc, 0x04, 0x0a, 0x0f, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x74, 0x12, 0x59, 0x0a,
-	0x1a, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x2e, 0x6d, 0x69,
-	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x18, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d,
-	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12,
-	0x4b, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1e, 0x2e, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0d,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x2e,
-	0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22,
-	0x00, 0x12, 0x47, 0x0a, 0x0f, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x11, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x44, 0x12,
-	0x25, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x13, 0x55, 0x6e, 0x72, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x44, 0x12, 0x27,
-	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x72,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x32, 0x9d, 0x04, 0x0a, 0x03, 0x55, 0x44, 0x42,
-	0x12, 0x41, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72,
-	0x12, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x55,
-	0x44, 0x42, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63,
-	0x6b, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x12, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63,
-	0x6b, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46,
-	0x61, 0x63, 0x74, 0x12, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0b, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x46, 0x61, 0x63, 0x74, 0x12, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0a, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x61, 0x63, 0x74, 0x12, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x13, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61,
-	0x73, 0x65, 0x12, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x10, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x2e,
-	0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x32, 0xed, 0x01, 0x0a, 0x0a, 0x41, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x41, 0x75, 0x74,
-	0x68, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b,
-	0x22, 0x00, 0x12, 0x42, 0x0a, 0x0b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x65, 0x72,
-	0x74, 0x12, 0x22, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x15, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x45, 0x41, 0x42, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12,
-	0x21, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x45, 0x41,
-	0x42, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x45, 0x41, 0x42, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xc3, 0x03, 0x0a, 0x0a, 0x52, 0x65, 0x6d,
-	0x6f, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x54, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
-	0x12, 0x24, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52,
-	0x73, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a,
-	0x04, 0x52, 0x65, 0x61, 0x64, 0x12, 0x1a, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x52, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33,
-	0x0a, 0x05, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x41, 0x63, 0x6b, 0x12, 0x4f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x6f,
-	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x1a, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x52, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x57,
-	0x72, 0x69, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x52, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x07, 0x52, 0x65, 0x61, 0x64, 0x44,
-	0x69, 0x72, 0x12, 0x1a, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x52, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
-	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x52,
-	0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x26,
-	0x5a, 0x24, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x6c, 0x69,
-	0x78, 0x78, 0x69, 0x72, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x73, 0x2f, 0x6d, 0x69, 0x78, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-var (
-	file_mixmessages_proto_rawDescOnce sync.Once
-	file_mixmessages_proto_rawDescData = file_mixmessages_proto_rawDesc
-)
+func (x *StrAddress) Reset() {
+	*x = StrAddress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_mixmessages_proto_rawDescGZIP() []byte {
-	file_mixmessages_proto_rawDescOnce.Do(func() {
-		file_mixmessages_proto_rawDescData = protoimpl.X.CompressGZIP(file_mixmessages_proto_rawDescData)
-	})
+func (x *StrAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StrAddress) ProtoMessage() {}
+
+func (x *StrAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StrAddress.ProtoReflect.Descriptor instead.
+func (*StrAddress) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *StrAddress) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// Describes a round
+type RoundInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID                         uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	UpdateID                   uint64                 `protobuf:"varint,2,opt,name=UpdateID,proto3" json:"UpdateID,omitempty"`
+	State                      uint32                 `protobuf:"varint,3,opt,name=State,proto3" json:"State,omitempty"` // Precomp, Standby, Realtime, Completed, Failed
+	BatchSize                  uint32                 `protobuf:"varint,4,opt,name=BatchSize,proto3" json:"BatchSize,omitempty"`
+	Topology                   [][]byte               `protobuf:"bytes,5,rep,name=Topology,proto3" json:"Topology,omitempty"`             // List of NodeIDs
+	Timestamps                 []uint64               `protobuf:"varint,6,rep,packed,name=Timestamps,proto3" json:"Timestamps,omitempty"` // List of state timestamps in UTC
+	Errors                     []*RoundError          `protobuf:"bytes,7,rep,name=Errors,proto3" json:"Errors,omitempty"`                 // List of round errors which occurred
+	ClientErrors               []*ClientError         `protobuf:"bytes,8,rep,name=ClientErrors,proto3" json:"ClientErrors,omitempty"`
+	ResourceQueueTimeoutMillis uint32                 `protobuf:"varint,9,opt,name=ResourceQueueTimeoutMillis,proto3" json:"ResourceQueueTimeoutMillis,omitempty"` // Timeout for resource queue on nodes
+	Signature                  *messages.RSASignature `protobuf:"bytes,10,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	AddressSpaceSize           uint32                 `protobuf:"varint,11,opt,name=AddressSpaceSize,proto3" json:"AddressSpaceSize,omitempty"`
+	EccSignature               *messages.ECCSignature `protobuf:"bytes,12,opt,name=EccSignature,proto3" json:"EccSignature,omitempty"`
+}
+
+func (x *RoundInfo) Reset() {
+	*x = RoundInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoundInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoundInfo) ProtoMessage() {}
+
+func (x *RoundInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoundInfo.ProtoReflect.Descriptor instead.
+func (*RoundInfo) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *RoundInfo) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *RoundInfo) GetUpdateID() uint64 {
+	if x != nil {
+		return x.UpdateID
+	}
+	return 0
+}
+
+func (x *RoundInfo) GetState() uint32 {
+	if x != nil {
+		return x.State
+	}
+	return 0
+}
+
+func (x *RoundInfo) GetBatchSize() uint32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *RoundInfo) GetTopology() [][]byte {
+	if x != nil {
+		return x.Topology
+	}
+	return nil
+}
+
+func (x *RoundInfo) GetTimestamps() []uint64 {
+	if x != nil {
+		return x.Timestamps
+	}
+	return nil
+}
+
+func (x *RoundInfo) GetErrors() []*RoundError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *RoundInfo) GetClientErrors() []*ClientError {
+	if x != nil {
+		return x.ClientErrors
+	}
+	return nil
+}
+
+func (x *RoundInfo) GetResourceQueueTimeoutMillis() uint32 {
+	if x != nil {
+		return x.ResourceQueueTimeoutMillis
+	}
+	return 0
+}
+
+func (x *RoundInfo) GetSignature() *messages.RSASignature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *RoundInfo) GetAddressSpaceSize() uint32 {
+	if x != nil {
+		return x.AddressSpaceSize
+	}
+	return 0
+}
+
+func (x *RoundInfo) GetEccSignature() *messages.ECCSignature {
+	if x != nil {
+		return x.EccSignature
+	}
+	return nil
+}
+
+// RoundError will be used to describe a round error
+// Passed along to gateways (level 2) and clients (level 3)
+type RoundError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        uint64                 `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	NodeId    []byte                 `protobuf:"bytes,2,opt,name=NodeId,proto3" json:"NodeId,omitempty"`
+	Error     string                 `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+	Signature *messages.RSASignature `protobuf:"bytes,4,opt,name=Signature,proto3" json:"Signature,omitempty"`
+}
+
+func (x *RoundError) Reset() {
+	*x = RoundError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoundError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoundError) ProtoMessage() {}
+
+func (x *RoundError) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoundError.ProtoReflect.Descriptor instead.
+func (*RoundError) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *RoundError) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RoundError) GetNodeId() []byte {
+	if x != nil {
+		return x.NodeId
+	}
+	return nil
+}
+
+func (x *RoundError) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *RoundError) GetSignature() *messages.RSASignature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type EABCredentialRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EABCredentialRequest) Reset() {
+	*x = EABCredentialRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EABCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EABCredentialRequest) ProtoMessage() {}
+
+func (x *EABCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EABCredentialRequest.ProtoReflect.Descriptor instead.
+func (*EABCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{101}
+}
+
+type EABCredentialResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyId string `protobuf:"bytes,1,opt,name=KeyId,proto3" json:"KeyId,omitempty"`
+	Key   string `protobuf:"bytes,2,opt,name=Key,proto3" json:"Key,omitempty"`
+}
+
+func (x *EABCredentialResponse) Reset() {
+	*x = EABCredentialResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EABCredentialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EABCredentialResponse) ProtoMessage() {}
+
+func (x *EABCredentialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EABCredentialResponse.ProtoReflect.Descriptor instead.
+func (*EABCredentialResponse) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *EABCredentialResponse) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *EABCredentialResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type AuthorizerCertRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GwID      []byte `protobuf:"bytes,1,opt,name=GwID,proto3" json:"GwID,omitempty"`
+	Timestamp int64  `protobuf:"varint,2,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	ACMEToken string `protobuf:"bytes,3,opt,name=ACMEToken,proto3" json:"ACMEToken,omitempty"`
+	Signature []byte `protobuf:"bytes,4,opt,name=Signature,proto3" json:"Signature,omitempty"`
+}
+
+func (x *AuthorizerCertRequest) Reset() {
+	*x = AuthorizerCertRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizerCertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizerCertRequest) ProtoMessage() {}
+
+func (x *AuthorizerCertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizerCertRequest.ProtoReflect.Descriptor instead.
+func (*AuthorizerCertRequest) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *AuthorizerCertRequest) GetGwID() []byte {
+	if x != nil {
+		return x.GwID
+	}
+	return nil
+}
+
+func (x *AuthorizerCertRequest) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *AuthorizerCertRequest) GetACMEToken() string {
+	if x != nil {
+		return x.ACMEToken
+	}
+	return ""
+}
+
+func (x *AuthorizerCertRequest) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type AuthorizerAuth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeID    []byte `protobuf:"bytes,1,opt,name=NodeID,proto3" json:"NodeID,omitempty"`
+	Salt      []byte `protobuf:"bytes,2,opt,name=Salt,proto3" json:"Salt,omitempty"`
+	PubkeyPem []byte `protobuf:"bytes,3,opt,name=PubkeyPem,proto3" json:"PubkeyPem,omitempty"`
+	TimeStamp int64  `protobuf:"varint,4,opt,name=TimeStamp,proto3" json:"TimeStamp,omitempty"`
+	Signature []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *AuthorizerAuth) Reset() {
+	*x = AuthorizerAuth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizerAuth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizerAuth) ProtoMessage() {}
+
+func (x *AuthorizerAuth) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizerAuth.ProtoReflect.Descriptor instead.
+func (*AuthorizerAuth) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *AuthorizerAuth) GetNodeID() []byte {
+	if x != nil {
+		return x.NodeID
+	}
+	return nil
+}
+
+func (x *AuthorizerAuth) GetSalt() []byte {
+	if x != nil {
+		return x.Salt
+	}
+	return nil
+}
+
+func (x *AuthorizerAuth) GetPubkeyPem() []byte {
+	if x != nil {
+		return x.PubkeyPem
+	}
+	return nil
+}
+
+func (x *AuthorizerAuth) GetTimeStamp() int64 {
+	if x != nil {
+		return x.TimeStamp
+	}
+	return 0
+}
+
+func (x *AuthorizerAuth) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type RsAuthenticationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username     string `protobuf:"bytes,1,opt,name=Username,proto3" json:"Username,omitempty"`
+	PasswordHash []byte `protobuf:"bytes,2,opt,name=PasswordHash,proto3" json:"PasswordHash,omitempty"`
+	Salt         []byte `protobuf:"bytes,3,opt,name=Salt,proto3" json:"Salt,omitempty"`
+}
+
+func (x *RsAuthenticationRequest) Reset() {
+	*x = RsAuthenticationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RsAuthenticationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsAuthenticationRequest) ProtoMessage() {}
+
+func (x *RsAuthenticationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsAuthenticationRequest.ProtoReflect.Descriptor instead.
+func (*RsAuthenticationRequest) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *RsAuthenticationRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RsAuthenticationRequest) GetPasswordHash() []byte {
+	if x != nil {
+		return x.PasswordHash
+	}
+	return nil
+}
+
+func (x *RsAuthenticationRequest) GetSalt() []byte {
+	if x != nil {
+		return x.Salt
+	}
+	return nil
+}
+
+type RsAuthenticationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token     []byte `protobuf:"bytes,1,opt,name=Token,proto3" json:"Token,omitempty"`
+	ExpiresAt int64  `protobuf:"varint,2,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
+}
+
+func (x *RsAuthenticationResponse) Reset() {
+	*x = RsAuthenticationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RsAuthenticationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsAuthenticationResponse) ProtoMessage() {}
+
+func (x *RsAuthenticationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsAuthenticationResponse.ProtoReflect.Descriptor instead.
+func (*RsAuthenticationResponse) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *RsAuthenticationResponse) GetToken() []byte {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *RsAuthenticationResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type RsReadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path  string `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
+	Token []byte `protobuf:"bytes,2,opt,name=Token,proto3" json:"Token,omitempty"`
+}
+
+func (x *RsReadRequest) Reset() {
+	*x = RsReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsReadRequest) ProtoMessage() {}
+
+func (x *RsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsReadRequest.ProtoReflect.Descriptor instead.
+func (*RsReadRequest) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *RsReadRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RsReadRequest) GetToken() []byte {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+type RsLastWriteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token []byte `protobuf:"bytes,1,opt,name=Token,proto3" json:"Token,omitempty"`
+}
+
+func (x *RsLastWriteRequest) Reset() {
+	*x = RsLastWriteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RsLastWriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsLastWriteRequest) ProtoMessage() {}
+
+func (x *RsLastWriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsLastWriteRequest.ProtoReflect.Descriptor instead.
+func (*RsLastWriteRequest) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *RsLastWriteRequest) GetToken() []byte {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+type RsReadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=Data,proto3" json:"Data,omitempty"`
+}
+
+func (x *RsReadResponse) Reset() {
+	*x = RsReadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RsReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsReadResponse) ProtoMessage() {}
+
+func (x *RsReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsReadResponse.ProtoReflect.Descriptor instead.
+func (*RsReadResponse) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *RsReadResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type RsWriteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path  string `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
+	Data  []byte `protobuf:"bytes,2,opt,name=Data,proto3" json:"Data,omitempty"`
+	Token []byte `protobuf:"bytes,3,opt,name=Token,proto3" json:"Token,omitempty"`
+}
+
+func (x *RsWriteRequest) Reset() {
+	*x = RsWriteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RsWriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsWriteRequest) ProtoMessage() {}
+
+func (x *RsWriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsWriteRequest.ProtoReflect.Descriptor instead.
+func (*RsWriteRequest) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *RsWriteRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RsWriteRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *RsWriteRequest) GetToken() []byte {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+type RsReadDirResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []string `protobuf:"bytes,1,rep,name=Data,proto3" json:"Data,omitempty"`
+}
+
+func (x *RsReadDirResponse) Reset() {
+	*x = RsReadDirResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RsReadDirResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsReadDirResponse) ProtoMessage() {}
+
+func (x *RsReadDirResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsReadDirResponse.ProtoReflect.Descriptor instead.
+func (*RsReadDirResponse) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *RsReadDirResponse) GetData() []string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type RsTimestampResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp int64 `protobuf:"varint,1,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+}
+
+func (x *RsTimestampResponse) Reset() {
+	*x = RsTimestampResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RsTimestampResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsTimestampResponse) ProtoMessage() {}
+
+func (x *RsTimestampResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsTimestampResponse.ProtoReflect.Descriptor instead.
+func (*RsTimestampResponse) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *RsTimestampResponse) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type RsRenameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OldPath string `protobuf:"bytes,1,opt,name=OldPath,proto3" json:"OldPath,omitempty"`
+	NewPath string `protobuf:"bytes,2,opt,name=NewPath,proto3" json:"NewPath,omitempty"`
+	Token   []byte `protobuf:"bytes,3,opt,name=Token,proto3" json:"Token,omitempty"`
+}
+
+func (x *RsRenameRequest) Reset() {
+	*x = RsRenameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RsRenameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsRenameRequest) ProtoMessage() {}
+
+func (x *RsRenameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsRenameRequest.ProtoReflect.Descriptor instead.
+func (*RsRenameRequest) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *RsRenameRequest) GetOldPath() string {
+	if x != nil {
+		return x.OldPath
+	}
+	return ""
+}
+
+func (x *RsRenameRequest) GetNewPath() string {
+	if x != nil {
+		return x.NewPath
+	}
+	return ""
+}
+
+func (x *RsRenameRequest) GetToken() []byte {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+type RsStatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Size         int64 `protobuf:"varint,1,opt,name=Size,proto3" json:"Size,omitempty"`
+	IsDir        bool  `protobuf:"varint,2,opt,name=IsDir,proto3" json:"IsDir,omitempty"`
+	LastModified int64 `protobuf:"varint,3,opt,name=LastModified,proto3" json:"LastModified,omitempty"`
+}
+
+func (x *RsStatResponse) Reset() {
+	*x = RsStatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RsStatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsStatResponse) ProtoMessage() {}
+
+func (x *RsStatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsStatResponse.ProtoReflect.Descriptor instead.
+func (*RsStatResponse) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *RsStatResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *RsStatResponse) GetIsDir() bool {
+	if x != nil {
+		return x.IsDir
+	}
+	return false
+}
+
+func (x *RsStatResponse) GetLastModified() int64 {
+	if x != nil {
+		return x.LastModified
+	}
+	return 0
+}
+
+// RsConditionalWriteRequest writes the data to the path only if its last
+// modified timestamp is ExpectedLastModified, or if the path does not exist
+// when ExpectedLastModified is zero.
+type RsConditionalWriteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path                 string `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
+	Data                 []byte `protobuf:"bytes,2,opt,name=Data,proto3" json:"Data,omitempty"`
+	Token                []byte `protobuf:"bytes,3,opt,name=Token,proto3" json:"Token,omitempty"`
+	ExpectedLastModified int64  `protobuf:"varint,4,opt,name=ExpectedLastModified,proto3" json:"ExpectedLastModified,omitempty"`
+}
+
+func (x *RsConditionalWriteRequest) Reset() {
+	*x = RsConditionalWriteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RsConditionalWriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsConditionalWriteRequest) ProtoMessage() {}
+
+func (x *RsConditionalWriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsConditionalWriteRequest.ProtoReflect.Descriptor instead.
+func (*RsConditionalWriteRequest) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *RsConditionalWriteRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RsConditionalWriteRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *RsConditionalWriteRequest) GetToken() []byte {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *RsConditionalWriteRequest) GetExpectedLastModified() int64 {
+	if x != nil {
+		return x.ExpectedLastModified
+	}
+	return 0
+}
+
+// RsReadStreamRequest reads Length bytes of the path from Offset, or to the end
+// of the path if Length is zero.
+type RsReadStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path   string `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
+	Token  []byte `protobuf:"bytes,2,opt,name=Token,proto3" json:"Token,omitempty"`
+	Offset int64  `protobuf:"varint,3,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	Length int64  `protobuf:"varint,4,opt,name=Length,proto3" json:"Length,omitempty"`
+}
+
+func (x *RsReadStreamRequest) Reset() {
+	*x = RsReadStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RsReadStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsReadStreamRequest) ProtoMessage() {}
+
+func (x *RsReadStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsReadStreamRequest.ProtoReflect.Descriptor instead.
+func (*RsReadStreamRequest) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *RsReadStreamRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RsReadStreamRequest) GetToken() []byte {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *RsReadStreamRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *RsReadStreamRequest) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+// RsChunk is a chunk of a path starting at Offset. Hash is the SHA-256 hash of
+// Data.
+type RsChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset int64  `protobuf:"varint,1,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	Data   []byte `protobuf:"bytes,2,opt,name=Data,proto3" json:"Data,omitempty"`
+	Hash   []byte `protobuf:"bytes,3,opt,name=Hash,proto3" json:"Hash,omitempty"`
+}
+
+func (x *RsChunk) Reset() {
+	*x = RsChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RsChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsChunk) ProtoMessage() {}
+
+func (x *RsChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsChunk.ProtoReflect.Descriptor instead.
+func (*RsChunk) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *RsChunk) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *RsChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *RsChunk) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+// RsWriteChunk is a chunk of an upload to the path starting at Offset, which
+// must be the number of bytes of the upload already stored. An upload starting
+// at zero replaces any unfinished upload to the path. The upload is written to
+// the path once a chunk with Final set is stored. Hash is the SHA-256 hash of
+// Data.
+type RsWriteChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path   string `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
+	Token  []byte `protobuf:"bytes,2,opt,name=Token,proto3" json:"Token,omitempty"`
+	Offset int64  `protobuf:"varint,3,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	Data   []byte `protobuf:"bytes,4,opt,name=Data,proto3" json:"Data,omitempty"`
+	Hash   []byte `protobuf:"bytes,5,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Final  bool   `protobuf:"varint,6,opt,name=Final,proto3" json:"Final,omitempty"`
+}
+
+func (x *RsWriteChunk) Reset() {
+	*x = RsWriteChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RsWriteChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsWriteChunk) ProtoMessage() {}
+
+func (x *RsWriteChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsWriteChunk.ProtoReflect.Descriptor instead.
+func (*RsWriteChunk) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *RsWriteChunk) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RsWriteChunk) GetToken() []byte {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *RsWriteChunk) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *RsWriteChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *RsWriteChunk) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *RsWriteChunk) GetFinal() bool {
+	if x != nil {
+		return x.Final
+	}
+	return false
+}
+
+// RsUploadOffset is the number of bytes stored of the unfinished upload to a
+// path.
+type RsUploadOffset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset int64 `protobuf:"varint,1,opt,name=Offset,proto3" json:"Offset,omitempty"`
+}
+
+func (x *RsUploadOffset) Reset() {
+	*x = RsUploadOffset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RsUploadOffset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsUploadOffset) ProtoMessage() {}
+
+func (x *RsUploadOffset) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsUploadOffset.ProtoReflect.Descriptor instead.
+func (*RsUploadOffset) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *RsUploadOffset) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// RsWatchRequest streams the changes to paths under Prefix with a sequence
+// number after AfterSequence. If AfterSequence is zero, the stream starts with
+// a started event holding the current sequence number.
+type RsWatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefix        string `protobuf:"bytes,1,opt,name=Prefix,proto3" json:"Prefix,omitempty"`
+	Token         []byte `protobuf:"bytes,2,opt,name=Token,proto3" json:"Token,omitempty"`
+	AfterSequence uint64 `protobuf:"varint,3,opt,name=AfterSequence,proto3" json:"AfterSequence,omitempty"`
+}
+
+func (x *RsWatchRequest) Reset() {
+	*x = RsWatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RsWatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsWatchRequest) ProtoMessage() {}
+
+func (x *RsWatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsWatchRequest.ProtoReflect.Descriptor instead.
+func (*RsWatchRequest) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *RsWatchRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *RsWatchRequest) GetToken() []byte {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *RsWatchRequest) GetAfterSequence() uint64 {
+	if x != nil {
+		return x.AfterSequence
+	}
+	return 0
+}
+
+type RsChangeEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sequence  uint64 `protobuf:"varint,1,opt,name=Sequence,proto3" json:"Sequence,omitempty"`
+	Type      uint32 `protobuf:"varint,2,opt,name=Type,proto3" json:"Type,omitempty"` // Written, Deleted, Renamed, Started
+	Path      string `protobuf:"bytes,3,opt,name=Path,proto3" json:"Path,omitempty"`
+	NewPath   string `protobuf:"bytes,4,opt,name=NewPath,proto3" json:"NewPath,omitempty"` // Renamed only
+	Timestamp int64  `protobuf:"varint,5,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+}
+
+func (x *RsChangeEvent) Reset() {
+	*x = RsChangeEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RsChangeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsChangeEvent) ProtoMessage() {}
+
+func (x *RsChangeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsChangeEvent.ProtoReflect.Descriptor instead.
+func (*RsChangeEvent) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *RsChangeEvent) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *RsChangeEvent) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *RsChangeEvent) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RsChangeEvent) GetNewPath() string {
+	if x != nil {
+		return x.NewPath
+	}
+	return ""
+}
+
+func (x *RsChangeEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+var File_mixmessages_proto protoreflect.FileDescriptor
+
+var file_mixmessages_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x1a, 0x33, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x78, 0x78, 0x5f,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x73, 0x2f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xa5, 0x02, 0x0a, 0x10, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x12, 0x73, 0x0a, 0x1e, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x1e,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a,
+	0x0a, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x34, 0x0a, 0x15, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x26, 0x0a, 0x0e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x44, 0x48, 0x50, 0x75, 0x62, 0x4b,
+	0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x44, 0x48, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x22, 0xeb, 0x01, 0x0a, 0x1b, 0x53, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x10, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x54, 0x0a, 0x19, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x52, 0x53, 0x41, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52,
+	0x19, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x55, 0x73, 0x65, 0x53, 0x48, 0x41, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x55, 0x73, 0x65, 0x53, 0x48, 0x41, 0x22, 0xca, 0x01, 0x0a, 0x16, 0x53, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2a, 0x0a, 0x10, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x54, 0x0a,
+	0x19, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x53, 0x41, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x19, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55,
+	0x73, 0x65, 0x53, 0x48, 0x41, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x55, 0x73, 0x65,
+	0x53, 0x48, 0x41, 0x22, 0xd5, 0x01, 0x0a, 0x11, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x45, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x16, 0x45, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x48,
+	0x4d, 0x41, 0x43, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x16, 0x45, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x48, 0x4d, 0x41,
+	0x43, 0x12, 0x22, 0x0a, 0x0c, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x48, 0x50, 0x75, 0x62, 0x4b, 0x65,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x48, 0x50,
+	0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x44, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x58, 0x0a, 0x16, 0x53,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4b,
+	0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x53, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x4b, 0x65, 0x79, 0x73, 0x22, 0xcf, 0x01, 0x0a, 0x11, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0b, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a,
+	0x1a, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x42, 0x79, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x53, 0x41,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x1a, 0x4b, 0x65, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x79, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x2a, 0x0a, 0x10, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x47,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x10, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x49, 0x0a, 0x11, 0x50, 0x6f, 0x73, 0x74, 0x50,
+	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x4e, 0x75, 0x6d, 0x53, 0x6c, 0x6f,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x4e, 0x75, 0x6d, 0x53, 0x6c, 0x6f,
+	0x74, 0x73, 0x22, 0x3b, 0x0a, 0x0f, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x75, 0x66, 0x66, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x28, 0x0a, 0x0f, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x75,
+	0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x22,
+	0x50, 0x0a, 0x0e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x12, 0x2c, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x4b, 0x65,
+	0x79, 0x22, 0x38, 0x0a, 0x0c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x12, 0x28, 0x0a, 0x0f, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x7c, 0x0a, 0x05, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x2c, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x68, 0x61, 0x73, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x68, 0x61, 0x73, 0x65,
+	0x12, 0x27, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x6c,
+	0x6f, 0x74, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x22, 0x53, 0x0a, 0x0e, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x27, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x22, 0x75,
+	0x0a, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2c, 0x0a, 0x05, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69, 0x78,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x46, 0x72, 0x6f,
+	0x6d, 0x50, 0x68, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x46, 0x72,
+	0x6f, 0x6d, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x6f, 0x0a, 0x0f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x6c, 0x6f, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x53, 0x6c, 0x6f,
+	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x53,
+	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x43, 0x68, 0x75, 0x6e, 0x6b,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x48, 0x61, 0x73,
+	0x68, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x43, 0x68, 0x75, 0x6e, 0x6b,
+	0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x6d, 0x0a, 0x0d, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54,
+	0x72, 0x69, 0x70, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x07,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x2c, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0xec, 0x01, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x28, 0x0a, 0x04, 0x46, 0x75, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x4e, 0x44, 0x46, 0x48, 0x61, 0x73, 0x68, 0x52, 0x04, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x2e,
+	0x0a, 0x07, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44,
+	0x46, 0x48, 0x61, 0x73, 0x68, 0x52, 0x07, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1e,
+	0x0a, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x22, 0xe9, 0x03, 0x0a, 0x12, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50,
+	0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x07, 0x46,
+	0x75, 0x6c, 0x6c, 0x4e, 0x44, 0x46, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d,
+	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46, 0x52, 0x07,
+	0x46, 0x75, 0x6c, 0x6c, 0x4e, 0x44, 0x46, 0x12, 0x30, 0x0a, 0x0a, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x61, 0x6c, 0x4e, 0x44, 0x46, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x69,
+	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46, 0x52, 0x0a, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x4e, 0x44, 0x46, 0x12, 0x30, 0x0a, 0x07, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69, 0x78,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0c, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x61, 0x64, 0x79, 0x52,
+	0x05, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x30, 0x0a, 0x13, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65,
+	0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x13, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x32, 0x0a, 0x14, 0x45, 0x61, 0x72, 0x6c,
+	0x69, 0x65, 0x73, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x36, 0x0a, 0x16,
+	0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x45, 0x61,
+	0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x2a, 0x0a, 0x10, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72, 0x72,
+	0x22, 0x46, 0x0a, 0x0a, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x96, 0x01, 0x0a, 0x0a, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x50, 0x69, 0x65, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x69, 0x65, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x50, 0x69, 0x65, 0x63, 0x65, 0x12, 0x22, 0x0a,
+	0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x34, 0x0a, 0x09, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x53, 0x41, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x22, 0x14, 0x0a, 0x12, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x43, 0x65, 0x72, 0x74, 0x22, 0x54, 0x0a, 0x12, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x23, 0x0a,
+	0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05,
+	0x44, 0x61, 0x74, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x44, 0x61, 0x74,
+	0x75, 0x6d, 0x22, 0x2a, 0x0a, 0x10, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x22, 0x4a,
+	0x0a, 0x18, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69, 0x78,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x06, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x22, 0x62, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x34,
+	0x0a, 0x08, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x08, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x6e,
+	0x0a, 0x18, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x3a, 0x0a, 0x07, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69,
+	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x5b,
+	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x60, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x61, 0x73, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x48, 0x61, 0x73, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x58, 0x0a,
+	0x0d, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x08, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x69, 0x78,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x08, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x0c, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x12, 0x30, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x52, 0x05, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x69,
+	0x67, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x44, 0x69, 0x67,
+	0x65, 0x73, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x2b, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x12, 0x18, 0x0a,
+	0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x22, 0x1a, 0x0a, 0x06, 0x49, 0x44, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03,
+	0x49, 0x44, 0x73, 0x22, 0x84, 0x04, 0x0a, 0x04, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x34, 0x0a, 0x15, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x50,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x15, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x41, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x34, 0x0a, 0x15, 0x45, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x4b, 0x65, 0x79,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x65, 0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x3c,
+	0x0a, 0x19, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x41, 0x43, 0x79, 0x70, 0x68, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x19, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x41, 0x43, 0x79, 0x70, 0x68, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74, 0x12, 0x3c, 0x0a, 0x19,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x43,
+	0x79, 0x70, 0x68, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x19, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42,
+	0x43, 0x79, 0x70, 0x68, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74, 0x12, 0x40, 0x0a, 0x1b, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x43, 0x79, 0x70, 0x68, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x1b, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x43, 0x79, 0x70, 0x68, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08,
+	0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x41, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x41, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42,
+	0x12, 0x12, 0x0a, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x53, 0x61, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4b, 0x4d, 0x41, 0x43, 0x73, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x05, 0x4b, 0x4d, 0x41, 0x43, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x64,
+	0x32, 0x35, 0x35, 0x31, 0x39, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x45, 0x64, 0x32,
+	0x35, 0x35, 0x31, 0x39, 0x12, 0x24, 0x0a, 0x0d, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61,
+	0x6c, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x08, 0x52, 0x0d, 0x45, 0x70, 0x68,
+	0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x22, 0xd9, 0x02, 0x0a, 0x0b, 0x47,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x2e, 0x0a, 0x07, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x69,
+	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46, 0x48, 0x61, 0x73,
+	0x68, 0x52, 0x07, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x4c, 0x61,
+	0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x65,
+	0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0b, 0x52, 0x65, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x45, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x0a, 0x0b, 0x46, 0x61, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x46, 0x61, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
+	0x12, 0x1c, 0x0a, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x26,
+	0x0a, 0x0e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0xe6, 0x02, 0x0a, 0x13, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30,
+	0x0a, 0x0a, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x4e, 0x44, 0x46, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x4e, 0x44, 0x46, 0x52, 0x0a, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x4e, 0x44, 0x46,
+	0x12, 0x30, 0x0a, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x73,
+	0x52, 0x07, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x45, 0x61, 0x72,
+	0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12,
+	0x2a, 0x0a, 0x10, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x45, 0x72, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x45, 0x61, 0x72, 0x6c, 0x69,
+	0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x47,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x22,
+	0x82, 0x01, 0x0a, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x46, 0x69, 0x72, 0x73,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x46, 0x69, 0x72, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x32, 0x0a, 0x07, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x52, 0x07, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x73, 0x22, 0x65, 0x0a, 0x0b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x6c,
+	0x6f, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x46,
+	0x69, 0x72, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x46, 0x69, 0x72, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x0c,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x08,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x22, 0x96, 0x01, 0x0a,
+	0x0b, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x2b, 0x0a, 0x07,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74,
+	0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x41, 0x43, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x03, 0x4d, 0x41, 0x43, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x49,
+	0x70, 0x41, 0x64, 0x64, 0x72, 0x22, 0xe7, 0x01, 0x0a, 0x13, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x09, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x09, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a,
+	0x0a, 0x52, 0x65, 0x74, 0x72, 0x79, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x52, 0x65, 0x74, 0x72, 0x79, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x2a, 0x0a,
+	0x10, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49,
+	0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x58, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x09, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x70, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x03, 0x49, 0x70, 0x73, 0x22, 0x64, 0x0a, 0x0a, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x63, 0x69, 0x70,
+	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x52,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x53,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x53, 0x22,
+	0x98, 0x01, 0x0a, 0x16, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x61,
+	0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x12, 0x24,
+	0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x6c, 0x73,
+	0x43, 0x65, 0x72, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x54,
+	0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x54, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x32, 0x0a, 0x12, 0x4e, 0x6f,
+	0x64, 0x65, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x40,
+	0x0a, 0x1a, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c,
+	0x49, 0x73, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x49, 0x73, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64,
+	0x22, 0x25, 0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x4e, 0x6f,
+	0x64, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x02, 0x49, 0x44, 0x22, 0x1d, 0x0a, 0x07, 0x4e, 0x44, 0x46, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x48, 0x61, 0x73, 0x68, 0x22, 0x4d, 0x0a, 0x03, 0x4e, 0x44, 0x46, 0x12, 0x10, 0x0a,
+	0x03, 0x4e, 0x64, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x4e, 0x64, 0x66, 0x12,
+	0x34, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x53,
+	0x41, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xb0, 0x02, 0x0a, 0x10, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x61,
+	0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x12, 0x24,
+	0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x6c, 0x73,
+	0x43, 0x65, 0x72, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x54, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x54, 0x6c, 0x73, 0x43, 0x65,
+	0x72, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x2a, 0x0a, 0x10,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x94, 0x02, 0x0a, 0x12, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2a, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x40, 0x0a, 0x1b, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x1b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x3a, 0x0a,
+	0x18, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x18, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4e, 0x6f, 0x6e, 0x63,
+	0x65, 0x12, 0x2c, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x53, 0x6f,
+	0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x43, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x1e, 0x0a, 0x1c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x65, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x4e, 0x6f, 0x6e, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x22, 0x60, 0x0a, 0x17, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x12, 0x45, 0x0a, 0x0d, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x2a, 0x53, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x58, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32,
+	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x0d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x5c, 0x0a, 0x1e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x4b, 0x65,
+	0x79, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22,
+	0xb0, 0x01, 0x0a, 0x1e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x1e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1e, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x12, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x52, 0x53, 0x41, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x12,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x22, 0xa1, 0x02, 0x0a, 0x25, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x73, 0x0a, 0x1e,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x1e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x6d, 0x0a, 0x1b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x1b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x29, 0x0a, 0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0xcc, 0x03, 0x0a, 0x11, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x28, 0x0a, 0x04, 0x46, 0x75, 0x6c, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46, 0x48, 0x61, 0x73, 0x68, 0x52, 0x04, 0x46, 0x75, 0x6c,
+	0x6c, 0x12, 0x2e, 0x0a, 0x07, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x4e, 0x44, 0x46, 0x48, 0x61, 0x73, 0x68, 0x52, 0x07, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61,
+	0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x2d, 0x0a,
+	0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d,
+	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0e,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x0c, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31,
+	0x39, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39,
+	0x22, 0x57, 0x0a, 0x0b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x1a, 0x0a, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xf2, 0x02, 0x0a, 0x16, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x46, 0x75, 0x6c, 0x6c, 0x4e, 0x44, 0x46, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46, 0x52, 0x07, 0x46, 0x75, 0x6c, 0x6c, 0x4e, 0x44, 0x46,
+	0x12, 0x30, 0x0a, 0x0a, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x4e, 0x44, 0x46, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46, 0x52, 0x0a, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x4e,
+	0x44, 0x46, 0x12, 0x30, 0x0a, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x13, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x32, 0x0a, 0x14, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65,
+	0x73, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x36, 0x0a, 0x16, 0x45, 0x61,
+	0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x45, 0x61, 0x72, 0x6c,
+	0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x2a, 0x0a, 0x10, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x45, 0x61,
+	0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x22, 0xe6,
+	0x02, 0x0a, 0x14, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x41, 0x70, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x41, 0x70, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x2e, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x73, 0x61, 0x50, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x50, 0x65, 0x6d, 0x12,
+	0x2a, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53,
+	0x61, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x61, 0x6c, 0x74, 0x12, 0x34, 0x0a, 0x15, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x40, 0x0a, 0x1b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x73, 0x61, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x72, 0x53, 0x69, 0x67,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x72,
+	0x53, 0x69, 0x67, 0x12, 0x2a, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x26, 0x0a, 0x0e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xc4, 0x01, 0x0a, 0x16, 0x55, 0x6e, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x41, 0x70, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x41, 0x70, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x50, 0x65, 0x6d,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x50, 0x65, 0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x26, 0x0a, 0x0e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x61,
+	0x0a, 0x1a, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x64, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x07,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0xd7, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43,
+	0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72,
+	0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x40, 0x0a, 0x1b, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x72, 0x53, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1b,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x72, 0x53, 0x69, 0x67, 0x22, 0xce, 0x01, 0x0a, 0x1c,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x72, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x15,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x72, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x15, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79,
+	0x49, 0x44, 0x12, 0x2e, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x73, 0x61, 0x50, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x50,
+	0x65, 0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c,
+	0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x94, 0x01, 0x0a,
+	0x18, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x50, 0x65, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x50, 0x65, 0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x22, 0x96, 0x01, 0x0a, 0x1a, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x73, 0x61, 0x50, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x50,
+	0x65, 0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c,
+	0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x53, 0x0a, 0x11,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x10, 0x0a, 0x03, 0x41, 0x70, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x41, 0x70, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x79, 0x22, 0x65, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x64, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x15, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72,
+	0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x44,
+	0x12, 0x16, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x22, 0x8e, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x36, 0x0a,
+	0x06, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x42, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64,
+	0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49,
+	0x64, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x44, 0x73, 0x22, 0xcd, 0x02, 0x0a, 0x1b, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x26, 0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73,
+	0x61, 0x12, 0x2a, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x53, 0x61, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x61, 0x6c, 0x74, 0x12, 0x2e, 0x0a,
+	0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61,
+	0x53, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x53, 0x69, 0x67, 0x12, 0x34, 0x0a,
+	0x15, 0x49, 0x49, 0x44, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x73, 0x61, 0x53, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x49, 0x49,
+	0x44, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61,
+	0x53, 0x69, 0x67, 0x12, 0x34, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x7d, 0x0a, 0x1d, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79,
+	0x49, 0x64, 0x12, 0x34, 0x0a, 0x15, 0x49, 0x49, 0x44, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x53, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x15, 0x49, 0x49, 0x44, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x73, 0x61, 0x53, 0x69, 0x67, 0x22, 0x1e, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x03, 0x49, 0x44, 0x73, 0x22, 0x72, 0x0a, 0x11, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x43, 0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x6e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x76, 0x0a, 0x10,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x20, 0x0a, 0x0b, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c,
+	0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x50,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x46, 0x50, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x61, 0x73,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x48, 0x61, 0x73, 0x68, 0x22, 0xb1, 0x01, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x45, 0x64, 0x32, 0x35,
+	0x35, 0x31, 0x39, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x11, 0x55, 0x73, 0x65, 0x72, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x50, 0x75, 0x62, 0x4b,
+	0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x36, 0x0a, 0x16, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x53,
+	0x41, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x16, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x53, 0x41, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x14, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x45,
+	0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x11, 0x55, 0x73, 0x65, 0x72, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x50,
+	0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x17, 0x55, 0x44, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x45, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x17, 0x55, 0x44, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x45,
+	0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22,
+	0x8a, 0x01, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x12, 0x3a, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x05, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x22, 0xb4, 0x01, 0x0a,
+	0x16, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x76,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x2c, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x50, 0x75,
+	0x62, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x55, 0x73, 0x65, 0x72,
+	0x45, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a,
+	0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x36, 0x0a, 0x16, 0x55,
+	0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x53, 0x41, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x16, 0x55, 0x73, 0x65,
+	0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x53, 0x41, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x22, 0x33, 0x0a, 0x19, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x84, 0x01, 0x0a, 0x12, 0x55, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1c, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x52, 0x65, 0x63,
+	0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x50,
+	0x65, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x52, 0x65, 0x63, 0x65, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x50, 0x65, 0x6d, 0x22,
+	0xce, 0x02, 0x0a, 0x13, 0x55, 0x44, 0x42, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x16, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x16, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x22, 0x0a, 0x0c, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x65, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x50, 0x65, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x49, 0x0a, 0x14, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x14, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x11,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x32, 0x0a, 0x03, 0x66, 0x72,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x03, 0x66, 0x72, 0x73, 0x12, 0x10,
+	0x0a, 0x03, 0x55, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x55, 0x49, 0x44,
+	0x22, 0x56, 0x0a, 0x08, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08,
+	0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x68, 0x50, 0x75,
+	0x62, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x64, 0x68, 0x50, 0x75,
+	0x62, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x22, 0x68, 0x0a, 0x13, 0x46, 0x61, 0x63, 0x74,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x55, 0x49,
+	0x44, 0x12, 0x25, 0x0a, 0x04, 0x46, 0x61, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61,
+	0x63, 0x74, 0x52, 0x04, 0x46, 0x61, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x46, 0x61, 0x63, 0x74,
+	0x53, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x46, 0x61, 0x63, 0x74, 0x53,
+	0x69, 0x67, 0x22, 0x36, 0x0a, 0x04, 0x46, 0x61, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x61,
+	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x46, 0x61, 0x63, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x46, 0x61, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x46, 0x61, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3e, 0x0a, 0x14, 0x46, 0x61,
+	0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x50, 0x0a, 0x12, 0x46, 0x61,
+	0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x26, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x75, 0x0a, 0x12,
+	0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x03, 0x55, 0x49, 0x44, 0x12, 0x33, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x44,
+	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x52, 0x0b, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x46, 0x61, 0x63,
+	0x74, 0x53, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x46, 0x61, 0x63, 0x74,
+	0x53, 0x69, 0x67, 0x22, 0x51, 0x0a, 0x11, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x3c, 0x0a, 0x08, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x78,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x69, 0x0a, 0x12, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3d, 0x0a, 0x08,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63,
+	0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x56, 0x0a, 0x19, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39,
+	0x0a, 0x07, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61,
+	0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x07, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x4f, 0x0a, 0x10, 0x46, 0x61, 0x63,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x3b, 0x0a,
+	0x08, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61,
+	0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x08, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x29, 0x0a, 0x11, 0x46, 0x61,
+	0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x54, 0x0a, 0x18, 0x46, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x38, 0x0a, 0x07, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x46, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x07, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x5f, 0x0a, 0x0f, 0x46,
+	0x61, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x55, 0x49, 0x44,
+	0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c,
+	0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x55, 0x0a, 0x0e,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x46, 0x61, 0x63, 0x74, 0x12, 0x25,
+	0x0a, 0x04, 0x46, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d,
+	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x52,
+	0x04, 0x46, 0x61, 0x63, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x65, 0x64, 0x22, 0x45, 0x0a, 0x10, 0x46, 0x61, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x46, 0x61, 0x63, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x46,
+	0x61, 0x63, 0x74, 0x52, 0x05, 0x46, 0x61, 0x63, 0x74, 0x73, 0x22, 0x26, 0x0a, 0x0a, 0x53, 0x74,
+	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x22, 0xf4, 0x03, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44,
+	0x12, 0x1a, 0x0a, 0x08, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x08, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12, 0x1e, 0x0a, 0x0a,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x04,
+	0x52, 0x0a, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x12, 0x2f, 0x0a, 0x06,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d,
+	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x3c, 0x0a,
+	0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x0c, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x3e, 0x0a, 0x1a, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x1a, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x53, 0x41, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x2a, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x3a, 0x0a,
+	0x0c, 0x45, 0x63, 0x63, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x45,
+	0x43, 0x43, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x0c, 0x45, 0x63, 0x63,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x80, 0x01, 0x0a, 0x0a, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x6f, 0x64, 0x65,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x34, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x53, 0x41, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x16, 0x0a, 0x14,
+	0x45, 0x41, 0x42, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x3f, 0x0a, 0x15, 0x45, 0x41, 0x42, 0x43, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4b, 0x65,
+	0x79, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x4b, 0x65, 0x79, 0x22, 0x85, 0x01, 0x0a, 0x15, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x65, 0x72, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x47, 0x77, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x47,
+	0x77, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1c, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x96, 0x01,
+	0x0a, 0x0e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68,
+	0x12, 0x16, 0x0a, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x61, 0x6c, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x50, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x50, 0x65, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69,
+	0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54,
+	0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x6d, 0x0a, 0x17, 0x52, 0x73, 0x41, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a,
+	0x0c, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0c, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x53, 0x61, 0x6c, 0x74, 0x22, 0x4e, 0x0a, 0x18, 0x52, 0x73, 0x41, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x39, 0x0a, 0x0d, 0x52, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x2a, 0x0a, 0x12, 0x52, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x24, 0x0a, 0x0e,
+	0x52, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61,
+	0x74, 0x61, 0x22, 0x4e, 0x0a, 0x0e, 0x52, 0x73, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x27, 0x0a, 0x11, 0x52, 0x73, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0x33, 0x0a, 0x13, 0x52,
+	0x73, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x22, 0x5b, 0x0a, 0x0f, 0x52, 0x73, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x6c, 0x64, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x6c, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a,
+	0x07, 0x4e, 0x65, 0x77, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x4e, 0x65, 0x77, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5e, 0x0a,
+	0x0e, 0x52, 0x73, 0x53, 0x74, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x73, 0x44, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x49, 0x73, 0x44, 0x69, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x4c, 0x61, 0x73,
+	0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x22, 0x8d, 0x01,
+	0x0a, 0x19, 0x52, 0x73, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50,
+	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x32, 0x0a, 0x14, 0x45, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x22, 0x6f, 0x0a,
+	0x13, 0x52, 0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x49,
+	0x0a, 0x07, 0x52, 0x73, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x48, 0x61, 0x73, 0x68, 0x22, 0x8e, 0x01, 0x0a, 0x0c, 0x52, 0x73,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14,
+	0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x44, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x12, 0x0a, 0x04, 0x48, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x22, 0x28, 0x0a, 0x0e, 0x52, 0x73,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x22, 0x64, 0x0a, 0x0e, 0x52, 0x73, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x14,
+	0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x41, 0x66, 0x74, 0x65, 0x72, 0x53, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x41, 0x66, 0x74,
+	0x65, 0x72, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x0d, 0x52,
+	0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x50, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x18, 0x0a, 0x07, 0x4e, 0x65, 0x77, 0x50, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x4e, 0x65, 0x77, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2a, 0x91, 0x01, 0x0a, 0x0d, 0x53, 0x6c, 0x6f,
+	0x74, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f,
+	0x54, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a,
+	0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10,
+	0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x41, 0x44, 0x5f, 0x4d, 0x41, 0x43, 0x10, 0x03, 0x12,
+	0x12, 0x0a, 0x0e, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x45,
+	0x52, 0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49,
+	0x54, 0x45, 0x44, 0x10, 0x05, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x52, 0x4f, 0x58, 0x59, 0x5f, 0x55,
+	0x4e, 0x52, 0x45, 0x41, 0x43, 0x48, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x06, 0x32, 0x96, 0x0a, 0x0a,
+	0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x41, 0x73, 0x6b, 0x4f, 0x6e, 0x6c, 0x69,
+	0x6e, 0x65, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63,
+	0x6b, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x65, 0x77,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x12, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x55, 0x6e, 0x6d, 0x69, 0x78, 0x65, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x11, 0x2e, 0x6d,
+	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x1a,
+	0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00,
+	0x28, 0x01, 0x12, 0x36, 0x0a, 0x0e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65, 0x61, 0x6c,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x28, 0x01, 0x12, 0x38, 0x0a, 0x10, 0x50, 0x72,
+	0x65, 0x63, 0x6f, 0x6d, 0x70, 0x54, 0x65, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x11,
+	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f,
+	0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b,
+	0x22, 0x00, 0x28, 0x01, 0x12, 0x3c, 0x0a, 0x09, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x68, 0x61, 0x73,
+	0x65, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b,
+	0x22, 0x00, 0x12, 0x37, 0x0a, 0x0f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6f, 0x73, 0x74,
+	0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x28, 0x01, 0x12, 0x54, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x1a, 0x1c, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22,
+	0x00, 0x12, 0x54, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x1e, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x11, 0x50, 0x6f, 0x73, 0x74, 0x50,
+	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1e, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x49, 0x0a,
+	0x0a, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x12, 0x1e, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x19, 0x2e, 0x6d, 0x69,
+	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x04, 0x50, 0x6f, 0x6c, 0x6c,
+	0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x1a, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x12, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4d,
+	0x69, 0x78, 0x65, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x44, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x69,
+	0x70, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x0e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x69, 0x6e, 0x67,
+	0x1a, 0x17, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53,
+	0x74, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0f, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x1e,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12,
+	0x42, 0x0a, 0x0f, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63,
+	0x6b, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x53, 0x68, 0x61, 0x72, 0x65, 0x46, 0x69, 0x6e, 0x61,
+	0x6c, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x41, 0x63, 0x6b, 0x22, 0x00, 0x32, 0xde, 0x08, 0x0a, 0x07, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x12, 0x59, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x23, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x69, 0x78,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x15,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0a, 0x50, 0x75, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x1a, 0x20,
+	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x50, 0x0a, 0x0f, 0x50, 0x75, 0x74, 0x4d, 0x61, 0x6e, 0x79, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x73,
+	0x1a, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0f, 0x50, 0x75, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x14, 0x50,
+	0x75, 0x74, 0x4d, 0x61, 0x6e, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x50, 0x72,
+	0x6f, 0x78, 0x79, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x1a, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x04, 0x50, 0x6f, 0x6c, 0x6c, 0x12,
+	0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x6c, 0x6c, 0x1a, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x68,
+	0x75, 0x6e, 0x6b, 0x22, 0x00, 0x30, 0x01, 0x12, 0x61, 0x0a, 0x17, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x73, 0x12, 0x1d, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x73, 0x1a, 0x25, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x18, 0x2e,
+	0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x1a, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x14, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x1a, 0x25, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x12, 0x1f, 0x2e,
+	0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x65, 0x72, 0x74, 0x1a, 0x1f,
+	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x22,
+	0x00, 0x12, 0x41, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41,
+	0x63, 0x6b, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x12, 0x1c, 0x2e, 0x6d, 0x69,
+	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x1a, 0x19, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x22, 0x00, 0x32, 0xdf, 0x02, 0x0a, 0x0f, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x72, 0x12, 0x65, 0x0a, 0x0c, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x6d, 0x69, 0x78,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x32, 0x2e, 0x6d, 0x69,
+	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x00, 0x12, 0x6f, 0x0a, 0x1c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x12, 0x29, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6d,
+	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x22, 0x00, 0x12, 0x74, 0x0a, 0x11, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73,
+	0x65, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x24, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x1a, 0x37, 0x2e,
+	0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x22, 0x00, 0x32, 0x83, 0x04, 0x0a, 0x0c, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x0c, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x07, 0x50, 0x6f, 0x6c,
+	0x6c, 0x4e, 0x64, 0x66, 0x12, 0x14, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46, 0x48, 0x61, 0x73, 0x68, 0x1a, 0x10, 0x2e, 0x6d, 0x69, 0x78,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46, 0x22, 0x00, 0x12, 0x40,
+	0x0a, 0x08, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x64, 0x66, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x10, 0x2e, 0x6d, 0x69, 0x78,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x4d, 0x0a, 0x04, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x23, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x60, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x4e, 0x6f, 0x64,
+	0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x1a, 0x27, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x4e,
+	0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x00, 0x12, 0x48, 0x0a, 0x15, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0e, 0x44,
+	0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x32, 0xfa,
+	0x05, 0x0a, 0x0f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x6f, 0x74, 0x12, 0x59, 0x0a, 0x1a, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x46, 0x6f, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x2a, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x55, 0x0a,
+	0x18, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41,
+	0x63, 0x6b, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22,
+	0x00, 0x12, 0x43, 0x0a, 0x0d, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x21, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0f, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12,
+	0x4b, 0x0a, 0x11, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x65, 0x64, 0x49, 0x44, 0x12, 0x25, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x65, 0x64, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x13,
+	0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x64, 0x49, 0x44, 0x12, 0x27, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x64, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x5b, 0x0a,
+	0x11, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x25, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x13, 0x52, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x27, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x69, 0x78,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x32, 0xc4, 0x07, 0x0a, 0x03,
+	0x55, 0x44, 0x42, 0x12, 0x41, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x55, 0x44, 0x42, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x46, 0x61, 0x63, 0x74, 0x12, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a,
+	0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x46, 0x61, 0x63, 0x74, 0x12, 0x1f, 0x2e, 0x6d,
+	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x3e,
+	0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x61, 0x63, 0x74, 0x12, 0x1f, 0x2e, 0x6d,
+	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x5c,
+	0x0a, 0x13, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11,
+	0x52, 0x65, 0x6e, 0x65, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x12, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x6e, 0x65,
+	0x77, 0x61, 0x6c, 0x1a, 0x21, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x12, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x23,
+	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41,
+	0x63, 0x6b, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0d, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46,
+	0x61, 0x63, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x1a, 0x26, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56,
+	0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x46, 0x61, 0x63, 0x74, 0x73, 0x12, 0x1d,
+	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x1a, 0x25, 0x2e,
+	0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x61,
+	0x63, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x46, 0x61, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x32, 0xed, 0x01, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
+	0x72, 0x12, 0x39, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x12, 0x1b,
+	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x1a, 0x0d, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x65, 0x72, 0x74, 0x12, 0x22, 0x2e, 0x6d, 0x69,
+	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x65, 0x72, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00,
+	0x12, 0x60, 0x0a, 0x15, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x41, 0x42, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x21, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x45, 0x41, 0x42, 0x43, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6d,
+	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x45, 0x41, 0x42, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x32, 0xdc, 0x07, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x79, 0x6e,
+	0x63, 0x12, 0x54, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x24, 0x2e, 0x6d, 0x69, 0x78,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x41, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52,
+	0x73, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x04, 0x52, 0x65, 0x61, 0x64, 0x12,
+	0x1a, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73,
+	0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x69,
+	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x52, 0x65, 0x61, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x12, 0x1b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x52, 0x73, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x12, 0x4f, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x12, 0x1a, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52,
+	0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d,
+	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51,
+	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x1f,
+	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x4c,
+	0x61, 0x73, 0x74, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x45, 0x0a, 0x07, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x12, 0x1a, 0x2e, 0x6d,
+	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x52, 0x65, 0x61,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x12, 0x1a, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x52, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x12, 0x35, 0x0a,
+	0x06, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x41, 0x63, 0x6b, 0x12, 0x3f, 0x0a, 0x04, 0x53, 0x74, 0x61, 0x74, 0x12, 0x1a, 0x2e, 0x6d,
+	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x52, 0x65, 0x61,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x53, 0x74, 0x61, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x26, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b,
+	0x12, 0x46, 0x0a, 0x0a, 0x52, 0x65, 0x61, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x20,
+	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x52,
+	0x65, 0x61, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x14, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52,
+	0x73, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x30, 0x01, 0x12, 0x47, 0x0a, 0x0b, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x19, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x68, 0x75,
+	0x6e, 0x6b, 0x1a, 0x1b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x52, 0x73, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x28,
+	0x01, 0x12, 0x4a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x12, 0x1a, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x52, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52,
+	0x73, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x42, 0x0a,
+	0x05, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x52, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30,
+	0x01, 0x42, 0x26, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x65, 0x6c, 0x69, 0x78, 0x78, 0x69, 0x72, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x73, 0x2f, 0x6d, 0x69,
+	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_mixmessages_proto_rawDescOnce sync.Once
+	file_mixmessages_proto_rawDescData = file_mixmessages_proto_rawDesc
+)
+
+func file_mixmessages_proto_rawDescGZIP() []byte {
+	file_mixmessages_proto_rawDescOnce.Do(func() {
+		file_mixmessages_proto_rawDescData = protoimpl.X.CompressGZIP(file_mixmessages_proto_rawDescData)
+	})
 	return file_mixmessages_proto_rawDescData
 }
 
-var file_mixmessages_proto_msgTypes = make([]protoimpl.MessageInfo, 88)
+var file_mixmessages_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_mixmessages_proto_msgTypes = make([]protoimpl.MessageInfo, 122)
 var file_mixmessages_proto_goTypes = []interface{}{
-	(*ClientKeyRequest)(nil),                      // 0: mixmessages.ClientKeyRequest
-	(*SignedClientBatchKeyRequest)(nil),           // 1: mixmessages.SignedClientBatchKeyRequest
-	(*SignedClientKeyRequest)(nil),                // 2: mixmessages.SignedClientKeyRequest
-	(*ClientKeyResponse)(nil),                     // 3: mixmessages.ClientKeyResponse
-	(*SignedBatchKeyResponse)(nil),                // 4: mixmessages.SignedBatchKeyResponse
-	(*SignedKeyResponse)(nil),                     // 5: mixmessages.SignedKeyResponse
-	(*PostPrecompResult)(nil),                     // 6: mixmessages.PostPrecompResult
-	(*RoundBufferInfo)(nil),                       // 7: mixmessages.RoundBufferInfo
-	(*RoundPublicKey)(nil),                        // 8: mixmessages.RoundPublicKey
-	(*RoundMetrics)(nil),                          // 9: mixmessages.RoundMetrics
-	(*Batch)(nil),                                 // 10: mixmessages.Batch
-	(*CompletedBatch)(nil),                        // 11: mixmessages.CompletedBatch
-	(*BatchInfo)(nil),                             // 12: mixmessages.BatchInfo
-	(*RoundTripPing)(nil),                         // 13: mixmessages.RoundTripPing
-	(*ServerPoll)(nil),                            // 14: mixmessages.ServerPoll
-	(*ServerPollResponse)(nil),                    // 15: mixmessages.ServerPollResponse
-	(*BatchReady)(nil),                            // 16: mixmessages.BatchReady
-	(*SharePiece)(nil),                            // 17: mixmessages.SharePiece
-	(*RequestGatewayCert)(nil),                    // 18: mixmessages.RequestGatewayCert
-	(*GatewayCertificate)(nil),                    // 19: mixmessages.GatewayCertificate
-	(*StreamChunk)(nil),                           // 20: mixmessages.StreamChunk
-	(*HistoricalRounds)(nil),                      // 21: mixmessages.HistoricalRounds
-	(*HistoricalRoundsResponse)(nil),              // 22: mixmessages.HistoricalRoundsResponse
-	(*GetMessagesBatch)(nil),                      // 23: mixmessages.GetMessagesBatch
-	(*GetMessagesResponseBatch)(nil),              // 24: mixmessages.GetMessagesResponseBatch
-	(*GetMessages)(nil),                           // 25: mixmessages.GetMessages
-	(*GetMessagesResponse)(nil),                   // 26: mixmessages.GetMessagesResponse
-	(*RoundMessages)(nil),                         // 27: mixmessages.RoundMessages
-	(*IDList)(nil),                                // 28: mixmessages.IDList
-	(*Slot)(nil),                                  // 29: mixmessages.Slot
-	(*GatewayPoll)(nil),                           // 30: mixmessages.GatewayPoll
-	(*GatewayPollResponse)(nil),                   // 31: mixmessages.GatewayPollResponse
-	(*ClientBlooms)(nil),                          // 32: mixmessages.ClientBlooms
-	(*ClientBloom)(nil),                           // 33: mixmessages.ClientBloom
-	(*GatewaySlots)(nil),                          // 34: mixmessages.GatewaySlots
-	(*GatewaySlot)(nil),                           // 35: mixmessages.GatewaySlot
-	(*GatewaySlotResponse)(nil),                   // 36: mixmessages.GatewaySlotResponse
-	(*BatchSenders)(nil),                          // 37: mixmessages.BatchSenders
-	(*Recipients)(nil),                            // 38: mixmessages.Recipients
-	(*RegisteredNodeConfirmation)(nil),            // 39: mixmessages.RegisteredNodeConfirmation
-	(*RegisteredNodeCheck)(nil),                   // 40: mixmessages.RegisteredNodeCheck
-	(*NDFHash)(nil),                               // 41: mixmessages.NDFHash
-	(*NDF)(nil),                                   // 42: mixmessages.NDF
-	(*NodeRegistration)(nil),                      // 43: mixmessages.NodeRegistration
-	(*ClientRegistration)(nil),                    // 44: mixmessages.ClientRegistration
-	(*ClientRegistrationConfirmation)(nil),        // 45: mixmessages.ClientRegistrationConfirmation
-	(*SignedRegistrationConfirmation)(nil),        // 46: mixmessages.SignedRegistrationConfirmation
-	(*SignedClientRegistrationConfirmations)(nil), // 47: mixmessages.SignedClientRegistrationConfirmations
-	(*ClientVersion)(nil),                         // 48: mixmessages.ClientVersion
-	(*PermissioningPoll)(nil),                     // 49: mixmessages.PermissioningPoll
-	(*ClientError)(nil),                           // 50: mixmessages.ClientError
-	(*PermissionPollResponse)(nil),                // 51: mixmessages.PermissionPollResponse
-	(*RegisterTokenRequest)(nil),                  // 52: mixmessages.RegisterTokenRequest
-	(*UnregisterTokenRequest)(nil),                // 53: mixmessages.UnregisterTokenRequest
-	(*UnregisterTrackedIdRequest)(nil),            // 54: mixmessages.UnregisterTrackedIdRequest
-	(*RegisterTrackedIdRequest)(nil),              // 55: mixmessages.RegisterTrackedIdRequest
-	(*TrackedIntermediaryIdRequest)(nil),          // 56: mixmessages.TrackedIntermediaryIdRequest
-	(*NotificationRegisterRequest)(nil),           // 57: mixmessages.NotificationRegisterRequest
-	(*NotificationUnregisterRequest)(nil),         // 58: mixmessages.NotificationUnregisterRequest
-	(*UserIdList)(nil),                            // 59: mixmessages.UserIdList
-	(*NotificationBatch)(nil),                     // 60: mixmessages.NotificationBatch
-	(*NotificationData)(nil),                      // 61: mixmessages.NotificationData
-	(*ChannelLeaseRequest)(nil),                   // 62: mixmessages.ChannelLeaseRequest
-	(*ChannelLeaseResponse)(nil),                  // 63: mixmessages.ChannelLeaseResponse
-	(*UsernameValidationRequest)(nil),             // 64: mixmessages.UsernameValidationRequest
-	(*UsernameValidation)(nil),                    // 65: mixmessages.UsernameValidation
-	(*UDBUserRegistration)(nil),                   // 66: mixmessages.UDBUserRegistration
-	(*Identity)(nil),                              // 67: mixmessages.Identity
-	(*FactRegisterRequest)(nil),                   // 68: mixmessages.FactRegisterRequest
-	(*Fact)(nil),                                  // 69: mixmessages.Fact
-	(*FactRegisterResponse)(nil),                  // 70: mixmessages.FactRegisterResponse
-	(*FactConfirmRequest)(nil),                    // 71: mixmessages.FactConfirmRequest
-	(*FactRemovalRequest)(nil),                    // 72: mixmessages.FactRemovalRequest
-	(*StrAddress)(nil),                            // 73: mixmessages.StrAddress
-	(*RoundInfo)(nil),                             // 74: mixmessages.RoundInfo
-	(*RoundError)(nil),                            // 75: mixmessages.RoundError
-	(*EABCredentialRequest)(nil),                  // 76: mixmessages.EABCredentialRequest
-	(*EABCredentialResponse)(nil),                 // 77: mixmessages.EABCredentialResponse
-	(*AuthorizerCertRequest)(nil),                 // 78: mixmessages.AuthorizerCertRequest
-	(*AuthorizerAuth)(nil),                        // 79: mixmessages.AuthorizerAuth
-	(*RsAuthenticationRequest)(nil),               // 80: mixmessages.RsAuthenticationRequest
-	(*RsAuthenticationResponse)(nil),              // 81: mixmessages.RsAuthenticationResponse
-	(*RsReadRequest)(nil),                         // 82: mixmessages.RsReadRequest
-	(*RsLastWriteRequest)(nil),                    // 83: mixmessages.RsLastWriteRequest
-	(*RsReadResponse)(nil),                        // 84: mixmessages.RsReadResponse
-	(*RsWriteRequest)(nil),                        // 85: mixmessages.RsWriteRequest
-	(*RsReadDirResponse)(nil),                     // 86: mixmessages.RsReadDirResponse
-	(*RsTimestampResponse)(nil),                   // 87: mixmessages.RsTimestampResponse
-	(*messages.RSASignature)(nil),                 // 88: messages.RSASignature
-	(*anypb.Any)(nil),                             // 89: google.protobuf.Any
-	(*messages.ECCSignature)(nil),                 // 90: messages.ECCSignature
-	(*messages.AuthenticatedMessage)(nil),         // 91: messages.AuthenticatedMessage
-	(*messages.Ping)(nil),                         // 92: messages.Ping
-	(*messages.Ack)(nil),                          // 93: messages.Ack
+	(SlotRejection)(0),                                 // 0: mixmessages.SlotRejection
+	(*ClientKeyRequest)(nil),                           // 1: mixmessages.ClientKeyRequest
+	(*SignedClientBatchKeyRequest)(nil),                // 2: mixmessages.SignedClientBatchKeyRequest
+	(*SignedClientKeyRequest)(nil),                     // 3: mixmessages.SignedClientKeyRequest
+	(*ClientKeyResponse)(nil),                          // 4: mixmessages.ClientKeyResponse
+	(*SignedBatchKeyResponse)(nil),                     // 5: mixmessages.SignedBatchKeyResponse
+	(*SignedKeyResponse)(nil),                          // 6: mixmessages.SignedKeyResponse
+	(*PostPrecompResult)(nil),                          // 7: mixmessages.PostPrecompResult
+	(*RoundBufferInfo)(nil),                            // 8: mixmessages.RoundBufferInfo
+	(*RoundPublicKey)(nil),                             // 9: mixmessages.RoundPublicKey
+	(*RoundMetrics)(nil),                               // 10: mixmessages.RoundMetrics
+	(*Batch)(nil),                                      // 11: mixmessages.Batch
+	(*CompletedBatch)(nil),                             // 12: mixmessages.CompletedBatch
+	(*BatchInfo)(nil),                                  // 13: mixmessages.BatchInfo
+	(*BatchCommitment)(nil),                            // 14: mixmessages.BatchCommitment
+	(*RoundTripPing)(nil),                              // 15: mixmessages.RoundTripPing
+	(*ServerPoll)(nil),                                 // 16: mixmessages.ServerPoll
+	(*ServerPollResponse)(nil),                         // 17: mixmessages.ServerPollResponse
+	(*BatchReady)(nil),                                 // 18: mixmessages.BatchReady
+	(*SharePiece)(nil),                                 // 19: mixmessages.SharePiece
+	(*RequestGatewayCert)(nil),                         // 20: mixmessages.RequestGatewayCert
+	(*GatewayCertificate)(nil),                         // 21: mixmessages.GatewayCertificate
+	(*StreamChunk)(nil),                                // 22: mixmessages.StreamChunk
+	(*HistoricalRounds)(nil),                           // 23: mixmessages.HistoricalRounds
+	(*HistoricalRoundsResponse)(nil),                   // 24: mixmessages.HistoricalRoundsResponse
+	(*GetMessagesBatch)(nil),                           // 25: mixmessages.GetMessagesBatch
+	(*GetMessagesResponseBatch)(nil),                   // 26: mixmessages.GetMessagesResponseBatch
+	(*GetMessages)(nil),                                // 27: mixmessages.GetMessages
+	(*GetMessagesResponse)(nil),                        // 28: mixmessages.GetMessagesResponse
+	(*RoundMessages)(nil),                              // 29: mixmessages.RoundMessages
+	(*RoundReplica)(nil),                               // 30: mixmessages.RoundReplica
+	(*GetRoundReplica)(nil),                            // 31: mixmessages.GetRoundReplica
+	(*IDList)(nil),                                     // 32: mixmessages.IDList
+	(*Slot)(nil),                                       // 33: mixmessages.Slot
+	(*GatewayPoll)(nil),                                // 34: mixmessages.GatewayPoll
+	(*GatewayPollResponse)(nil),                        // 35: mixmessages.GatewayPollResponse
+	(*ClientBlooms)(nil),                               // 36: mixmessages.ClientBlooms
+	(*ClientBloom)(nil),                                // 37: mixmessages.ClientBloom
+	(*GatewaySlots)(nil),                               // 38: mixmessages.GatewaySlots
+	(*GatewaySlot)(nil),                                // 39: mixmessages.GatewaySlot
+	(*GatewaySlotResponse)(nil),                        // 40: mixmessages.GatewaySlotResponse
+	(*BatchSenders)(nil),                               // 41: mixmessages.BatchSenders
+	(*Recipients)(nil),                                 // 42: mixmessages.Recipients
+	(*NodeCredentialRotation)(nil),                     // 43: mixmessages.NodeCredentialRotation
+	(*NodeDeregistration)(nil),                         // 44: mixmessages.NodeDeregistration
+	(*RegisteredNodeConfirmation)(nil),                 // 45: mixmessages.RegisteredNodeConfirmation
+	(*RegisteredNodeCheck)(nil),                        // 46: mixmessages.RegisteredNodeCheck
+	(*NDFHash)(nil),                                    // 47: mixmessages.NDFHash
+	(*NDF)(nil),                                        // 48: mixmessages.NDF
+	(*NodeRegistration)(nil),                           // 49: mixmessages.NodeRegistration
+	(*ClientRegistration)(nil),                         // 50: mixmessages.ClientRegistration
+	(*RegistrationChallengeRequest)(nil),               // 51: mixmessages.RegistrationChallengeRequest
+	(*RegistrationChallenge)(nil),                      // 52: mixmessages.RegistrationChallenge
+	(*ClientRegistrationBatch)(nil),                    // 53: mixmessages.ClientRegistrationBatch
+	(*SignedClientRegistrationConfirmationsBatch)(nil), // 54: mixmessages.SignedClientRegistrationConfirmationsBatch
+	(*ClientRegistrationConfirmation)(nil),             // 55: mixmessages.ClientRegistrationConfirmation
+	(*SignedRegistrationConfirmation)(nil),             // 56: mixmessages.SignedRegistrationConfirmation
+	(*SignedClientRegistrationConfirmations)(nil),      // 57: mixmessages.SignedClientRegistrationConfirmations
+	(*ClientVersion)(nil),                              // 58: mixmessages.ClientVersion
+	(*PermissioningPoll)(nil),                          // 59: mixmessages.PermissioningPoll
+	(*ClientError)(nil),                                // 60: mixmessages.ClientError
+	(*PermissionPollResponse)(nil),                     // 61: mixmessages.PermissionPollResponse
+	(*RegisterTokenRequest)(nil),                       // 62: mixmessages.RegisterTokenRequest
+	(*UnregisterTokenRequest)(nil),                     // 63: mixmessages.UnregisterTokenRequest
+	(*UnregisterTrackedIdRequest)(nil),                 // 64: mixmessages.UnregisterTrackedIdRequest
+	(*RegisterTrackedIdRequest)(nil),                   // 65: mixmessages.RegisterTrackedIdRequest
+	(*TrackedIntermediaryIdRequest)(nil),               // 66: mixmessages.TrackedIntermediaryIdRequest
+	(*ListRegistrationsRequest)(nil),                   // 67: mixmessages.ListRegistrationsRequest
+	(*RefreshRegistrationRequest)(nil),                 // 68: mixmessages.RefreshRegistrationRequest
+	(*TokenRegistration)(nil),                          // 69: mixmessages.TokenRegistration
+	(*TrackedIdRegistration)(nil),                      // 70: mixmessages.TrackedIdRegistration
+	(*RegistrationList)(nil),                           // 71: mixmessages.RegistrationList
+	(*NotificationRegisterRequest)(nil),                // 72: mixmessages.NotificationRegisterRequest
+	(*NotificationUnregisterRequest)(nil),              // 73: mixmessages.NotificationUnregisterRequest
+	(*UserIdList)(nil),                                 // 74: mixmessages.UserIdList
+	(*NotificationBatch)(nil),                          // 75: mixmessages.NotificationBatch
+	(*NotificationData)(nil),                           // 76: mixmessages.NotificationData
+	(*ChannelLeaseRequest)(nil),                        // 77: mixmessages.ChannelLeaseRequest
+	(*ChannelLeaseResponse)(nil),                       // 78: mixmessages.ChannelLeaseResponse
+	(*ChannelLeaseRenewal)(nil),                        // 79: mixmessages.ChannelLeaseRenewal
+	(*ChannelLeaseRevocation)(nil),                     // 80: mixmessages.ChannelLeaseRevocation
+	(*UsernameValidationRequest)(nil),                  // 81: mixmessages.UsernameValidationRequest
+	(*UsernameValidation)(nil),                         // 82: mixmessages.UsernameValidation
+	(*UDBUserRegistration)(nil),                        // 83: mixmessages.UDBUserRegistration
+	(*Identity)(nil),                                   // 84: mixmessages.Identity
+	(*FactRegisterRequest)(nil),                        // 85: mixmessages.FactRegisterRequest
+	(*Fact)(nil),                                       // 86: mixmessages.Fact
+	(*FactRegisterResponse)(nil),                       // 87: mixmessages.FactRegisterResponse
+	(*FactConfirmRequest)(nil),                         // 88: mixmessages.FactConfirmRequest
+	(*FactRemovalRequest)(nil),                         // 89: mixmessages.FactRemovalRequest
+	(*FactRegisterBatch)(nil),                          // 90: mixmessages.FactRegisterBatch
+	(*FactRegisterResult)(nil),                         // 91: mixmessages.FactRegisterResult
+	(*FactRegisterBatchResponse)(nil),                  // 92: mixmessages.FactRegisterBatchResponse
+	(*FactConfirmBatch)(nil),                           // 93: mixmessages.FactConfirmBatch
+	(*FactConfirmResult)(nil),                          // 94: mixmessages.FactConfirmResult
+	(*FactConfirmBatchResponse)(nil),                   // 95: mixmessages.FactConfirmBatchResponse
+	(*FactListRequest)(nil),                            // 96: mixmessages.FactListRequest
+	(*RegisteredFact)(nil),                             // 97: mixmessages.RegisteredFact
+	(*FactListResponse)(nil),                           // 98: mixmessages.FactListResponse
+	(*StrAddress)(nil),                                 // 99: mixmessages.StrAddress
+	(*RoundInfo)(nil),                                  // 100: mixmessages.RoundInfo
+	(*RoundError)(nil),                                 // 101: mixmessages.RoundError
+	(*EABCredentialRequest)(nil),                       // 102: mixmessages.EABCredentialRequest
+	(*EABCredentialResponse)(nil),                      // 103: mixmessages.EABCredentialResponse
+	(*AuthorizerCertRequest)(nil),                      // 104: mixmessages.AuthorizerCertRequest
+	(*AuthorizerAuth)(nil),                             // 105: mixmessages.AuthorizerAuth
+	(*RsAuthenticationRequest)(nil),                    // 106: mixmessages.RsAuthenticationRequest
+	(*RsAuthenticationResponse)(nil),                   // 107: mixmessages.RsAuthenticationResponse
+	(*RsReadRequest)(nil),                              // 108: mixmessages.RsReadRequest
+	(*RsLastWriteRequest)(nil),                         // 109: mixmessages.RsLastWriteRequest
+	(*RsReadResponse)(nil),                             // 110: mixmessages.RsReadResponse
+	(*RsWriteRequest)(nil),                             // 111: mixmessages.RsWriteRequest
+	(*RsReadDirResponse)(nil),                          // 112: mixmessages.RsReadDirResponse
+	(*RsTimestampResponse)(nil),                        // 113: mixmessages.RsTimestampResponse
+	(*RsRenameRequest)(nil),                            // 114: mixmessages.RsRenameRequest
+	(*RsStatResponse)(nil),                             // 115: mixmessages.RsStatResponse
+	(*RsConditionalWriteRequest)(nil),                  // 116: mixmessages.RsConditionalWriteRequest
+	(*RsReadStreamRequest)(nil),                        // 117: mixmessages.RsReadStreamRequest
+	(*RsChunk)(nil),                                    // 118: mixmessages.RsChunk
+	(*RsWriteChunk)(nil),                               // 119: mixmessages.RsWriteChunk
+	(*RsUploadOffset)(nil),                             // 120: mixmessages.RsUploadOffset
+	(*RsWatchRequest)(nil),                             // 121: mixmessages.RsWatchRequest
+	(*RsChangeEvent)(nil),                              // 122: mixmessages.RsChangeEvent
+	(*messages.RSASignature)(nil),                      // 123: messages.RSASignature
+	(*anypb.Any)(nil),                                  // 124: google.protobuf.Any
+	(*messages.ECCSignature)(nil),                      // 125: messages.ECCSignature
+	(*messages.AuthenticatedMessage)(nil),              // 126: messages.AuthenticatedMessage
+	(*messages.Ping)(nil),                              // 127: messages.Ping
+	(*messages.Ack)(nil),                               // 128: messages.Ack
 }
 var file_mixmessages_proto_depIdxs = []int32{
-	46,  // 0: mixmessages.ClientKeyRequest.ClientTransmissionConfirmation:type_name -> mixmessages.SignedRegistrationConfirmation
-	88,  // 1: mixmessages.SignedClientBatchKeyRequest.ClientKeyRequestSignature:type_name -> messages.RSASignature
-	88,  // 2: mixmessages.SignedClientKeyRequest.ClientKeyRequestSignature:type_name -> messages.RSASignature
-	5,   // 3: mixmessages.SignedBatchKeyResponse.SignedKeys:type_name -> mixmessages.SignedKeyResponse
-	88,  // 4: mixmessages.SignedKeyResponse.KeyResponseSignedByGateway:type_name -> messages.RSASignature
-	74,  // 5: mixmessages.RoundPublicKey.Round:type_name -> mixmessages.RoundInfo
-	74,  // 6: mixmessages.Batch.Round:type_name -> mixmessages.RoundInfo
-	29,  // 7: mixmessages.Batch.slots:type_name -> mixmessages.Slot
-	29,  // 8: mixmessages.CompletedBatch.slots:type_name -> mixmessages.Slot
-	74,  // 9: mixmessages.BatchInfo.Round:type_name -> mixmessages.RoundInfo
-	89,  // 10: mixmessages.RoundTripPing.Payload:type_name -> google.protobuf.Any
-	74,  // 11: mixmessages.RoundTripPing.Round:type_name -> mixmessages.RoundInfo
-	41,  // 12: mixmessages.ServerPoll.Full:type_name -> mixmessages.NDFHash
-	41,  // 13: mixmessages.ServerPoll.Partial:type_name -> mixmessages.NDFHash
-	42,  // 14: mixmessages.ServerPollResponse.FullNDF:type_name -> mixmessages.NDF
-	42,  // 15: mixmessages.ServerPollResponse.PartialNDF:type_name -> mixmessages.NDF
-	74,  // 16: mixmessages.ServerPollResponse.Updates:type_name -> mixmessages.RoundInfo
-	74,  // 17: mixmessages.ServerPollResponse.BatchRequest:type_name -> mixmessages.RoundInfo
-	16,  // 18: mixmessages.ServerPollResponse.Batch:type_name -> mixmessages.BatchReady
-	88,  // 19: mixmessages.SharePiece.Signature:type_name -> messages.RSASignature
-	74,  // 20: mixmessages.HistoricalRoundsResponse.Rounds:type_name -> mixmessages.RoundInfo
-	25,  // 21: mixmessages.GetMessagesBatch.Requests:type_name -> mixmessages.GetMessages
-	26,  // 22: mixmessages.GetMessagesResponseBatch.Results:type_name -> mixmessages.GetMessagesResponse
-	29,  // 23: mixmessages.GetMessagesResponse.Messages:type_name -> mixmessages.Slot
-	29,  // 24: mixmessages.RoundMessages.Messages:type_name -> mixmessages.Slot
-	41,  // 25: mixmessages.GatewayPoll.Partial:type_name -> mixmessages.NDFHash
-	42,  // 26: mixmessages.GatewayPollResponse.PartialNDF:type_name -> mixmessages.NDF
-	74,  // 27: mixmessages.GatewayPollResponse.Updates:type_name -> mixmessages.RoundInfo
-	32,  // 28: mixmessages.GatewayPollResponse.Filters:type_name -> mixmessages.ClientBlooms
-	33,  // 29: mixmessages.ClientBlooms.Filters:type_name -> mixmessages.ClientBloom
-	35,  // 30: mixmessages.GatewaySlots.Messages:type_name -> mixmessages.GatewaySlot
-	29,  // 31: mixmessages.GatewaySlot.Message:type_name -> mixmessages.Slot
-	88,  // 32: mixmessages.NDF.Signature:type_name -> messages.RSASignature
-	88,  // 33: mixmessages.SignedRegistrationConfirmation.RegistrarSignature:type_name -> messages.RSASignature
-	46,  // 34: mixmessages.SignedClientRegistrationConfirmations.ClientTransmissionConfirmation:type_name -> mixmessages.SignedRegistrationConfirmation
-	46,  // 35: mixmessages.SignedClientRegistrationConfirmations.ClientReceptionConfirmation:type_name -> mixmessages.SignedRegistrationConfirmation
-	41,  // 36: mixmessages.PermissioningPoll.Full:type_name -> mixmessages.NDFHash
-	41,  // 37: mixmessages.PermissioningPoll.Partial:type_name -> mixmessages.NDFHash
-	75,  // 38: mixmessages.PermissioningPoll.Error:type_name -> mixmessages.RoundError
-	50,  // 39: mixmessages.PermissioningPoll.ClientErrors:type_name -> mixmessages.ClientError
-	42,  // 40: mixmessages.PermissionPollResponse.FullNDF:type_name -> mixmessages.NDF
-	42,  // 41: mixmessages.PermissionPollResponse.PartialNDF:type_name -> mixmessages.NDF
-	74,  // 42: mixmessages.PermissionPollResponse.Updates:type_name -> mixmessages.RoundInfo
-	56,  // 43: mixmessages.UnregisterTrackedIdRequest.Request:type_name -> mixmessages.TrackedIntermediaryIdRequest
-	56,  // 44: mixmessages.RegisterTrackedIdRequest.Request:type_name -> mixmessages.TrackedIntermediaryIdRequest
-	61,  // 45: mixmessages.NotificationBatch.notifications:type_name -> mixmessages.NotificationData
-	67,  // 46: mixmessages.UDBUserRegistration.IdentityRegistration:type_name -> mixmessages.Identity
-	68,  // 47: mixmessages.UDBUserRegistration.frs:type_name -> mixmessages.FactRegisterRequest
-	69,  // 48: mixmessages.FactRegisterRequest.Fact:type_name -> mixmessages.Fact
-	69,  // 49: mixmessages.FactRemovalRequest.RemovalData:type_name -> mixmessages.Fact
-	75,  // 50: mixmessages.RoundInfo.Errors:type_name -> mixmessages.RoundError
-	50,  // 51: mixmessages.RoundInfo.ClientErrors:type_name -> mixmessages.ClientError
-	88,  // 52: mixmessages.RoundInfo.Signature:type_name -> messages.RSASignature
-	90,  // 53: mixmessages.RoundInfo.EccSignature:type_name -> messages.ECCSignature
-	88,  // 54: mixmessages.RoundError.Signature:type_name -> messages.RSASignature
-	91,  // 55: mixmessages.Node.AskOnline:input_type -> messages.AuthenticatedMessage
-	91,  // 56: mixmessages.Node.CreateNewRound:input_type -> messages.AuthenticatedMessage
-	29,  // 57: mixmessages.Node.UploadUnmixedBatch:input_type -> mixmessages.Slot
-	29,  // 58: mixmessages.Node.FinishRealtime:input_type -> mixmessages.Slot
-	29,  // 59: mixmessages.Node.PrecompTestBatch:input_type -> mixmessages.Slot
-	91,  // 60: mixmessages.Node.PostPhase:input_type -> messages.AuthenticatedMessage
-	29,  // 61: mixmessages.Node.StreamPostPhase:input_type -> mixmessages.Slot
-	91,  // 62: mixmessages.Node.GetRoundBufferInfo:input_type -> messages.AuthenticatedMessage
-	91,  // 63: mixmessages.Node.RequestClientKey:input_type -> messages.AuthenticatedMessage
-	91,  // 64: mixmessages.Node.PostPrecompResult:input_type -> messages.AuthenticatedMessage
-	91,  // 65: mixmessages.Node.GetMeasure:input_type -> messages.AuthenticatedMessage
-	91,  // 66: mixmessages.Node.Poll:input_type -> messages.AuthenticatedMessage
-	91,  // 67: mixmessages.Node.DownloadMixedBatch:input_type -> messages.AuthenticatedMessage
-	91,  // 68: mixmessages.Node.SendRoundTripPing:input_type -> messages.AuthenticatedMessage
-	91,  // 69: mixmessages.Node.RoundError:input_type -> messages.AuthenticatedMessage
-	92,  // 70: mixmessages.Node.GetPermissioningAddress:input_type -> messages.Ping
-	91,  // 71: mixmessages.Node.StartSharePhase:input_type -> messages.AuthenticatedMessage
-	91,  // 72: mixmessages.Node.SharePhaseRound:input_type -> messages.AuthenticatedMessage
-	91,  // 73: mixmessages.Node.ShareFinalKey:input_type -> messages.AuthenticatedMessage
-	2,   // 74: mixmessages.Gateway.RequestClientKey:input_type -> mixmessages.SignedClientKeyRequest
-	1,   // 75: mixmessages.Gateway.BatchNodeRegistration:input_type -> mixmessages.SignedClientBatchKeyRequest
-	35,  // 76: mixmessages.Gateway.PutMessage:input_type -> mixmessages.GatewaySlot
-	34,  // 77: mixmessages.Gateway.PutManyMessages:input_type -> mixmessages.GatewaySlots
-	91,  // 78: mixmessages.Gateway.PutMessageProxy:input_type -> messages.AuthenticatedMessage
-	91,  // 79: mixmessages.Gateway.PutManyMessagesProxy:input_type -> messages.AuthenticatedMessage
-	30,  // 80: mixmessages.Gateway.Poll:input_type -> mixmessages.GatewayPoll
-	21,  // 81: mixmessages.Gateway.RequestHistoricalRounds:input_type -> mixmessages.HistoricalRounds
-	25,  // 82: mixmessages.Gateway.RequestMessages:input_type -> mixmessages.GetMessages
-	23,  // 83: mixmessages.Gateway.RequestBatchMessages:input_type -> mixmessages.GetMessagesBatch
-	18,  // 84: mixmessages.Gateway.RequestTlsCert:input_type -> mixmessages.RequestGatewayCert
-	44,  // 85: mixmessages.ClientRegistrar.RegisterUser:input_type -> mixmessages.ClientRegistration
-	43,  // 86: mixmessages.Registration.RegisterNode:input_type -> mixmessages.NodeRegistration
-	41,  // 87: mixmessages.Registration.PollNdf:input_type -> mixmessages.NDFHash
-	91,  // 88: mixmessages.Registration.Poll:input_type -> messages.AuthenticatedMessage
-	40,  // 89: mixmessages.Registration.CheckRegistration:input_type -> mixmessages.RegisteredNodeCheck
-	58,  // 90: mixmessages.NotificationBot.UnregisterForNotifications:input_type -> mixmessages.NotificationUnregisterRequest
-	57,  // 91: mixmessages.NotificationBot.RegisterForNotifications:input_type -> mixmessages.NotificationRegisterRequest
-	91,  // 92: mixmessages.NotificationBot.ReceiveNotificationBatch:input_type -> messages.AuthenticatedMessage
-	52,  // 93: mixmessages.NotificationBot.RegisterToken:input_type -> mixmessages.RegisterTokenRequest
-	53,  // 94: mixmessages.NotificationBot.UnregisterToken:input_type -> mixmessages.UnregisterTokenRequest
-	55,  // 95: mixmessages.NotificationBot.RegisterTrackedID:input_type -> mixmessages.RegisterTrackedIdRequest
-	54,  // 96: mixmessages.NotificationBot.UnregisterTrackedID:input_type -> mixmessages.UnregisterTrackedIdRequest
-	66,  // 97: mixmessages.UDB.RegisterUser:input_type -> mixmessages.UDBUserRegistration
-	72,  // 98: mixmessages.UDB.RemoveUser:input_type -> mixmessages.FactRemovalRequest
-	68,  // 99: mixmessages.UDB.RegisterFact:input_type -> mixmessages.FactRegisterRequest
-	71,  // 100: mixmessages.UDB.ConfirmFact:input_type -> mixmessages.FactConfirmRequest
-	72,  // 101: mixmessages.UDB.RemoveFact:input_type -> mixmessages.FactRemovalRequest
-	62,  // 102: mixmessages.UDB.RequestChannelLease:input_type -> mixmessages.ChannelLeaseRequest
-	64,  // 103: mixmessages.UDB.ValidateUsername:input_type -> mixmessages.UsernameValidationRequest
-	79,  // 104: mixmessages.Authorizer.Authorize:input_type -> mixmessages.AuthorizerAuth
-	78,  // 105: mixmessages.Authorizer.RequestCert:input_type -> mixmessages.AuthorizerCertRequest
-	76,  // 106: mixmessages.Authorizer.RequestEABCredentials:input_type -> mixmessages.EABCredentialRequest
-	80,  // 107: mixmessages.RemoteSync.Login:input_type -> mixmessages.RsAuthenticationRequest
-	82,  // 108: mixmessages.RemoteSync.Read:input_type -> mixmessages.RsReadRequest
-	85,  // 109: mixmessages.RemoteSync.Write:input_type -> mixmessages.RsWriteRequest
-	82,  // 110: mixmessages.RemoteSync.GetLastModified:input_type -> mixmessages.RsReadRequest
-	83,  // 111: mixmessages.RemoteSync.GetLastWrite:input_type -> mixmessages.RsLastWriteRequest
-	82,  // 112: mixmessages.RemoteSync.ReadDir:input_type -> mixmessages.RsReadRequest
-	93,  // 113: mixmessages.Node.AskOnline:output_type -> messages.Ack
-	93,  // 114: mixmessages.Node.CreateNewRound:output_type -> messages.Ack
-	93,  // 115: mixmessages.Node.UploadUnmixedBatch:output_type -> messages.Ack
-	93,  // 116: mixmessages.Node.FinishRealtime:output_type -> messages.Ack
-	93,  // 117: mixmessages.Node.PrecompTestBatch:output_type -> messages.Ack
-	93,  // 118: mixmessages.Node.PostPhase:output_type -> messages.Ack
-	93,  // 119: mixmessages.Node.StreamPostPhase:output_type -> messages.Ack
-	7,   // 120: mixmessages.Node.GetRoundBufferInfo:output_type -> mixmessages.RoundBufferInfo
-	5,   // 121: mixmessages.Node.RequestClientKey:output_type -> mixmessages.SignedKeyResponse
-	93,  // 122: mixmessages.Node.PostPrecompResult:output_type -> messages.Ack
-	9,   // 123: mixmessages.Node.GetMeasure:output_type -> mixmessages.RoundMetrics
-	15,  // 124: mixmessages.Node.Poll:output_type -> mixmessages.ServerPollResponse
-	29,  // 125: mixmessages.Node.DownloadMixedBatch:output_type -> mixmessages.Slot
-	93,  // 126: mixmessages.Node.SendRoundTripPing:output_type -> messages.Ack
-	93,  // 127: mixmessages.Node.RoundError:output_type -> messages.Ack
-	73,  // 128: mixmessages.Node.GetPermissioningAddress:output_type -> mixmessages.StrAddress
-	93,  // 129: mixmessages.Node.StartSharePhase:output_type -> messages.Ack
-	93,  // 130: mixmessages.Node.SharePhaseRound:output_type -> messages.Ack
-	93,  // 131: mixmessages.Node.ShareFinalKey:output_type -> messages.Ack
-	5,   // 132: mixmessages.Gateway.RequestClientKey:output_type -> mixmessages.SignedKeyResponse
-	4,   // 133: mixmessages.Gateway.BatchNodeRegistration:output_type -> mixmessages.SignedBatchKeyResponse
-	36,  // 134: mixmessages.Gateway.PutMessage:output_type -> mixmessages.GatewaySlotResponse
-	36,  // 135: mixmessages.Gateway.PutManyMessages:output_type -> mixmessages.GatewaySlotResponse
-	36,  // 136: mixmessages.Gateway.PutMessageProxy:output_type -> mixmessages.GatewaySlotResponse
-	36,  // 137: mixmessages.Gateway.PutManyMessagesProxy:output_type -> mixmessages.GatewaySlotResponse
-	20,  // 138: mixmessages.Gateway.Poll:output_type -> mixmessages.StreamChunk
-	22,  // 139: mixmessages.Gateway.RequestHistoricalRounds:output_type -> mixmessages.HistoricalRoundsResponse
-	26,  // 140: mixmessages.Gateway.RequestMessages:output_type -> mixmessages.GetMessagesResponse
-	24,  // 141: mixmessages.Gateway.RequestBatchMessages:output_type -> mixmessages.GetMessagesResponseBatch
-	19,  // 142: mixmessages.Gateway.RequestTlsCert:output_type -> mixmessages.GatewayCertificate
-	47,  // 143: mixmessages.ClientRegistrar.RegisterUser:output_type -> mixmessages.SignedClientRegistrationConfirmations
-	93,  // 144: mixmessages.Registration.RegisterNode:output_type -> messages.Ack
-	42,  // 145: mixmessages.Registration.PollNdf:output_type -> mixmessages.NDF
-	51,  // 146: mixmessages.Registration.Poll:output_type -> mixmessages.PermissionPollResponse
-	39,  // 147: mixmessages.Registration.CheckRegistration:output_type -> mixmessages.RegisteredNodeConfirmation
-	93,  // 148: mixmessages.NotificationBot.UnregisterForNotifications:output_type -> messages.Ack
-	93,  // 149: mixmessages.NotificationBot.RegisterForNotifications:output_type -> messages.Ack
-	93,  // 150: mixmessages.NotificationBot.ReceiveNotificationBatch:output_type -> messages.Ack
-	93,  // 151: mixmessages.NotificationBot.RegisterToken:output_type -> messages.Ack
-	93,  // 152: mixmessages.NotificationBot.UnregisterToken:output_type -> messages.Ack
-	93,  // 153: mixmessages.NotificationBot.RegisterTrackedID:output_type -> messages.Ack
-	93,  // 154: mixmessages.NotificationBot.UnregisterTrackedID:output_type -> messages.Ack
-	93,  // 155: mixmessages.UDB.RegisterUser:output_type -> messages.Ack
-	93,  // 156: mixmessages.UDB.RemoveUser:output_type -> messages.Ack
-	70,  // 157: mixmessages.UDB.RegisterFact:output_type -> mixmessages.FactRegisterResponse
-	93,  // 158: mixmessages.UDB.ConfirmFact:output_type -> messages.Ack
-	93,  // 159: mixmessages.UDB.RemoveFact:output_type -> messages.Ack
-	63,  // 160: mixmessages.UDB.RequestChannelLease:output_type -> mixmessages.ChannelLeaseResponse
-	65,  // 161: mixmessages.UDB.ValidateUsername:output_type -> mixmessages.UsernameValidation
-	93,  // 162: mixmessages.Authorizer.Authorize:output_type -> messages.Ack
-	93,  // 163: mixmessages.Authorizer.RequestCert:output_type -> messages.Ack
-	77,  // 164: mixmessages.Authorizer.RequestEABCredentials:output_type -> mixmessages.EABCredentialResponse
-	81,  // 165: mixmessages.RemoteSync.Login:output_type -> mixmessages.RsAuthenticationResponse
-	84,  // 166: mixmessages.RemoteSync.Read:output_type -> mixmessages.RsReadResponse
-	93,  // 167: mixmessages.RemoteSync.Write:output_type -> messages.Ack
-	87,  // 168: mixmessages.RemoteSync.GetLastModified:output_type -> mixmessages.RsTimestampResponse
-	87,  // 169: mixmessages.RemoteSync.GetLastWrite:output_type -> mixmessages.RsTimestampResponse
-	86,  // 170: mixmessages.RemoteSync.ReadDir:output_type -> mixmessages.RsReadDirResponse
-	113, // [113:171] is the sub-list for method output_type
-	55,  // [55:113] is the sub-list for method input_type
-	55,  // [55:55] is the sub-list for extension type_name
-	55,  // [55:55] is the sub-list for extension extendee
-	0,   // [0:55] is the sub-list for field type_name
+	56,  // 0: mixmessages.ClientKeyRequest.ClientTransmissionConfirmation:type_name -> mixmessages.SignedRegistrationConfirmation
+	123, // 1: mixmessages.SignedClientBatchKeyRequest.ClientKeyRequestSignature:type_name -> messages.RSASignature
+	123, // 2: mixmessages.SignedClientKeyRequest.ClientKeyRequestSignature:type_name -> messages.RSASignature
+	6,   // 3: mixmessages.SignedBatchKeyResponse.SignedKeys:type_name -> mixmessages.SignedKeyResponse
+	123, // 4: mixmessages.SignedKeyResponse.KeyResponseSignedByGateway:type_name -> messages.RSASignature
+	100, // 5: mixmessages.RoundPublicKey.Round:type_name -> mixmessages.RoundInfo
+	100, // 6: mixmessages.Batch.Round:type_name -> mixmessages.RoundInfo
+	33,  // 7: mixmessages.Batch.slots:type_name -> mixmessages.Slot
+	33,  // 8: mixmessages.CompletedBatch.slots:type_name -> mixmessages.Slot
+	100, // 9: mixmessages.BatchInfo.Round:type_name -> mixmessages.RoundInfo
+	124, // 10: mixmessages.RoundTripPing.Payload:type_name -> google.protobuf.Any
+	100, // 11: mixmessages.RoundTripPing.Round:type_name -> mixmessages.RoundInfo
+	47,  // 12: mixmessages.ServerPoll.Full:type_name -> mixmessages.NDFHash
+	47,  // 13: mixmessages.ServerPoll.Partial:type_name -> mixmessages.NDFHash
+	48,  // 14: mixmessages.ServerPollResponse.FullNDF:type_name -> mixmessages.NDF
+	48,  // 15: mixmessages.ServerPollResponse.PartialNDF:type_name -> mixmessages.NDF
+	100, // 16: mixmessages.ServerPollResponse.Updates:type_name -> mixmessages.RoundInfo
+	100, // 17: mixmessages.ServerPollResponse.BatchRequest:type_name -> mixmessages.RoundInfo
+	18,  // 18: mixmessages.ServerPollResponse.Batch:type_name -> mixmessages.BatchReady
+	123, // 19: mixmessages.SharePiece.Signature:type_name -> messages.RSASignature
+	100, // 20: mixmessages.HistoricalRoundsResponse.Rounds:type_name -> mixmessages.RoundInfo
+	27,  // 21: mixmessages.GetMessagesBatch.Requests:type_name -> mixmessages.GetMessages
+	28,  // 22: mixmessages.GetMessagesResponseBatch.Results:type_name -> mixmessages.GetMessagesResponse
+	33,  // 23: mixmessages.GetMessagesResponse.Messages:type_name -> mixmessages.Slot
+	33,  // 24: mixmessages.RoundMessages.Messages:type_name -> mixmessages.Slot
+	29,  // 25: mixmessages.RoundReplica.Round:type_name -> mixmessages.RoundMessages
+	47,  // 26: mixmessages.GatewayPoll.Partial:type_name -> mixmessages.NDFHash
+	48,  // 27: mixmessages.GatewayPollResponse.PartialNDF:type_name -> mixmessages.NDF
+	100, // 28: mixmessages.GatewayPollResponse.Updates:type_name -> mixmessages.RoundInfo
+	36,  // 29: mixmessages.GatewayPollResponse.Filters:type_name -> mixmessages.ClientBlooms
+	37,  // 30: mixmessages.ClientBlooms.Filters:type_name -> mixmessages.ClientBloom
+	39,  // 31: mixmessages.GatewaySlots.Messages:type_name -> mixmessages.GatewaySlot
+	33,  // 32: mixmessages.GatewaySlot.Message:type_name -> mixmessages.Slot
+	0,   // 33: mixmessages.GatewaySlotResponse.Rejection:type_name -> mixmessages.SlotRejection
+	123, // 34: mixmessages.NDF.Signature:type_name -> messages.RSASignature
+	50,  // 35: mixmessages.ClientRegistrationBatch.Registrations:type_name -> mixmessages.ClientRegistration
+	57,  // 36: mixmessages.SignedClientRegistrationConfirmationsBatch.Confirmations:type_name -> mixmessages.SignedClientRegistrationConfirmations
+	123, // 37: mixmessages.SignedRegistrationConfirmation.RegistrarSignature:type_name -> messages.RSASignature
+	56,  // 38: mixmessages.SignedClientRegistrationConfirmations.ClientTransmissionConfirmation:type_name -> mixmessages.SignedRegistrationConfirmation
+	56,  // 39: mixmessages.SignedClientRegistrationConfirmations.ClientReceptionConfirmation:type_name -> mixmessages.SignedRegistrationConfirmation
+	47,  // 40: mixmessages.PermissioningPoll.Full:type_name -> mixmessages.NDFHash
+	47,  // 41: mixmessages.PermissioningPoll.Partial:type_name -> mixmessages.NDFHash
+	101, // 42: mixmessages.PermissioningPoll.Error:type_name -> mixmessages.RoundError
+	60,  // 43: mixmessages.PermissioningPoll.ClientErrors:type_name -> mixmessages.ClientError
+	48,  // 44: mixmessages.PermissionPollResponse.FullNDF:type_name -> mixmessages.NDF
+	48,  // 45: mixmessages.PermissionPollResponse.PartialNDF:type_name -> mixmessages.NDF
+	100, // 46: mixmessages.PermissionPollResponse.Updates:type_name -> mixmessages.RoundInfo
+	66,  // 47: mixmessages.UnregisterTrackedIdRequest.Request:type_name -> mixmessages.TrackedIntermediaryIdRequest
+	66,  // 48: mixmessages.RegisterTrackedIdRequest.Request:type_name -> mixmessages.TrackedIntermediaryIdRequest
+	69,  // 49: mixmessages.RegistrationList.Tokens:type_name -> mixmessages.TokenRegistration
+	70,  // 50: mixmessages.RegistrationList.TrackedIDs:type_name -> mixmessages.TrackedIdRegistration
+	76,  // 51: mixmessages.NotificationBatch.notifications:type_name -> mixmessages.NotificationData
+	77,  // 52: mixmessages.ChannelLeaseRenewal.Request:type_name -> mixmessages.ChannelLeaseRequest
+	78,  // 53: mixmessages.ChannelLeaseRenewal.Lease:type_name -> mixmessages.ChannelLeaseResponse
+	84,  // 54: mixmessages.UDBUserRegistration.IdentityRegistration:type_name -> mixmessages.Identity
+	85,  // 55: mixmessages.UDBUserRegistration.frs:type_name -> mixmessages.FactRegisterRequest
+	86,  // 56: mixmessages.FactRegisterRequest.Fact:type_name -> mixmessages.Fact
+	86,  // 57: mixmessages.FactRemovalRequest.RemovalData:type_name -> mixmessages.Fact
+	85,  // 58: mixmessages.FactRegisterBatch.Requests:type_name -> mixmessages.FactRegisterRequest
+	87,  // 59: mixmessages.FactRegisterResult.Response:type_name -> mixmessages.FactRegisterResponse
+	91,  // 60: mixmessages.FactRegisterBatchResponse.Results:type_name -> mixmessages.FactRegisterResult
+	88,  // 61: mixmessages.FactConfirmBatch.Requests:type_name -> mixmessages.FactConfirmRequest
+	94,  // 62: mixmessages.FactConfirmBatchResponse.Results:type_name -> mixmessages.FactConfirmResult
+	86,  // 63: mixmessages.RegisteredFact.Fact:type_name -> mixmessages.Fact
+	97,  // 64: mixmessages.FactListResponse.Facts:type_name -> mixmessages.RegisteredFact
+	101, // 65: mixmessages.RoundInfo.Errors:type_name -> mixmessages.RoundError
+	60,  // 66: mixmessages.RoundInfo.ClientErrors:type_name -> mixmessages.ClientError
+	123, // 67: mixmessages.RoundInfo.Signature:type_name -> messages.RSASignature
+	125, // 68: mixmessages.RoundInfo.EccSignature:type_name -> messages.ECCSignature
+	123, // 69: mixmessages.RoundError.Signature:type_name -> messages.RSASignature
+	126, // 70: mixmessages.Node.AskOnline:input_type -> messages.AuthenticatedMessage
+	126, // 71: mixmessages.Node.CreateNewRound:input_type -> messages.AuthenticatedMessage
+	33,  // 72: mixmessages.Node.UploadUnmixedBatch:input_type -> mixmessages.Slot
+	33,  // 73: mixmessages.Node.FinishRealtime:input_type -> mixmessages.Slot
+	33,  // 74: mixmessages.Node.PrecompTestBatch:input_type -> mixmessages.Slot
+	126, // 75: mixmessages.Node.PostPhase:input_type -> messages.AuthenticatedMessage
+	33,  // 76: mixmessages.Node.StreamPostPhase:input_type -> mixmessages.Slot
+	126, // 77: mixmessages.Node.GetRoundBufferInfo:input_type -> messages.AuthenticatedMessage
+	126, // 78: mixmessages.Node.RequestClientKey:input_type -> messages.AuthenticatedMessage
+	126, // 79: mixmessages.Node.PostPrecompResult:input_type -> messages.AuthenticatedMessage
+	126, // 80: mixmessages.Node.GetMeasure:input_type -> messages.AuthenticatedMessage
+	126, // 81: mixmessages.Node.Poll:input_type -> messages.AuthenticatedMessage
+	126, // 82: mixmessages.Node.DownloadMixedBatch:input_type -> messages.AuthenticatedMessage
+	126, // 83: mixmessages.Node.SendRoundTripPing:input_type -> messages.AuthenticatedMessage
+	126, // 84: mixmessages.Node.RoundError:input_type -> messages.AuthenticatedMessage
+	127, // 85: mixmessages.Node.GetPermissioningAddress:input_type -> messages.Ping
+	126, // 86: mixmessages.Node.StartSharePhase:input_type -> messages.AuthenticatedMessage
+	126, // 87: mixmessages.Node.SharePhaseRound:input_type -> messages.AuthenticatedMessage
+	126, // 88: mixmessages.Node.ShareFinalKey:input_type -> messages.AuthenticatedMessage
+	3,   // 89: mixmessages.Gateway.RequestClientKey:input_type -> mixmessages.SignedClientKeyRequest
+	2,   // 90: mixmessages.Gateway.BatchNodeRegistration:input_type -> mixmessages.SignedClientBatchKeyRequest
+	39,  // 91: mixmessages.Gateway.PutMessage:input_type -> mixmessages.GatewaySlot
+	38,  // 92: mixmessages.Gateway.PutManyMessages:input_type -> mixmessages.GatewaySlots
+	126, // 93: mixmessages.Gateway.PutMessageProxy:input_type -> messages.AuthenticatedMessage
+	126, // 94: mixmessages.Gateway.PutManyMessagesProxy:input_type -> messages.AuthenticatedMessage
+	34,  // 95: mixmessages.Gateway.Poll:input_type -> mixmessages.GatewayPoll
+	23,  // 96: mixmessages.Gateway.RequestHistoricalRounds:input_type -> mixmessages.HistoricalRounds
+	27,  // 97: mixmessages.Gateway.RequestMessages:input_type -> mixmessages.GetMessages
+	25,  // 98: mixmessages.Gateway.RequestBatchMessages:input_type -> mixmessages.GetMessagesBatch
+	20,  // 99: mixmessages.Gateway.RequestTlsCert:input_type -> mixmessages.RequestGatewayCert
+	126, // 100: mixmessages.Gateway.ReplicateRound:input_type -> messages.AuthenticatedMessage
+	31,  // 101: mixmessages.Gateway.RequestRoundReplica:input_type -> mixmessages.GetRoundReplica
+	50,  // 102: mixmessages.ClientRegistrar.RegisterUser:input_type -> mixmessages.ClientRegistration
+	51,  // 103: mixmessages.ClientRegistrar.RequestRegistrationChallenge:input_type -> mixmessages.RegistrationChallengeRequest
+	53,  // 104: mixmessages.ClientRegistrar.RegisterUserBatch:input_type -> mixmessages.ClientRegistrationBatch
+	49,  // 105: mixmessages.Registration.RegisterNode:input_type -> mixmessages.NodeRegistration
+	47,  // 106: mixmessages.Registration.PollNdf:input_type -> mixmessages.NDFHash
+	126, // 107: mixmessages.Registration.WatchNdf:input_type -> messages.AuthenticatedMessage
+	126, // 108: mixmessages.Registration.Poll:input_type -> messages.AuthenticatedMessage
+	46,  // 109: mixmessages.Registration.CheckRegistration:input_type -> mixmessages.RegisteredNodeCheck
+	126, // 110: mixmessages.Registration.RotateNodeCredentials:input_type -> messages.AuthenticatedMessage
+	126, // 111: mixmessages.Registration.DeregisterNode:input_type -> messages.AuthenticatedMessage
+	73,  // 112: mixmessages.NotificationBot.UnregisterForNotifications:input_type -> mixmessages.NotificationUnregisterRequest
+	72,  // 113: mixmessages.NotificationBot.RegisterForNotifications:input_type -> mixmessages.NotificationRegisterRequest
+	126, // 114: mixmessages.NotificationBot.ReceiveNotificationBatch:input_type -> messages.AuthenticatedMessage
+	62,  // 115: mixmessages.NotificationBot.RegisterToken:input_type -> mixmessages.RegisterTokenRequest
+	63,  // 116: mixmessages.NotificationBot.UnregisterToken:input_type -> mixmessages.UnregisterTokenRequest
+	65,  // 117: mixmessages.NotificationBot.RegisterTrackedID:input_type -> mixmessages.RegisterTrackedIdRequest
+	64,  // 118: mixmessages.NotificationBot.UnregisterTrackedID:input_type -> mixmessages.UnregisterTrackedIdRequest
+	67,  // 119: mixmessages.NotificationBot.ListRegistrations:input_type -> mixmessages.ListRegistrationsRequest
+	68,  // 120: mixmessages.NotificationBot.RefreshRegistration:input_type -> mixmessages.RefreshRegistrationRequest
+	83,  // 121: mixmessages.UDB.RegisterUser:input_type -> mixmessages.UDBUserRegistration
+	89,  // 122: mixmessages.UDB.RemoveUser:input_type -> mixmessages.FactRemovalRequest
+	85,  // 123: mixmessages.UDB.RegisterFact:input_type -> mixmessages.FactRegisterRequest
+	88,  // 124: mixmessages.UDB.ConfirmFact:input_type -> mixmessages.FactConfirmRequest
+	89,  // 125: mixmessages.UDB.RemoveFact:input_type -> mixmessages.FactRemovalRequest
+	77,  // 126: mixmessages.UDB.RequestChannelLease:input_type -> mixmessages.ChannelLeaseRequest
+	79,  // 127: mixmessages.UDB.RenewChannelLease:input_type -> mixmessages.ChannelLeaseRenewal
+	80,  // 128: mixmessages.UDB.RevokeChannelLease:input_type -> mixmessages.ChannelLeaseRevocation
+	81,  // 129: mixmessages.UDB.ValidateUsername:input_type -> mixmessages.UsernameValidationRequest
+	90,  // 130: mixmessages.UDB.RegisterFacts:input_type -> mixmessages.FactRegisterBatch
+	93,  // 131: mixmessages.UDB.ConfirmFacts:input_type -> mixmessages.FactConfirmBatch
+	96,  // 132: mixmessages.UDB.ListFacts:input_type -> mixmessages.FactListRequest
+	105, // 133: mixmessages.Authorizer.Authorize:input_type -> mixmessages.AuthorizerAuth
+	104, // 134: mixmessages.Authorizer.RequestCert:input_type -> mixmessages.AuthorizerCertRequest
+	102, // 135: mixmessages.Authorizer.RequestEABCredentials:input_type -> mixmessages.EABCredentialRequest
+	106, // 136: mixmessages.RemoteSync.Login:input_type -> mixmessages.RsAuthenticationRequest
+	108, // 137: mixmessages.RemoteSync.Read:input_type -> mixmessages.RsReadRequest
+	111, // 138: mixmessages.RemoteSync.Write:input_type -> mixmessages.RsWriteRequest
+	108, // 139: mixmessages.RemoteSync.GetLastModified:input_type -> mixmessages.RsReadRequest
+	109, // 140: mixmessages.RemoteSync.GetLastWrite:input_type -> mixmessages.RsLastWriteRequest
+	108, // 141: mixmessages.RemoteSync.ReadDir:input_type -> mixmessages.RsReadRequest
+	108, // 142: mixmessages.RemoteSync.Delete:input_type -> mixmessages.RsReadRequest
+	114, // 143: mixmessages.RemoteSync.Rename:input_type -> mixmessages.RsRenameRequest
+	108, // 144: mixmessages.RemoteSync.Stat:input_type -> mixmessages.RsReadRequest
+	116, // 145: mixmessages.RemoteSync.ConditionalWrite:input_type -> mixmessages.RsConditionalWriteRequest
+	117, // 146: mixmessages.RemoteSync.ReadStream:input_type -> mixmessages.RsReadStreamRequest
+	119, // 147: mixmessages.RemoteSync.WriteStream:input_type -> mixmessages.RsWriteChunk
+	108, // 148: mixmessages.RemoteSync.GetUploadOffset:input_type -> mixmessages.RsReadRequest
+	121, // 149: mixmessages.RemoteSync.Watch:input_type -> mixmessages.RsWatchRequest
+	128, // 150: mixmessages.Node.AskOnline:output_type -> messages.Ack
+	128, // 151: mixmessages.Node.CreateNewRound:output_type -> messages.Ack
+	128, // 152: mixmessages.Node.UploadUnmixedBatch:output_type -> messages.Ack
+	128, // 153: mixmessages.Node.FinishRealtime:output_type -> messages.Ack
+	128, // 154: mixmessages.Node.PrecompTestBatch:output_type -> messages.Ack
+	128, // 155: mixmessages.Node.PostPhase:output_type -> messages.Ack
+	128, // 156: mixmessages.Node.StreamPostPhase:output_type -> messages.Ack
+	8,   // 157: mixmessages.Node.GetRoundBufferInfo:output_type -> mixmessages.RoundBufferInfo
+	6,   // 158: mixmessages.Node.RequestClientKey:output_type -> mixmessages.SignedKeyResponse
+	128, // 159: mixmessages.Node.PostPrecompResult:output_type -> messages.Ack
+	10,  // 160: mixmessages.Node.GetMeasure:output_type -> mixmessages.RoundMetrics
+	17,  // 161: mixmessages.Node.Poll:output_type -> mixmessages.ServerPollResponse
+	33,  // 162: mixmessages.Node.DownloadMixedBatch:output_type -> mixmessages.Slot
+	128, // 163: mixmessages.Node.SendRoundTripPing:output_type -> messages.Ack
+	128, // 164: mixmessages.Node.RoundError:output_type -> messages.Ack
+	99,  // 165: mixmessages.Node.GetPermissioningAddress:output_type -> mixmessages.StrAddress
+	128, // 166: mixmessages.Node.StartSharePhase:output_type -> messages.Ack
+	128, // 167: mixmessages.Node.SharePhaseRound:output_type -> messages.Ack
+	128, // 168: mixmessages.Node.ShareFinalKey:output_type -> messages.Ack
+	6,   // 169: mixmessages.Gateway.RequestClientKey:output_type -> mixmessages.SignedKeyResponse
+	5,   // 170: mixmessages.Gateway.BatchNodeRegistration:output_type -> mixmessages.SignedBatchKeyResponse
+	40,  // 171: mixmessages.Gateway.PutMessage:output_type -> mixmessages.GatewaySlotResponse
+	40,  // 172: mixmessages.Gateway.PutManyMessages:output_type -> mixmessages.GatewaySlotResponse
+	40,  // 173: mixmessages.Gateway.PutMessageProxy:output_type -> mixmessages.GatewaySlotResponse
+	40,  // 174: mixmessages.Gateway.PutManyMessagesProxy:output_type -> mixmessages.GatewaySlotResponse
+	22,  // 175: mixmessages.Gateway.Poll:output_type -> mixmessages.StreamChunk
+	24,  // 176: mixmessages.Gateway.RequestHistoricalRounds:output_type -> mixmessages.HistoricalRoundsResponse
+	28,  // 177: mixmessages.Gateway.RequestMessages:output_type -> mixmessages.GetMessagesResponse
+	26,  // 178: mixmessages.Gateway.RequestBatchMessages:output_type -> mixmessages.GetMessagesResponseBatch
+	21,  // 179: mixmessages.Gateway.RequestTlsCert:output_type -> mixmessages.GatewayCertificate
+	128, // 180: mixmessages.Gateway.ReplicateRound:output_type -> messages.Ack
+	30,  // 181: mixmessages.Gateway.RequestRoundReplica:output_type -> mixmessages.RoundReplica
+	57,  // 182: mixmessages.ClientRegistrar.RegisterUser:output_type -> mixmessages.SignedClientRegistrationConfirmations
+	52,  // 183: mixmessages.ClientRegistrar.RequestRegistrationChallenge:output_type -> mixmessages.RegistrationChallenge
+	54,  // 184: mixmessages.ClientRegistrar.RegisterUserBatch:output_type -> mixmessages.SignedClientRegistrationConfirmationsBatch
+	128, // 185: mixmessages.Registration.RegisterNode:output_type -> messages.Ack
+	48,  // 186: mixmessages.Registration.PollNdf:output_type -> mixmessages.NDF
+	48,  // 187: mixmessages.Registration.WatchNdf:output_type -> mixmessages.NDF
+	61,  // 188: mixmessages.Registration.Poll:output_type -> mixmessages.PermissionPollResponse
+	45,  // 189: mixmessages.Registration.CheckRegistration:output_type -> mixmessages.RegisteredNodeConfirmation
+	128, // 190: mixmessages.Registration.RotateNodeCredentials:output_type -> messages.Ack
+	128, // 191: mixmessages.Registration.DeregisterNode:output_type -> messages.Ack
+	128, // 192: mixmessages.NotificationBot.UnregisterForNotifications:output_type -> messages.Ack
+	128, // 193: mixmessages.NotificationBot.RegisterForNotifications:output_type -> messages.Ack
+	128, // 194: mixmessages.NotificationBot.ReceiveNotificationBatch:output_type -> messages.Ack
+	128, // 195: mixmessages.NotificationBot.RegisterToken:output_type -> messages.Ack
+	128, // 196: mixmessages.NotificationBot.UnregisterToken:output_type -> messages.Ack
+	128, // 197: mixmessages.NotificationBot.RegisterTrackedID:output_type -> messages.Ack
+	128, // 198: mixmessages.NotificationBot.UnregisterTrackedID:output_type -> messages.Ack
+	71,  // 199: mixmessages.NotificationBot.ListRegistrations:output_type -> mixmessages.RegistrationList
+	71,  // 200: mixmessages.NotificationBot.RefreshRegistration:output_type -> mixmessages.RegistrationList
+	128, // 201: mixmessages.UDB.RegisterUser:output_type -> messages.Ack
+	128, // 202: mixmessages.UDB.RemoveUser:output_type -> messages.Ack
+	87,  // 203: mixmessages.UDB.RegisterFact:output_type -> mixmessages.FactRegisterResponse
+	128, // 204: mixmessages.UDB.ConfirmFact:output_type -> messages.Ack
+	128, // 205: mixmessages.UDB.RemoveFact:output_type -> messages.Ack
+	78,  // 206: mixmessages.UDB.RequestChannelLease:output_type -> mixmessages.ChannelLeaseResponse
+	78,  // 207: mixmessages.UDB.RenewChannelLease:output_type -> mixmessages.ChannelLeaseResponse
+	128, // 208: mixmessages.UDB.RevokeChannelLease:output_type -> messages.Ack
+	82,  // 209: mixmessages.UDB.ValidateUsername:output_type -> mixmessages.UsernameValidation
+	92,  // 210: mixmessages.UDB.RegisterFacts:output_type -> mixmessages.FactRegisterBatchResponse
+	95,  // 211: mixmessages.UDB.ConfirmFacts:output_type -> mixmessages.FactConfirmBatchResponse
+	98,  // 212: mixmessages.UDB.ListFacts:output_type -> mixmessages.FactListResponse
+	128, // 213: mixmessages.Authorizer.Authorize:output_type -> messages.Ack
+	128, // 214: mixmessages.Authorizer.RequestCert:output_type -> messages.Ack
+	103, // 215: mixmessages.Authorizer.RequestEABCredentials:output_type -> mixmessages.EABCredentialResponse
+	107, // 216: mixmessages.RemoteSync.Login:output_type -> mixmessages.RsAuthenticationResponse
+	110, // 217: mixmessages.RemoteSync.Read:output_type -> mixmessages.RsReadResponse
+	128, // 218: mixmessages.RemoteSync.Write:output_type -> messages.Ack
+	113, // 219: mixmessages.RemoteSync.GetLastModified:output_type -> mixmessages.RsTimestampResponse
+	113, // 220: mixmessages.RemoteSync.GetLastWrite:output_type -> mixmessages.RsTimestampResponse
+	112, // 221: mixmessages.RemoteSync.ReadDir:output_type -> mixmessages.RsReadDirResponse
+	128, // 222: mixmessages.RemoteSync.Delete:output_type -> messages.Ack
+	128, // 223: mixmessages.RemoteSync.Rename:output_type -> messages.Ack
+	115, // 224: mixmessages.RemoteSync.Stat:output_type -> mixmessages.RsStatResponse
+	128, // 225: mixmessages.RemoteSync.ConditionalWrite:output_type -> messages.Ack
+	118, // 226: mixmessages.RemoteSync.ReadStream:output_type -> mixmessages.RsChunk
+	120, // 227: mixmessages.RemoteSync.WriteStream:output_type -> mixmessages.RsUploadOffset
+	120, // 228: mixmessages.RemoteSync.GetUploadOffset:output_type -> mixmessages.RsUploadOffset
+	122, // 229: mixmessages.RemoteSync.Watch:output_type -> mixmessages.RsChangeEvent
+	150, // [150:230] is the sub-list for method output_type
+	70,  // [70:150] is the sub-list for method input_type
+	70,  // [70:70] is the sub-list for extension type_name
+	70,  // [70:70] is the sub-list for extension extendee
+	0,   // [0:70] is the sub-list for field type_name
 }
 
 func init() { file_mixmessages_proto_init() }
@@ -7247,8 +9855,188 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignedClientBatchKeyRequest); i {
+		file_mixmessages_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignedClientBatchKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignedClientKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignedBatchKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignedKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostPrecompResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoundBufferInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoundPublicKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoundMetrics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Batch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompletedBatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCommitment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoundTripPing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerPoll); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerPollResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7259,8 +10047,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignedClientKeyRequest); i {
+		file_mixmessages_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchReady); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7271,8 +10059,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClientKeyResponse); i {
+		file_mixmessages_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SharePiece); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7283,8 +10071,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignedBatchKeyResponse); i {
+		file_mixmessages_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestGatewayCert); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7295,8 +10083,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignedKeyResponse); i {
+		file_mixmessages_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayCertificate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7307,8 +10095,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PostPrecompResult); i {
+		file_mixmessages_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamChunk); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7319,8 +10107,236 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoundBufferInfo); i {
+		file_mixmessages_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HistoricalRounds); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HistoricalRoundsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMessagesBatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMessagesResponseBatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMessages); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMessagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoundMessages); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoundReplica); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRoundReplica); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IDList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Slot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayPoll); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayPollResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientBlooms); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientBloom); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewaySlots); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewaySlot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewaySlotResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchSenders); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Recipients); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7331,8 +10347,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoundPublicKey); i {
+		file_mixmessages_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeCredentialRotation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7343,8 +10359,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoundMetrics); i {
+		file_mixmessages_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeDeregistration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7355,8 +10371,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Batch); i {
+		file_mixmessages_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisteredNodeConfirmation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7367,8 +10383,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CompletedBatch); i {
+		file_mixmessages_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisteredNodeCheck); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7379,8 +10395,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchInfo); i {
+		file_mixmessages_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NDFHash); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7391,8 +10407,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoundTripPing); i {
+		file_mixmessages_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NDF); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7403,8 +10419,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerPoll); i {
+		file_mixmessages_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeRegistration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7415,8 +10431,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerPollResponse); i {
+		file_mixmessages_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientRegistration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7427,8 +10443,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchReady); i {
+		file_mixmessages_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistrationChallengeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7439,8 +10455,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SharePiece); i {
+		file_mixmessages_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistrationChallenge); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7451,8 +10467,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestGatewayCert); i {
+		file_mixmessages_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientRegistrationBatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7463,8 +10479,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GatewayCertificate); i {
+		file_mixmessages_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignedClientRegistrationConfirmationsBatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7475,8 +10491,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamChunk); i {
+		file_mixmessages_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientRegistrationConfirmation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7487,8 +10503,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HistoricalRounds); i {
+		file_mixmessages_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignedRegistrationConfirmation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7499,8 +10515,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HistoricalRoundsResponse); i {
+		file_mixmessages_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignedClientRegistrationConfirmations); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7511,8 +10527,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMessagesBatch); i {
+		file_mixmessages_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientVersion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7523,8 +10539,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMessagesResponseBatch); i {
+		file_mixmessages_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PermissioningPoll); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7535,8 +10551,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMessages); i {
+		file_mixmessages_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7547,8 +10563,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMessagesResponse); i {
+		file_mixmessages_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PermissionPollResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7559,8 +10575,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoundMessages); i {
+		file_mixmessages_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterTokenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7571,8 +10587,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IDList); i {
+		file_mixmessages_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnregisterTokenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7583,8 +10599,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Slot); i {
+		file_mixmessages_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnregisterTrackedIdRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7595,8 +10611,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GatewayPoll); i {
+		file_mixmessages_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterTrackedIdRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7607,8 +10623,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GatewayPollResponse); i {
+		file_mixmessages_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackedIntermediaryIdRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7619,8 +10635,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClientBlooms); i {
+		file_mixmessages_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRegistrationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7631,8 +10647,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClientBloom); i {
+		file_mixmessages_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshRegistrationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7643,8 +10659,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GatewaySlots); i {
+		file_mixmessages_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenRegistration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7655,8 +10671,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GatewaySlot); i {
+		file_mixmessages_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackedIdRegistration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7667,8 +10683,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GatewaySlotResponse); i {
+		file_mixmessages_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistrationList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7679,8 +10695,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchSenders); i {
+		file_mixmessages_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationRegisterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7691,8 +10707,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Recipients); i {
+		file_mixmessages_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationUnregisterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7703,8 +10719,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisteredNodeConfirmation); i {
+		file_mixmessages_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserIdList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7715,8 +10731,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisteredNodeCheck); i {
+		file_mixmessages_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationBatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7727,8 +10743,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NDFHash); i {
+		file_mixmessages_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7739,8 +10755,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NDF); i {
+		file_mixmessages_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelLeaseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7751,8 +10767,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeRegistration); i {
+		file_mixmessages_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelLeaseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7763,8 +10779,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClientRegistration); i {
+		file_mixmessages_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelLeaseRenewal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7775,8 +10791,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClientRegistrationConfirmation); i {
+		file_mixmessages_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelLeaseRevocation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7787,8 +10803,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignedRegistrationConfirmation); i {
+		file_mixmessages_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsernameValidationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7799,8 +10815,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignedClientRegistrationConfirmations); i {
+		file_mixmessages_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsernameValidation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7811,8 +10827,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClientVersion); i {
+		file_mixmessages_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UDBUserRegistration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7823,8 +10839,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PermissioningPoll); i {
+		file_mixmessages_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Identity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7835,8 +10851,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClientError); i {
+		file_mixmessages_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FactRegisterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7847,8 +10863,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PermissionPollResponse); i {
+		file_mixmessages_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Fact); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7859,8 +10875,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterTokenRequest); i {
+		file_mixmessages_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FactRegisterResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7871,8 +10887,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnregisterTokenRequest); i {
+		file_mixmessages_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FactConfirmRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7883,8 +10899,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnregisterTrackedIdRequest); i {
+		file_mixmessages_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FactRemovalRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7895,8 +10911,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterTrackedIdRequest); i {
+		file_mixmessages_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FactRegisterBatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7907,8 +10923,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrackedIntermediaryIdRequest); i {
+		file_mixmessages_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FactRegisterResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7919,8 +10935,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NotificationRegisterRequest); i {
+		file_mixmessages_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FactRegisterBatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7931,8 +10947,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NotificationUnregisterRequest); i {
+		file_mixmessages_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FactConfirmBatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7943,8 +10959,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserIdList); i {
+		file_mixmessages_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FactConfirmResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7955,8 +10971,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NotificationBatch); i {
+		file_mixmessages_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FactConfirmBatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7967,8 +10983,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NotificationData); i {
+		file_mixmessages_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FactListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7979,8 +10995,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelLeaseRequest); i {
+		file_mixmessages_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisteredFact); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7991,8 +11007,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelLeaseResponse); i {
+		file_mixmessages_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FactListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8003,8 +11019,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UsernameValidationRequest); i {
+		file_mixmessages_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StrAddress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8015,8 +11031,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UsernameValidation); i {
+		file_mixmessages_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoundInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8027,8 +11043,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UDBUserRegistration); i {
+		file_mixmessages_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoundError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8039,8 +11055,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Identity); i {
+		file_mixmessages_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EABCredentialRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8051,8 +11067,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FactRegisterRequest); i {
+		file_mixmessages_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EABCredentialResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8063,8 +11079,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Fact); i {
+		file_mixmessages_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizerCertRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8075,8 +11091,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FactRegisterResponse); i {
+		file_mixmessages_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizerAuth); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8087,8 +11103,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FactConfirmRequest); i {
+		file_mixmessages_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RsAuthenticationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8099,8 +11115,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FactRemovalRequest); i {
+		file_mixmessages_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RsAuthenticationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8111,8 +11127,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StrAddress); i {
+		file_mixmessages_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RsReadRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8123,8 +11139,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoundInfo); i {
+		file_mixmessages_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RsLastWriteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8135,8 +11151,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoundError); i {
+		file_mixmessages_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RsReadResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8147,8 +11163,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EABCredentialRequest); i {
+		file_mixmessages_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RsWriteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8159,8 +11175,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EABCredentialResponse); i {
+		file_mixmessages_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RsReadDirResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8171,8 +11187,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthorizerCertRequest); i {
+		file_mixmessages_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RsTimestampResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8183,8 +11199,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthorizerAuth); i {
+		file_mixmessages_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RsRenameRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8195,8 +11211,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RsAuthenticationRequest); i {
+		file_mixmessages_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RsStatResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8207,8 +11223,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RsAuthenticationResponse); i {
+		file_mixmessages_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RsConditionalWriteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8219,8 +11235,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RsReadRequest); i {
+		file_mixmessages_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RsReadStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8231,8 +11247,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RsLastWriteRequest); i {
+		file_mixmessages_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RsChunk); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8243,8 +11259,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RsReadResponse); i {
+		file_mixmessages_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RsWriteChunk); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8255,8 +11271,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RsWriteRequest); i {
+		file_mixmessages_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RsUploadOffset); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8267,8 +11283,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RsReadDirResponse); i {
+		file_mixmessages_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RsWatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8279,8 +11295,8 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
-		file_mixmessages_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RsTimestampResponse); i {
+		file_mixmessages_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RsChangeEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8297,13 +11313,14 @@ func file_mixmessages_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mixmessages_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   88,
+			NumEnums:      1,
+			NumMessages:   122,
 			NumExtensions: 0,
 			NumServices:   8,
 		},
 		GoTypes:           file_mixmessages_proto_goTypes,
 		DependencyIndexes: file_mixmessages_proto_depIdxs,
+		EnumInfos:         file_mixmessages_proto_enumTypes,
 		MessageInfos:      file_mixmessages_proto_msgTypes,
 	}.Build()
 	File_mixmessages_proto = out.File
@@ -988,6 +988,10 @@ type GatewayClient interface {
 	RequestMessages(ctx context.Context, in *GetMessages, opts ...grpc.CallOption) (*GetMessagesResponse, error)
 	RequestBatchMessages(ctx context.Context, in *GetMessagesBatch, opts ...grpc.CallOption) (*GetMessagesResponseBatch, error)
 	RequestTlsCert(ctx context.Context, in *RequestGatewayCert, opts ...grpc.CallOption) (*GatewayCertificate, error)
+	// Gateway -> Gateway push of a round's messages to a replica
+	ReplicateRound(ctx context.Context, in *messages.AuthenticatedMessage, opts ...grpc.CallOption) (*messages.Ack, error)
+	// Gateway -> Gateway request for a replica of a round's messages
+	RequestRoundReplica(ctx context.Context, in *GetRoundReplica, opts ...grpc.CallOption) (*RoundReplica, error)
 }
 
 type gatewayClient struct {
@@ -1120,6 +1124,24 @@ func (c *gatewayClient) RequestTlsCert(ctx context.Context, in *RequestGatewayCe
 	return out, nil
 }
 
+func (c *gatewayClient) ReplicateRound(ctx context.Context, in *messages.AuthenticatedMessage, opts ...grpc.CallOption) (*messages.Ack, error) {
+	out := new(messages.Ack)
+	err := c.cc.Invoke(ctx, "/mixmessages.Gateway/ReplicateRound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) RequestRoundReplica(ctx context.Context, in *GetRoundReplica, opts ...grpc.CallOption) (*RoundReplica, error) {
+	out := new(RoundReplica)
+	err := c.cc.Invoke(ctx, "/mixmessages.Gateway/RequestRoundReplica", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GatewayServer is the server API for Gateway service.
 // All implementations must embed UnimplementedGatewayServer
 // for forward compatibility
@@ -1145,6 +1167,10 @@ type GatewayServer interface {
 	RequestMessages(context.Context, *GetMessages) (*GetMessagesResponse, error)
 	RequestBatchMessages(context.Context, *GetMessagesBatch) (*GetMessagesResponseBatch, error)
 	RequestTlsCert(context.Context, *RequestGatewayCert) (*GatewayCertificate, error)
+	// Gateway -> Gateway push of a round's messages to a replica
+	ReplicateRound(context.Context, *messages.AuthenticatedMessage) (*messages.Ack, error)
+	// Gateway -> Gateway request for a replica of a round's messages
+	RequestRoundReplica(context.Context, *GetRoundReplica) (*RoundReplica, error)
 	mustEmbedUnimplementedGatewayServer()
 }
 
@@ -1185,6 +1211,12 @@ func (UnimplementedGatewayServer) RequestBatchMessages(context.Context, *GetMess
 func (UnimplementedGatewayServer) RequestTlsCert(context.Context, *RequestGatewayCert) (*GatewayCertificate, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestTlsCert not implemented")
 }
+func (UnimplementedGatewayServer) ReplicateRound(context.Context, *messages.AuthenticatedMessage) (*messages.Ack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplicateRound not implemented")
+}
+func (UnimplementedGatewayServer) RequestRoundReplica(context.Context, *GetRoundReplica) (*RoundReplica, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestRoundReplica not implemented")
+}
 func (UnimplementedGatewayServer) mustEmbedUnimplementedGatewayServer() {}
 
 // UnsafeGatewayServer may be embedded to opt out of forward compatibility for this service.
@@ -1399,6 +1431,42 @@ func _Gateway_RequestTlsCert_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Gateway_ReplicateRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(messages.AuthenticatedMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).ReplicateRound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mixmessages.Gateway/ReplicateRound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).ReplicateRound(ctx, req.(*messages.AuthenticatedMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_RequestRoundReplica_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoundReplica)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).RequestRoundReplica(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mixmessages.Gateway/RequestRoundReplica",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).RequestRoundReplica(ctx, req.(*GetRoundReplica))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Gateway_ServiceDesc is the grpc.ServiceDesc for Gateway service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1446,6 +1514,14 @@ var Gateway_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RequestTlsCert",
 			Handler:    _Gateway_RequestTlsCert_Handler,
 		},
+		{
+			MethodName: "ReplicateRound",
+			Handler:    _Gateway_ReplicateRound_Handler,
+		},
+		{
+			MethodName: "RequestRoundReplica",
+			Handler:    _Gateway_RequestRoundReplica_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1463,6 +1539,11 @@ var Gateway_ServiceDesc = grpc.ServiceDesc{
 type ClientRegistrarClient interface {
 	// Client uses this to register its user with the system
 	RegisterUser(ctx context.Context, in *ClientRegistration, opts ...grpc.CallOption) (*SignedClientRegistrationConfirmations, error)
+	// Client uses this to get a proof-of-work challenge to solve before
+	// registering
+	RequestRegistrationChallenge(ctx context.Context, in *RegistrationChallengeRequest, opts ...grpc.CallOption) (*RegistrationChallenge, error)
+	// Registers several users at once, such as a fleet of test clients
+	RegisterUserBatch(ctx context.Context, in *ClientRegistrationBatch, opts ...grpc.CallOption) (*SignedClientRegistrationConfirmationsBatch, error)
 }
 
 type clientRegistrarClient struct {
@@ -1482,12 +1563,35 @@ func (c *clientRegistrarClient) RegisterUser(ctx context.Context, in *ClientRegi
 	return out, nil
 }
 
+func (c *clientRegistrarClient) RequestRegistrationChallenge(ctx context.Context, in *RegistrationChallengeRequest, opts ...grpc.CallOption) (*RegistrationChallenge, error) {
+	out := new(RegistrationChallenge)
+	err := c.cc.Invoke(ctx, "/mixmessages.ClientRegistrar/RequestRegistrationChallenge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientRegistrarClient) RegisterUserBatch(ctx context.Context, in *ClientRegistrationBatch, opts ...grpc.CallOption) (*SignedClientRegistrationConfirmationsBatch, error) {
+	out := new(SignedClientRegistrationConfirmationsBatch)
+	err := c.cc.Invoke(ctx, "/mixmessages.ClientRegistrar/RegisterUserBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ClientRegistrarServer is the server API for ClientRegistrar service.
 // All implementations must embed UnimplementedClientRegistrarServer
 // for forward compatibility
 type ClientRegistrarServer interface {
 	// Client uses this to register its user with the system
 	RegisterUser(context.Context, *ClientRegistration) (*SignedClientRegistrationConfirmations, error)
+	// Client uses this to get a proof-of-work challenge to solve before
+	// registering
+	RequestRegistrationChallenge(context.Context, *RegistrationChallengeRequest) (*RegistrationChallenge, error)
+	// Registers several users at once, such as a fleet of test clients
+	RegisterUserBatch(context.Context, *ClientRegistrationBatch) (*SignedClientRegistrationConfirmationsBatch, error)
 	mustEmbedUnimplementedClientRegistrarServer()
 }
 
@@ -1498,6 +1602,12 @@ type UnimplementedClientRegistrarServer struct {
 func (UnimplementedClientRegistrarServer) RegisterUser(context.Context, *ClientRegistration) (*SignedClientRegistrationConfirmations, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterUser not implemented")
 }
+func (UnimplementedClientRegistrarServer) RequestRegistrationChallenge(context.Context, *RegistrationChallengeRequest) (*RegistrationChallenge, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestRegistrationChallenge not implemented")
+}
+func (UnimplementedClientRegistrarServer) RegisterUserBatch(context.Context, *ClientRegistrationBatch) (*SignedClientRegistrationConfirmationsBatch, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterUserBatch not implemented")
+}
 func (UnimplementedClientRegistrarServer) mustEmbedUnimplementedClientRegistrarServer() {}
 
 // UnsafeClientRegistrarServer may be embedded to opt out of forward compatibility for this service.
@@ -1529,6 +1639,42 @@ func _ClientRegistrar_RegisterUser_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientRegistrar_RequestRegistrationChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegistrationChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientRegistrarServer).RequestRegistrationChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mixmessages.ClientRegistrar/RequestRegistrationChallenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientRegistrarServer).RequestRegistrationChallenge(ctx, req.(*RegistrationChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientRegistrar_RegisterUserBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientRegistrationBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientRegistrarServer).RegisterUserBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mixmessages.ClientRegistrar/RegisterUserBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientRegistrarServer).RegisterUserBatch(ctx, req.(*ClientRegistrationBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ClientRegistrar_ServiceDesc is the grpc.ServiceDesc for ClientRegistrar service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1540,6 +1686,14 @@ var ClientRegistrar_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterUser",
 			Handler:    _ClientRegistrar_RegisterUser_Handler,
 		},
+		{
+			MethodName: "RequestRegistrationChallenge",
+			Handler:    _ClientRegistrar_RequestRegistrationChallenge_Handler,
+		},
+		{
+			MethodName: "RegisterUserBatch",
+			Handler:    _ClientRegistrar_RegisterUserBatch_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "mixmessages.proto",
@@ -1553,10 +1707,17 @@ type RegistrationClient interface {
 	RegisterNode(ctx context.Context, in *NodeRegistration, opts ...grpc.CallOption) (*messages.Ack, error)
 	// Obtain NDF from the Registration Server
 	PollNdf(ctx context.Context, in *NDFHash, opts ...grpc.CallOption) (*NDF, error)
+	// Streams each new NDF from the Registration Server, starting from the
+	// NDFHash of the caller's NDF
+	WatchNdf(ctx context.Context, in *messages.AuthenticatedMessage, opts ...grpc.CallOption) (Registration_WatchNdfClient, error)
 	// Server -> Permissioning unified polling
 	Poll(ctx context.Context, in *messages.AuthenticatedMessage, opts ...grpc.CallOption) (*PermissionPollResponse, error)
 	// Checks if node has been registered
 	CheckRegistration(ctx context.Context, in *RegisteredNodeCheck, opts ...grpc.CallOption) (*RegisteredNodeConfirmation, error)
+	// Replaces the TLS certificates and salt of a registered node
+	RotateNodeCredentials(ctx context.Context, in *messages.AuthenticatedMessage, opts ...grpc.CallOption) (*messages.Ack, error)
+	// Removes a registered node from the network
+	DeregisterNode(ctx context.Context, in *messages.AuthenticatedMessage, opts ...grpc.CallOption) (*messages.Ack, error)
 }
 
 type registrationClient struct {
@@ -1585,6 +1746,38 @@ func (c *registrationClient) PollNdf(ctx context.Context, in *NDFHash, opts ...g
 	return out, nil
 }
 
+func (c *registrationClient) WatchNdf(ctx context.Context, in *messages.AuthenticatedMessage, opts ...grpc.CallOption) (Registration_WatchNdfClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Registration_ServiceDesc.Streams[0], "/mixmessages.Registration/WatchNdf", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &registrationWatchNdfClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Registration_WatchNdfClient interface {
+	Recv() (*NDF, error)
+	grpc.ClientStream
+}
+
+type registrationWatchNdfClient struct {
+	grpc.ClientStream
+}
+
+func (x *registrationWatchNdfClient) Recv() (*NDF, error) {
+	m := new(NDF)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *registrationClient) Poll(ctx context.Context, in *messages.AuthenticatedMessage, opts ...grpc.CallOption) (*PermissionPollResponse, error) {
 	out := new(PermissionPollResponse)
 	err := c.cc.Invoke(ctx, "/mixmessages.Registration/Poll", in, out, opts...)
@@ -1603,6 +1796,24 @@ func (c *registrationClient) CheckRegistration(ctx context.Context, in *Register
 	return out, nil
 }
 
+func (c *registrationClient) RotateNodeCredentials(ctx context.Context, in *messages.AuthenticatedMessage, opts ...grpc.CallOption) (*messages.Ack, error) {
+	out := new(messages.Ack)
+	err := c.cc.Invoke(ctx, "/mixmessages.Registration/RotateNodeCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registrationClient) DeregisterNode(ctx context.Context, in *messages.AuthenticatedMessage, opts ...grpc.CallOption) (*messages.Ack, error) {
+	out := new(messages.Ack)
+	err := c.cc.Invoke(ctx, "/mixmessages.Registration/DeregisterNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RegistrationServer is the server API for Registration service.
 // All implementations must embed UnimplementedRegistrationServer
 // for forward compatibility
@@ -1611,10 +1822,17 @@ type RegistrationServer interface {
 	RegisterNode(context.Context, *NodeRegistration) (*messages.Ack, error)
 	// Obtain NDF from the Registration Server
 	PollNdf(context.Context, *NDFHash) (*NDF, error)
+	// Streams each new NDF from the Registration Server, starting from the
+	// NDFHash of the caller's NDF
+	WatchNdf(*messages.AuthenticatedMessage, Registration_WatchNdfServer) error
 	// Server -> Permissioning unified polling
 	Poll(context.Context, *messages.AuthenticatedMessage) (*PermissionPollResponse, error)
 	// Checks if node has been registered
 	CheckRegistration(context.Context, *RegisteredNodeCheck) (*RegisteredNodeConfirmation, error)
+	// Replaces the TLS certificates and salt of a registered node
+	RotateNodeCredentials(context.Context, *messages.AuthenticatedMessage) (*messages.Ack, error)
+	// Removes a registered node from the network
+	DeregisterNode(context.Context, *messages.AuthenticatedMessage) (*messages.Ack, error)
 	mustEmbedUnimplementedRegistrationServer()
 }
 
@@ -1628,12 +1846,21 @@ func (UnimplementedRegistrationServer) RegisterNode(context.Context, *NodeRegist
 func (UnimplementedRegistrationServer) PollNdf(context.Context, *NDFHash) (*NDF, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PollNdf not implemented")
 }
+func (UnimplementedRegistrationServer) WatchNdf(*messages.AuthenticatedMessage, Registration_WatchNdfServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchNdf not implemented")
+}
 func (UnimplementedRegistrationServer) Poll(context.Context, *messages.AuthenticatedMessage) (*PermissionPollResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Poll not implemented")
 }
 func (UnimplementedRegistrationServer) CheckRegistration(context.Context, *RegisteredNodeCheck) (*RegisteredNodeConfirmation, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckRegistration not implemented")
 }
+func (UnimplementedRegistrationServer) RotateNodeCredentials(context.Context, *messages.AuthenticatedMessage) (*messages.Ack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateNodeCredentials not implemented")
+}
+func (UnimplementedRegistrationServer) DeregisterNode(context.Context, *messages.AuthenticatedMessage) (*messages.Ack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterNode not implemented")
+}
 func (UnimplementedRegistrationServer) mustEmbedUnimplementedRegistrationServer() {}
 
 // UnsafeRegistrationServer may be embedded to opt out of forward compatibility for this service.
@@ -1683,6 +1910,27 @@ func _Registration_PollNdf_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Registration_WatchNdf_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(messages.AuthenticatedMessage)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RegistrationServer).WatchNdf(m, &registrationWatchNdfServer{stream})
+}
+
+type Registration_WatchNdfServer interface {
+	Send(*NDF) error
+	grpc.ServerStream
+}
+
+type registrationWatchNdfServer struct {
+	grpc.ServerStream
+}
+
+func (x *registrationWatchNdfServer) Send(m *NDF) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Registration_Poll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(messages.AuthenticatedMessage)
 	if err := dec(in); err != nil {
@@ -1719,6 +1967,42 @@ func _Registration_CheckRegistration_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Registration_RotateNodeCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(messages.AuthenticatedMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationServer).RotateNodeCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mixmessages.Registration/RotateNodeCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationServer).RotateNodeCredentials(ctx, req.(*messages.AuthenticatedMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registration_DeregisterNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(messages.AuthenticatedMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationServer).DeregisterNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mixmessages.Registration/DeregisterNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationServer).DeregisterNode(ctx, req.(*messages.AuthenticatedMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Registration_ServiceDesc is the grpc.ServiceDesc for Registration service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1742,8 +2026,22 @@ var Registration_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckRegistration",
 			Handler:    _Registration_CheckRegistration_Handler,
 		},
+		{
+			MethodName: "RotateNodeCredentials",
+			Handler:    _Registration_RotateNodeCredentials_Handler,
+		},
+		{
+			MethodName: "DeregisterNode",
+			Handler:    _Registration_DeregisterNode_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchNdf",
+			Handler:       _Registration_WatchNdf_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "mixmessages.proto",
 }
 
@@ -1765,6 +2063,11 @@ type NotificationBotClient interface {
 	RegisterTrackedID(ctx context.Context, in *RegisterTrackedIdRequest, opts ...grpc.CallOption) (*messages.Ack, error)
 	// (V2 notifications) Unregister a tracked ID from a user
 	UnregisterTrackedID(ctx context.Context, in *UnregisterTrackedIdRequest, opts ...grpc.CallOption) (*messages.Ack, error)
+	// (V2 notifications) List the tokens and tracked IDs registered to a user
+	ListRegistrations(ctx context.Context, in *ListRegistrationsRequest, opts ...grpc.CallOption) (*RegistrationList, error)
+	// (V2 notifications) Extend all registrations of a user before they
+	// expire, returning their new expiries
+	RefreshRegistration(ctx context.Context, in *RefreshRegistrationRequest, opts ...grpc.CallOption) (*RegistrationList, error)
 }
 
 type notificationBotClient struct {
@@ -1838,6 +2141,24 @@ func (c *notificationBotClient) UnregisterTrackedID(ctx context.Context, in *Unr
 	return out, nil
 }
 
+func (c *notificationBotClient) ListRegistrations(ctx context.Context, in *ListRegistrationsRequest, opts ...grpc.CallOption) (*RegistrationList, error) {
+	out := new(RegistrationList)
+	err := c.cc.Invoke(ctx, "/mixmessages.NotificationBot/ListRegistrations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationBotClient) RefreshRegistration(ctx context.Context, in *RefreshRegistrationRequest, opts ...grpc.CallOption) (*RegistrationList, error) {
+	out := new(RegistrationList)
+	err := c.cc.Invoke(ctx, "/mixmessages.NotificationBot/RefreshRegistration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NotificationBotServer is the server API for NotificationBot service.
 // All implementations must embed UnimplementedNotificationBotServer
 // for forward compatibility
@@ -1856,6 +2177,11 @@ type NotificationBotServer interface {
 	RegisterTrackedID(context.Context, *RegisterTrackedIdRequest) (*messages.Ack, error)
 	// (V2 notifications) Unregister a tracked ID from a user
 	UnregisterTrackedID(context.Context, *UnregisterTrackedIdRequest) (*messages.Ack, error)
+	// (V2 notifications) List the tokens and tracked IDs registered to a user
+	ListRegistrations(context.Context, *ListRegistrationsRequest) (*RegistrationList, error)
+	// (V2 notifications) Extend all registrations of a user before they
+	// expire, returning their new expiries
+	RefreshRegistration(context.Context, *RefreshRegistrationRequest) (*RegistrationList, error)
 	mustEmbedUnimplementedNotificationBotServer()
 }
 
@@ -1884,6 +2210,12 @@ func (UnimplementedNotificationBotServer) RegisterTrackedID(context.Context, *Re
 func (UnimplementedNotificationBotServer) UnregisterTrackedID(context.Context, *UnregisterTrackedIdRequest) (*messages.Ack, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnregisterTrackedID not implemented")
 }
+func (UnimplementedNotificationBotServer) ListRegistrations(context.Context, *ListRegistrationsRequest) (*RegistrationList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRegistrations not implemented")
+}
+func (UnimplementedNotificationBotServer) RefreshRegistration(context.Context, *RefreshRegistrationRequest) (*RegistrationList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshRegistration not implemented")
+}
 func (UnimplementedNotificationBotServer) mustEmbedUnimplementedNotificationBotServer() {}
 
 // UnsafeNotificationBotServer may be embedded to opt out of forward compatibility for this service.
@@ -2023,6 +2355,42 @@ func _NotificationBot_UnregisterTrackedID_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotificationBot_ListRegistrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRegistrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationBotServer).ListRegistrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mixmessages.NotificationBot/ListRegistrations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationBotServer).ListRegistrations(ctx, req.(*ListRegistrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationBot_RefreshRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationBotServer).RefreshRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mixmessages.NotificationBot/RefreshRegistration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationBotServer).RefreshRegistration(ctx, req.(*RefreshRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NotificationBot_ServiceDesc is the grpc.ServiceDesc for NotificationBot service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2058,6 +2426,14 @@ var NotificationBot_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnregisterTrackedID",
 			Handler:    _NotificationBot_UnregisterTrackedID_Handler,
 		},
+		{
+			MethodName: "ListRegistrations",
+			Handler:    _NotificationBot_ListRegistrations_Handler,
+		},
+		{
+			MethodName: "RefreshRegistration",
+			Handler:    _NotificationBot_RefreshRegistration_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "mixmessages.proto",
@@ -2085,9 +2461,22 @@ type UDBClient interface {
 	// RequestChannelAuthentication requests a signature from UD on a user's ed25519 public key
 	// Returning a lease and a signature from UD
 	RequestChannelLease(ctx context.Context, in *ChannelLeaseRequest, opts ...grpc.CallOption) (*ChannelLeaseResponse, error)
+	// RenewChannelLease extends an unexpired channel lease, returning a new
+	// lease and signature from UD
+	RenewChannelLease(ctx context.Context, in *ChannelLeaseRenewal, opts ...grpc.CallOption) (*ChannelLeaseResponse, error)
+	// RevokeChannelLease revokes a user's channel lease before it expires
+	RevokeChannelLease(ctx context.Context, in *ChannelLeaseRevocation, opts ...grpc.CallOption) (*messages.Ack, error)
 	// ValidateUsername sends a UsernameValidationRequest. This is a user side
 	// initiated comm, where UD signs the username.
 	ValidateUsername(ctx context.Context, in *UsernameValidationRequest, opts ...grpc.CallOption) (*UsernameValidation, error)
+	// RegisterFacts starts the association of several facts with an ID, with
+	// a result for each fact
+	RegisterFacts(ctx context.Context, in *FactRegisterBatch, opts ...grpc.CallOption) (*FactRegisterBatchResponse, error)
+	// ConfirmFacts completes the association of several facts with an ID,
+	// with a result for each fact
+	ConfirmFacts(ctx context.Context, in *FactConfirmBatch, opts ...grpc.CallOption) (*FactConfirmBatchResponse, error)
+	// ListFacts returns the facts registered to an ID
+	ListFacts(ctx context.Context, in *FactListRequest, opts ...grpc.CallOption) (*FactListResponse, error)
 }
 
 type uDBClient struct {
@@ -2152,6 +2541,24 @@ func (c *uDBClient) RequestChannelLease(ctx context.Context, in *ChannelLeaseReq
 	return out, nil
 }
 
+func (c *uDBClient) RenewChannelLease(ctx context.Context, in *ChannelLeaseRenewal, opts ...grpc.CallOption) (*ChannelLeaseResponse, error) {
+	out := new(ChannelLeaseResponse)
+	err := c.cc.Invoke(ctx, "/mixmessages.UDB/RenewChannelLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uDBClient) RevokeChannelLease(ctx context.Context, in *ChannelLeaseRevocation, opts ...grpc.CallOption) (*messages.Ack, error) {
+	out := new(messages.Ack)
+	err := c.cc.Invoke(ctx, "/mixmessages.UDB/RevokeChannelLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *uDBClient) ValidateUsername(ctx context.Context, in *UsernameValidationRequest, opts ...grpc.CallOption) (*UsernameValidation, error) {
 	out := new(UsernameValidation)
 	err := c.cc.Invoke(ctx, "/mixmessages.UDB/ValidateUsername", in, out, opts...)
@@ -2161,20 +2568,47 @@ func (c *uDBClient) ValidateUsername(ctx context.Context, in *UsernameValidation
 	return out, nil
 }
 
-// UDBServer is the server API for UDB service.
-// All implementations must embed UnimplementedUDBServer
-// for forward compatibility
-type UDBServer interface {
-	// RegisterUser adds a new ID to the user discovery system
-	RegisterUser(context.Context, *UDBUserRegistration) (*messages.Ack, error)
-	// RemoveUser deletes this user registration and blocks anyone from ever
-	// registering under that username again.
-	// The fact removal request must be for the username or it will not work.
-	RemoveUser(context.Context, *FactRemovalRequest) (*messages.Ack, error)
-	// RegisterFact starts the association of a fact with an ID. This is
-	// completed if it is a username and confirmations are required for verifiable
-	// facts like e-mail address and phone numbers.
-	RegisterFact(context.Context, *FactRegisterRequest) (*FactRegisterResponse, error)
+func (c *uDBClient) RegisterFacts(ctx context.Context, in *FactRegisterBatch, opts ...grpc.CallOption) (*FactRegisterBatchResponse, error) {
+	out := new(FactRegisterBatchResponse)
+	err := c.cc.Invoke(ctx, "/mixmessages.UDB/RegisterFacts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uDBClient) ConfirmFacts(ctx context.Context, in *FactConfirmBatch, opts ...grpc.CallOption) (*FactConfirmBatchResponse, error) {
+	out := new(FactConfirmBatchResponse)
+	err := c.cc.Invoke(ctx, "/mixmessages.UDB/ConfirmFacts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uDBClient) ListFacts(ctx context.Context, in *FactListRequest, opts ...grpc.CallOption) (*FactListResponse, error) {
+	out := new(FactListResponse)
+	err := c.cc.Invoke(ctx, "/mixmessages.UDB/ListFacts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UDBServer is the server API for UDB service.
+// All implementations must embed UnimplementedUDBServer
+// for forward compatibility
+type UDBServer interface {
+	// RegisterUser adds a new ID to the user discovery system
+	RegisterUser(context.Context, *UDBUserRegistration) (*messages.Ack, error)
+	// RemoveUser deletes this user registration and blocks anyone from ever
+	// registering under that username again.
+	// The fact removal request must be for the username or it will not work.
+	RemoveUser(context.Context, *FactRemovalRequest) (*messages.Ack, error)
+	// RegisterFact starts the association of a fact with an ID. This is
+	// completed if it is a username and confirmations are required for verifiable
+	// facts like e-mail address and phone numbers.
+	RegisterFact(context.Context, *FactRegisterRequest) (*FactRegisterResponse, error)
 	// ConfirmFact completes the association of a fact with an ID
 	ConfirmFact(context.Context, *FactConfirmRequest) (*messages.Ack, error)
 	// RemoveFact deletes a fact from its associated ID.
@@ -2183,9 +2617,22 @@ type UDBServer interface {
 	// RequestChannelAuthentication requests a signature from UD on a user's ed25519 public key
 	// Returning a lease and a signature from UD
 	RequestChannelLease(context.Context, *ChannelLeaseRequest) (*ChannelLeaseResponse, error)
+	// RenewChannelLease extends an unexpired channel lease, returning a new
+	// lease and signature from UD
+	RenewChannelLease(context.Context, *ChannelLeaseRenewal) (*ChannelLeaseResponse, error)
+	// RevokeChannelLease revokes a user's channel lease before it expires
+	RevokeChannelLease(context.Context, *ChannelLeaseRevocation) (*messages.Ack, error)
 	// ValidateUsername sends a UsernameValidationRequest. This is a user side
 	// initiated comm, where UD signs the username.
 	ValidateUsername(context.Context, *UsernameValidationRequest) (*UsernameValidation, error)
+	// RegisterFacts starts the association of several facts with an ID, with
+	// a result for each fact
+	RegisterFacts(context.Context, *FactRegisterBatch) (*FactRegisterBatchResponse, error)
+	// ConfirmFacts completes the association of several facts with an ID,
+	// with a result for each fact
+	ConfirmFacts(context.Context, *FactConfirmBatch) (*FactConfirmBatchResponse, error)
+	// ListFacts returns the facts registered to an ID
+	ListFacts(context.Context, *FactListRequest) (*FactListResponse, error)
 	mustEmbedUnimplementedUDBServer()
 }
 
@@ -2211,9 +2658,24 @@ func (UnimplementedUDBServer) RemoveFact(context.Context, *FactRemovalRequest) (
 func (UnimplementedUDBServer) RequestChannelLease(context.Context, *ChannelLeaseRequest) (*ChannelLeaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestChannelLease not implemented")
 }
+func (UnimplementedUDBServer) RenewChannelLease(context.Context, *ChannelLeaseRenewal) (*ChannelLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewChannelLease not implemented")
+}
+func (UnimplementedUDBServer) RevokeChannelLease(context.Context, *ChannelLeaseRevocation) (*messages.Ack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeChannelLease not implemented")
+}
 func (UnimplementedUDBServer) ValidateUsername(context.Context, *UsernameValidationRequest) (*UsernameValidation, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidateUsername not implemented")
 }
+func (UnimplementedUDBServer) RegisterFacts(context.Context, *FactRegisterBatch) (*FactRegisterBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterFacts not implemented")
+}
+func (UnimplementedUDBServer) ConfirmFacts(context.Context, *FactConfirmBatch) (*FactConfirmBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmFacts not implemented")
+}
+func (UnimplementedUDBServer) ListFacts(context.Context, *FactListRequest) (*FactListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFacts not implemented")
+}
 func (UnimplementedUDBServer) mustEmbedUnimplementedUDBServer() {}
 
 // UnsafeUDBServer may be embedded to opt out of forward compatibility for this service.
@@ -2335,6 +2797,42 @@ func _UDB_RequestChannelLease_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UDB_RenewChannelLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelLeaseRenewal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UDBServer).RenewChannelLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mixmessages.UDB/RenewChannelLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UDBServer).RenewChannelLease(ctx, req.(*ChannelLeaseRenewal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UDB_RevokeChannelLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelLeaseRevocation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UDBServer).RevokeChannelLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mixmessages.UDB/RevokeChannelLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UDBServer).RevokeChannelLease(ctx, req.(*ChannelLeaseRevocation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UDB_ValidateUsername_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UsernameValidationRequest)
 	if err := dec(in); err != nil {
@@ -2353,6 +2851,60 @@ func _UDB_ValidateUsername_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UDB_RegisterFacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FactRegisterBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UDBServer).RegisterFacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mixmessages.UDB/RegisterFacts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UDBServer).RegisterFacts(ctx, req.(*FactRegisterBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UDB_ConfirmFacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FactConfirmBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UDBServer).ConfirmFacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mixmessages.UDB/ConfirmFacts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UDBServer).ConfirmFacts(ctx, req.(*FactConfirmBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UDB_ListFacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FactListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UDBServer).ListFacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mixmessages.UDB/ListFacts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UDBServer).ListFacts(ctx, req.(*FactListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UDB_ServiceDesc is the grpc.ServiceDesc for UDB service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2384,10 +2936,30 @@ var UDB_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RequestChannelLease",
 			Handler:    _UDB_RequestChannelLease_Handler,
 		},
+		{
+			MethodName: "RenewChannelLease",
+			Handler:    _UDB_RenewChannelLease_Handler,
+		},
+		{
+			MethodName: "RevokeChannelLease",
+			Handler:    _UDB_RevokeChannelLease_Handler,
+		},
 		{
 			MethodName: "ValidateUsername",
 			Handler:    _UDB_ValidateUsername_Handler,
 		},
+		{
+			MethodName: "RegisterFacts",
+			Handler:    _UDB_RegisterFacts_Handler,
+		},
+		{
+			MethodName: "ConfirmFacts",
+			Handler:    _UDB_ConfirmFacts_Handler,
+		},
+		{
+			MethodName: "ListFacts",
+			Handler:    _UDB_ListFacts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "mixmessages.proto",
@@ -2561,6 +3133,14 @@ type RemoteSyncClient interface {
 	GetLastModified(ctx context.Context, in *RsReadRequest, opts ...grpc.CallOption) (*RsTimestampResponse, error)
 	GetLastWrite(ctx context.Context, in *RsLastWriteRequest, opts ...grpc.CallOption) (*RsTimestampResponse, error)
 	ReadDir(ctx context.Context, in *RsReadRequest, opts ...grpc.CallOption) (*RsReadDirResponse, error)
+	Delete(ctx context.Context, in *RsReadRequest, opts ...grpc.CallOption) (*messages.Ack, error)
+	Rename(ctx context.Context, in *RsRenameRequest, opts ...grpc.CallOption) (*messages.Ack, error)
+	Stat(ctx context.Context, in *RsReadRequest, opts ...grpc.CallOption) (*RsStatResponse, error)
+	ConditionalWrite(ctx context.Context, in *RsConditionalWriteRequest, opts ...grpc.CallOption) (*messages.Ack, error)
+	ReadStream(ctx context.Context, in *RsReadStreamRequest, opts ...grpc.CallOption) (RemoteSync_ReadStreamClient, error)
+	WriteStream(ctx context.Context, opts ...grpc.CallOption) (RemoteSync_WriteStreamClient, error)
+	GetUploadOffset(ctx context.Context, in *RsReadRequest, opts ...grpc.CallOption) (*RsUploadOffset, error)
+	Watch(ctx context.Context, in *RsWatchRequest, opts ...grpc.CallOption) (RemoteSync_WatchClient, error)
 }
 
 type remoteSyncClient struct {
@@ -2625,6 +3205,149 @@ func (c *remoteSyncClient) ReadDir(ctx context.Context, in *RsReadRequest, opts
 	return out, nil
 }
 
+func (c *remoteSyncClient) Delete(ctx context.Context, in *RsReadRequest, opts ...grpc.CallOption) (*messages.Ack, error) {
+	out := new(messages.Ack)
+	err := c.cc.Invoke(ctx, "/mixmessages.RemoteSync/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteSyncClient) Rename(ctx context.Context, in *RsRenameRequest, opts ...grpc.CallOption) (*messages.Ack, error) {
+	out := new(messages.Ack)
+	err := c.cc.Invoke(ctx, "/mixmessages.RemoteSync/Rename", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteSyncClient) Stat(ctx context.Context, in *RsReadRequest, opts ...grpc.CallOption) (*RsStatResponse, error) {
+	out := new(RsStatResponse)
+	err := c.cc.Invoke(ctx, "/mixmessages.RemoteSync/Stat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteSyncClient) ConditionalWrite(ctx context.Context, in *RsConditionalWriteRequest, opts ...grpc.CallOption) (*messages.Ack, error) {
+	out := new(messages.Ack)
+	err := c.cc.Invoke(ctx, "/mixmessages.RemoteSync/ConditionalWrite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteSyncClient) ReadStream(ctx context.Context, in *RsReadStreamRequest, opts ...grpc.CallOption) (RemoteSync_ReadStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RemoteSync_ServiceDesc.Streams[0], "/mixmessages.RemoteSync/ReadStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &remoteSyncReadStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RemoteSync_ReadStreamClient interface {
+	Recv() (*RsChunk, error)
+	grpc.ClientStream
+}
+
+type remoteSyncReadStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *remoteSyncReadStreamClient) Recv() (*RsChunk, error) {
+	m := new(RsChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *remoteSyncClient) WriteStream(ctx context.Context, opts ...grpc.CallOption) (RemoteSync_WriteStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RemoteSync_ServiceDesc.Streams[1], "/mixmessages.RemoteSync/WriteStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &remoteSyncWriteStreamClient{stream}
+	return x, nil
+}
+
+type RemoteSync_WriteStreamClient interface {
+	Send(*RsWriteChunk) error
+	CloseAndRecv() (*RsUploadOffset, error)
+	grpc.ClientStream
+}
+
+type remoteSyncWriteStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *remoteSyncWriteStreamClient) Send(m *RsWriteChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *remoteSyncWriteStreamClient) CloseAndRecv() (*RsUploadOffset, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(RsUploadOffset)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *remoteSyncClient) GetUploadOffset(ctx context.Context, in *RsReadRequest, opts ...grpc.CallOption) (*RsUploadOffset, error) {
+	out := new(RsUploadOffset)
+	err := c.cc.Invoke(ctx, "/mixmessages.RemoteSync/GetUploadOffset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteSyncClient) Watch(ctx context.Context, in *RsWatchRequest, opts ...grpc.CallOption) (RemoteSync_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RemoteSync_ServiceDesc.Streams[2], "/mixmessages.RemoteSync/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &remoteSyncWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RemoteSync_WatchClient interface {
+	Recv() (*RsChangeEvent, error)
+	grpc.ClientStream
+}
+
+type remoteSyncWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *remoteSyncWatchClient) Recv() (*RsChangeEvent, error) {
+	m := new(RsChangeEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // RemoteSyncServer is the server API for RemoteSync service.
 // All implementations must embed UnimplementedRemoteSyncServer
 // for forward compatibility
@@ -2635,6 +3358,14 @@ type RemoteSyncServer interface {
 	GetLastModified(context.Context, *RsReadRequest) (*RsTimestampResponse, error)
 	GetLastWrite(context.Context, *RsLastWriteRequest) (*RsTimestampResponse, error)
 	ReadDir(context.Context, *RsReadRequest) (*RsReadDirResponse, error)
+	Delete(context.Context, *RsReadRequest) (*messages.Ack, error)
+	Rename(context.Context, *RsRenameRequest) (*messages.Ack, error)
+	Stat(context.Context, *RsReadRequest) (*RsStatResponse, error)
+	ConditionalWrite(context.Context, *RsConditionalWriteRequest) (*messages.Ack, error)
+	ReadStream(*RsReadStreamRequest, RemoteSync_ReadStreamServer) error
+	WriteStream(RemoteSync_WriteStreamServer) error
+	GetUploadOffset(context.Context, *RsReadRequest) (*RsUploadOffset, error)
+	Watch(*RsWatchRequest, RemoteSync_WatchServer) error
 	mustEmbedUnimplementedRemoteSyncServer()
 }
 
@@ -2660,6 +3391,30 @@ func (UnimplementedRemoteSyncServer) GetLastWrite(context.Context, *RsLastWriteR
 func (UnimplementedRemoteSyncServer) ReadDir(context.Context, *RsReadRequest) (*RsReadDirResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadDir not implemented")
 }
+func (UnimplementedRemoteSyncServer) Delete(context.Context, *RsReadRequest) (*messages.Ack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedRemoteSyncServer) Rename(context.Context, *RsRenameRequest) (*messages.Ack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rename not implemented")
+}
+func (UnimplementedRemoteSyncServer) Stat(context.Context, *RsReadRequest) (*RsStatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stat not implemented")
+}
+func (UnimplementedRemoteSyncServer) ConditionalWrite(context.Context, *RsConditionalWriteRequest) (*messages.Ack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConditionalWrite not implemented")
+}
+func (UnimplementedRemoteSyncServer) ReadStream(*RsReadStreamRequest, RemoteSync_ReadStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReadStream not implemented")
+}
+func (UnimplementedRemoteSyncServer) WriteStream(RemoteSync_WriteStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method WriteStream not implemented")
+}
+func (UnimplementedRemoteSyncServer) GetUploadOffset(context.Context, *RsReadRequest) (*RsUploadOffset, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUploadOffset not implemented")
+}
+func (UnimplementedRemoteSyncServer) Watch(*RsWatchRequest, RemoteSync_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
 func (UnimplementedRemoteSyncServer) mustEmbedUnimplementedRemoteSyncServer() {}
 
 // UnsafeRemoteSyncServer may be embedded to opt out of forward compatibility for this service.
@@ -2781,6 +3536,164 @@ func _RemoteSync_ReadDir_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RemoteSync_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RsReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSyncServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mixmessages.RemoteSync/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSyncServer).Delete(ctx, req.(*RsReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteSync_Rename_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RsRenameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSyncServer).Rename(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mixmessages.RemoteSync/Rename",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSyncServer).Rename(ctx, req.(*RsRenameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteSync_Stat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RsReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSyncServer).Stat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mixmessages.RemoteSync/Stat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSyncServer).Stat(ctx, req.(*RsReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteSync_ConditionalWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RsConditionalWriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSyncServer).ConditionalWrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mixmessages.RemoteSync/ConditionalWrite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSyncServer).ConditionalWrite(ctx, req.(*RsConditionalWriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteSync_ReadStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RsReadStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RemoteSyncServer).ReadStream(m, &remoteSyncReadStreamServer{stream})
+}
+
+type RemoteSync_ReadStreamServer interface {
+	Send(*RsChunk) error
+	grpc.ServerStream
+}
+
+type remoteSyncReadStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *remoteSyncReadStreamServer) Send(m *RsChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RemoteSync_WriteStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RemoteSyncServer).WriteStream(&remoteSyncWriteStreamServer{stream})
+}
+
+type RemoteSync_WriteStreamServer interface {
+	SendAndClose(*RsUploadOffset) error
+	Recv() (*RsWriteChunk, error)
+	grpc.ServerStream
+}
+
+type remoteSyncWriteStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *remoteSyncWriteStreamServer) SendAndClose(m *RsUploadOffset) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *remoteSyncWriteStreamServer) Recv() (*RsWriteChunk, error) {
+	m := new(RsWriteChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _RemoteSync_GetUploadOffset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RsReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSyncServer).GetUploadOffset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mixmessages.RemoteSync/GetUploadOffset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSyncServer).GetUploadOffset(ctx, req.(*RsReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteSync_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RsWatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RemoteSyncServer).Watch(m, &remoteSyncWatchServer{stream})
+}
+
+type RemoteSync_WatchServer interface {
+	Send(*RsChangeEvent) error
+	grpc.ServerStream
+}
+
+type remoteSyncWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *remoteSyncWatchServer) Send(m *RsChangeEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // RemoteSync_ServiceDesc is the grpc.ServiceDesc for RemoteSync service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2812,7 +3725,43 @@ var RemoteSync_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReadDir",
 			Handler:    _RemoteSync_ReadDir_Handler,
 		},
+		{
+			MethodName: "Delete",
+			Handler:    _RemoteSync_Delete_Handler,
+		},
+		{
+			MethodName: "Rename",
+			Handler:    _RemoteSync_Rename_Handler,
+		},
+		{
+			MethodName: "Stat",
+			Handler:    _RemoteSync_Stat_Handler,
+		},
+		{
+			MethodName: "ConditionalWrite",
+			Handler:    _RemoteSync_ConditionalWrite_Handler,
+		},
+		{
+			MethodName: "GetUploadOffset",
+			Handler:    _RemoteSync_GetUploadOffset_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ReadStream",
+			Handler:       _RemoteSync_ReadStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WriteStream",
+			Handler:       _RemoteSync_WriteStream_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Watch",
+			Handler:       _RemoteSync_Watch_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "mixmessages.proto",
 }
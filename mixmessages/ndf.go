@@ -28,8 +28,14 @@ func (m *NDF) GetSig() *messages.RSASignature {
 }
 
 // Digest hashes the contents of the message in a repeatable manner
-// using the provided cryptographic hash. It includes the nonce in the hash
+// using the provided cryptographic hash. It includes the nonce in the hash.
+// The digest version used is set by SetDigestPolicy.
 func (m *NDF) Digest(nonce []byte, h hash.Hash) []byte {
+	return DigestSignable(m, GetSigningDigestVersion(), nonce, h)
+}
+
+// legacyDigest is the LegacyDigest version of Digest.
+func (m *NDF) legacyDigest(nonce []byte, h hash.Hash) []byte {
 	h.Reset()
 
 	// Hash the ndf and the nonce
@@ -10,13 +10,24 @@ package mixmessages
 import (
 	"bytes"
 	"encoding/base64"
+	"encoding/binary"
 	"encoding/csv"
 	"github.com/pkg/errors"
-	jww "github.com/spf13/jwalterweatherman"
 	"strings"
 )
 
-func MakeNotificationsCSV(l []*NotificationData) string {
+const (
+	// notificationsPrefix starts notifications in the compact format. It
+	// never starts the legacy CSV format, which holds only base64.
+	notificationsPrefix = "!"
+
+	// notificationsVersion is the version of the compact format produced.
+	notificationsVersion = 1
+)
+
+// MakeNotificationsCSV encodes the notifications in the legacy CSV format,
+// which drops their ephemeral IDs.
+func MakeNotificationsCSV(l []*NotificationData) (string, error) {
 	output := make([][]string, len(l))
 	for i, n := range l {
 		output[i] = []string{base64.StdEncoding.EncodeToString(n.MessageHash),
@@ -26,12 +37,18 @@ func MakeNotificationsCSV(l []*NotificationData) string {
 	buf := &bytes.Buffer{}
 	w := csv.NewWriter(buf)
 	if err := w.WriteAll(output); err != nil {
-		jww.FATAL.Printf("Failed to make notificationsCSV: %+v", err)
+		return "", errors.WithMessage(err, "Failed to make notifications CSV")
 	}
-	return string(buf.Bytes())
+	return string(buf.Bytes()), nil
 }
 
+// DecodeNotificationsCSV decodes notifications in the legacy CSV format, or
+// the compact format.
 func DecodeNotificationsCSV(data string) ([]*NotificationData, error) {
+	if strings.HasPrefix(data, notificationsPrefix) {
+		return DecodeNotifications(data)
+	}
+
 	r := csv.NewReader(strings.NewReader(data))
 	read, err := r.ReadAll()
 	if err != nil {
@@ -56,3 +73,138 @@ func DecodeNotificationsCSV(data string) ([]*NotificationData, error) {
 	}
 	return l, nil
 }
+
+// MakeNotifications encodes the notifications in the compact format, which
+// keeps all of their fields.
+func MakeNotifications(l []*NotificationData) string {
+	entries := make([]byte, 0, len(l)*notificationLen(&NotificationData{}))
+	for _, n := range l {
+		entries = appendNotification(entries, n)
+	}
+	return encodeNotifications(entries, len(l))
+}
+
+// DecodeNotifications decodes notifications in the compact format, or the
+// legacy CSV format.
+func DecodeNotifications(data string) ([]*NotificationData, error) {
+	if !strings.HasPrefix(data, notificationsPrefix) {
+		return DecodeNotificationsCSV(data)
+	}
+
+	b, err := base64.RawStdEncoding.DecodeString(
+		strings.TrimPrefix(data, notificationsPrefix))
+	if err != nil {
+		return nil, errors.WithMessage(err, "Failed to decode notifications")
+	}
+	if len(b) == 0 || b[0] != notificationsVersion {
+		return nil, errors.New("Unsupported notifications version")
+	}
+	b = b[1:]
+
+	count, n := binary.Uvarint(b)
+	// Each notification takes at least three bytes
+	if n <= 0 || count > uint64(len(b)-n)/3 {
+		return nil, errors.New("Invalid notification count")
+	}
+	b = b[n:]
+
+	l := make([]*NotificationData, count)
+	for i := range l {
+		ephemeralID, n := binary.Varint(b)
+		if n <= 0 {
+			return nil, errors.Errorf("Invalid ephemeral ID of notification %d", i)
+		}
+		b = b[n:]
+		identityFP, rest, err := readNotificationBytes(b)
+		if err != nil {
+			return nil, errors.WithMessagef(err,
+				"Invalid identity fingerprint of notification %d", i)
+		}
+		messageHash, rest, err := readNotificationBytes(rest)
+		if err != nil {
+			return nil, errors.WithMessagef(err,
+				"Invalid message hash of notification %d", i)
+		}
+		b = rest
+		l[i] = &NotificationData{
+			EphemeralID: ephemeralID,
+			IdentityFP:  identityFP,
+			MessageHash: messageHash,
+		}
+	}
+	if len(b) != 0 {
+		return nil, errors.Errorf("%d trailing bytes after notifications", len(b))
+	}
+	return l, nil
+}
+
+// SplitNotifications encodes the notifications of the batch in the compact
+// format, split into as few payloads of at most maxSize bytes as possible
+// while keeping their order. Notifications which do not fit in a payload on
+// their own are dropped and returned.
+func SplitNotifications(batch *NotificationBatch, maxSize int) (
+	payloads []string, dropped []*NotificationData) {
+	var entries []byte
+	count := 0
+	for _, n := range batch.GetNotifications() {
+		size := notificationLen(n)
+		if encodedNotificationsLen(size, 1) > maxSize {
+			dropped = append(dropped, n)
+			continue
+		}
+		if encodedNotificationsLen(len(entries)+size, count+1) > maxSize {
+			payloads = append(payloads, encodeNotifications(entries, count))
+			entries, count = nil, 0
+		}
+		entries = appendNotification(entries, n)
+		count++
+	}
+	if count > 0 {
+		payloads = append(payloads, encodeNotifications(entries, count))
+	}
+	return payloads, dropped
+}
+
+// encodeNotifications returns the compact format of count encoded entries.
+func encodeNotifications(entries []byte, count int) string {
+	b := make([]byte, 0, 1+binary.MaxVarintLen64+len(entries))
+	b = append(b, notificationsVersion)
+	b = binary.AppendUvarint(b, uint64(count))
+	b = append(b, entries...)
+	return notificationsPrefix + base64.RawStdEncoding.EncodeToString(b)
+}
+
+// encodedNotificationsLen returns the length of the compact format of count
+// entries taking entriesLen bytes.
+func encodedNotificationsLen(entriesLen, count int) int {
+	n := 1 + len(binary.AppendUvarint(nil, uint64(count))) + entriesLen
+	return len(notificationsPrefix) + base64.RawStdEncoding.EncodedLen(n)
+}
+
+// appendNotification appends the encoded notification to b.
+func appendNotification(b []byte, n *NotificationData) []byte {
+	b = binary.AppendVarint(b, n.GetEphemeralID())
+	b = binary.AppendUvarint(b, uint64(len(n.GetIdentityFP())))
+	b = append(b, n.GetIdentityFP()...)
+	b = binary.AppendUvarint(b, uint64(len(n.GetMessageHash())))
+	return append(b, n.GetMessageHash()...)
+}
+
+// notificationLen returns the length of the encoded notification.
+func notificationLen(n *NotificationData) int {
+	return len(appendNotification(nil, n))
+}
+
+// readNotificationBytes reads a length-prefixed field from the start of b,
+// returning it and the rest of b.
+func readNotificationBytes(b []byte) ([]byte, []byte, error) {
+	length, n := binary.Uvarint(b)
+	if n <= 0 || length > uint64(len(b)-n) {
+		return nil, nil, errors.New("field length out of range")
+	}
+	b = b[n:]
+	if length == 0 {
+		return nil, b, nil
+	}
+	return append([]byte{}, b[:length]...), b[length:], nil
+}
@@ -30,7 +30,10 @@ func TestMake_DecodeNotificationsCSV(t *testing.T) {
 		notifList = append(notifList, &NotificationData{MessageHash: msgHash, IdentityFP: ifp})
 	}
 
-	notifCSV := MakeNotificationsCSV(notifList)
+	notifCSV, err := MakeNotificationsCSV(notifList)
+	if err != nil {
+		t.Fatal(err)
+	}
 	newNotifList, err := DecodeNotificationsCSV(notifCSV)
 
 	if err != nil {
@@ -109,8 +112,116 @@ func TestMakeNotificationsCSV_Consistency(t *testing.T) {
 		notifList = append(notifList, &NotificationData{MessageHash: msgHash, IdentityFP: ifp})
 	}
 
-	notifCSV := MakeNotificationsCSV(notifList)
+	notifCSV, err := MakeNotificationsCSV(notifList)
+	if err != nil {
+		t.Fatal(err)
+	}
 	if notifCSV != expected {
 		t.Errorf("generated notif does not match expected")
 	}
 }
+
+// newTestNotifications returns notifications with random fields.
+func newTestNotifications(rng *rand.Rand, num int) []*NotificationData {
+	notifList := make([]*NotificationData, 0, num)
+	for i := 0; i < num; i++ {
+		msgHash := make([]byte, 32)
+		ifp := make([]byte, 25)
+		rng.Read(msgHash)
+		rng.Read(ifp)
+		notifList = append(notifList, &NotificationData{
+			EphemeralID: rng.Int63() - rng.Int63(),
+			MessageHash: msgHash,
+			IdentityFP:  ifp,
+		})
+	}
+	return notifList
+}
+
+// Tests that notifications in the compact format keep all of their fields,
+// and that both decoders accept both formats.
+func TestMake_DecodeNotifications(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+	notifList := newTestNotifications(rng, 50)
+
+	encoded := MakeNotifications(notifList)
+	for _, decode := range []func(string) ([]*NotificationData, error){
+		DecodeNotifications, DecodeNotificationsCSV} {
+		decoded, err := decode(encoded)
+		if err != nil {
+			t.Fatalf("Failed to decode notifications: %+v", err)
+		}
+		if !reflect.DeepEqual(notifList, decoded) {
+			t.Errorf("Decoded notifications do not match")
+		}
+	}
+
+	notifCSV, err := MakeNotificationsCSV(notifList)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := DecodeNotifications(notifCSV)
+	if err != nil {
+		t.Fatalf("Failed to decode notifications CSV: %+v", err)
+	}
+	if len(decoded) != len(notifList) ||
+		!reflect.DeepEqual(decoded[0].MessageHash, notifList[0].MessageHash) {
+		t.Errorf("Decoded CSV notifications do not match")
+	}
+}
+
+// Tests that DecodeNotifications rejects malformed payloads.
+func TestDecodeNotifications_Invalid(t *testing.T) {
+	valid := MakeNotifications(newTestNotifications(rand.New(rand.NewSource(42)), 2))
+
+	for _, data := range []string{
+		notificationsPrefix,
+		notificationsPrefix + "AgA",  // Unsupported version
+		notificationsPrefix + "AWQ",  // Count larger than the payload
+		valid[:len(valid)-4],         // Truncated
+		valid + "AA",                 // Trailing bytes
+		notificationsPrefix + "!!!!", // Not base64
+	} {
+		if _, err := DecodeNotifications(data); err == nil {
+			t.Errorf("Decoding %q did not produce an error", data)
+		}
+	}
+}
+
+// Tests that SplitNotifications produces payloads within the size which hold
+// every notification in order, except those too large for any payload.
+func TestSplitNotifications(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+	notifList := newTestNotifications(rng, 50)
+	tooLarge := &NotificationData{MessageHash: make([]byte, 1000)}
+	notifList = append(notifList[:10], append([]*NotificationData{tooLarge},
+		notifList[10:]...)...)
+	const maxSize = 512
+
+	payloads, dropped := SplitNotifications(
+		&NotificationBatch{Notifications: notifList}, maxSize)
+	if len(dropped) != 1 || dropped[0] != tooLarge {
+		t.Errorf("Expected only the large notification to be dropped, "+
+			"dropped %d", len(dropped))
+	}
+
+	var decoded []*NotificationData
+	for _, payload := range payloads {
+		if len(payload) > maxSize {
+			t.Errorf("Payload of %d bytes exceeds %d", len(payload), maxSize)
+		}
+		l, err := DecodeNotifications(payload)
+		if err != nil {
+			t.Fatalf("Failed to decode payload: %+v", err)
+		}
+		decoded = append(decoded, l...)
+	}
+	expected := append(notifList[:10:10], notifList[11:]...)
+	if !reflect.DeepEqual(expected, decoded) {
+		t.Errorf("Payloads do not hold the notifications in order")
+	}
+	if len(payloads) < 2 {
+		t.Errorf("Expected notifications to be split, got %d payloads",
+			len(payloads))
+	}
+}
@@ -0,0 +1,49 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the proof-of-work solved by clients before registering
+
+package mixmessages
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/bits"
+)
+
+// Solve returns a solution to the challenge for the client's transmission RSA
+// public key. The work done doubles with each bit of difficulty.
+func (m *RegistrationChallenge) Solve(transmissionKey string) []byte {
+	solution := make([]byte, 8)
+	for i := uint64(0); ; i++ {
+		binary.BigEndian.PutUint64(solution, i)
+		if m.Verify(transmissionKey, solution) {
+			return solution
+		}
+	}
+}
+
+// Verify returns true if the solution solves the challenge for the client's
+// transmission RSA public key.
+func (m *RegistrationChallenge) Verify(transmissionKey string,
+	solution []byte) bool {
+	h := sha256.New()
+	h.Write(m.GetNonce())
+	h.Write([]byte(transmissionKey))
+	h.Write(solution)
+	return leadingZeros(h.Sum(nil)) >= int(m.GetDifficulty())
+}
+
+// leadingZeros returns the number of leading zero bits in b.
+func leadingZeros(b []byte) int {
+	for i, v := range b {
+		if v != 0 {
+			return i*8 + bits.LeadingZeros8(v)
+		}
+	}
+	return len(b) * 8
+}
@@ -0,0 +1,45 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package mixmessages
+
+import (
+	"testing"
+)
+
+// Tests that a solution from Solve verifies for the key it was solved for,
+// and not for another key.
+func TestRegistrationChallenge_Solve(t *testing.T) {
+	c := &RegistrationChallenge{Nonce: []byte("nonce"), Difficulty: 12}
+
+	solution := c.Solve("key")
+	if !c.Verify("key", solution) {
+		t.Errorf("Solution %v does not verify", solution)
+	}
+	if c.Verify("other key", solution) && c.Verify("another key", solution) {
+		t.Errorf("Solution %v verifies for other keys", solution)
+	}
+}
+
+// Tests that leadingZeros counts the zero bits before the first one bit.
+func TestLeadingZeros(t *testing.T) {
+	tests := []struct {
+		b        []byte
+		expected int
+	}{
+		{[]byte{0x80}, 0},
+		{[]byte{0x00, 0x01}, 15},
+		{[]byte{0x00, 0x00}, 16},
+		{[]byte{0x0f, 0x00}, 4},
+	}
+
+	for _, tt := range tests {
+		if n := leadingZeros(tt.b); n != tt.expected {
+			t.Errorf("leadingZeros(%v) = %d, expected %d", tt.b, n, tt.expected)
+		}
+	}
+}
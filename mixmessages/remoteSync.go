@@ -0,0 +1,39 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains helpers for remote sync messages
+
+package mixmessages
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+)
+
+// HashRsChunk returns the integrity hash of the data of a remote sync chunk.
+func HashRsChunk(data []byte) []byte {
+	h := sha256.Sum256(data)
+	return h[:]
+}
+
+// Verify returns true if the hash of the chunk matches its data.
+func (m *RsChunk) Verify() bool {
+	return subtle.ConstantTimeCompare(m.GetHash(), HashRsChunk(m.GetData())) == 1
+}
+
+// Verify returns true if the hash of the chunk matches its data.
+func (m *RsWriteChunk) Verify() bool {
+	return subtle.ConstantTimeCompare(m.GetHash(), HashRsChunk(m.GetData())) == 1
+}
+
+// Types of RsChangeEvent
+const (
+	RsChangeWritten uint32 = iota
+	RsChangeDeleted
+	RsChangeRenamed
+	RsChangeStarted
+)
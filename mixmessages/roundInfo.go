@@ -42,8 +42,14 @@ func (m *RoundInfo) GetEccSig() *messages.ECCSignature {
 }
 
 // Digest hashes the contents of the message in a repeatable manner
-// using the provided cryptographic hash. It includes the nonce in the hash
+// using the provided cryptographic hash. It includes the nonce in the hash.
+// The digest version used is set by SetDigestPolicy.
 func (m *RoundInfo) Digest(nonce []byte, h hash.Hash) []byte {
+	return DigestSignable(m, GetSigningDigestVersion(), nonce, h)
+}
+
+// legacyDigest is the LegacyDigest version of Digest.
+func (m *RoundInfo) legacyDigest(nonce []byte, h hash.Hash) []byte {
 	h.Reset()
 
 	// Serialize  and hash RoundId
@@ -77,7 +83,7 @@ func (m *RoundInfo) Digest(nonce []byte, h hash.Hash) []byte {
 	// Hash ClientErrors
 	for _, clientError := range m.ClientErrors {
 		sha := crypto.SHA256.New()
-		data := clientError.Digest(nonce, sha)
+		data := clientError.legacyDigest(nonce, sha)
 		h.Write(data)
 	}
 
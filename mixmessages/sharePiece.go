@@ -27,8 +27,14 @@ func (m *SharePiece) GetSig() *messages.RSASignature {
 }
 
 // Digest hashes the contents of the message in a repeatable manner
-// using the provided cryptographic hash. It includes the nonce in the hash
+// using the provided cryptographic hash. It includes the nonce in the hash.
+// The digest version used is set by SetDigestPolicy.
 func (m *SharePiece) Digest(nonce []byte, h hash.Hash) []byte {
+	return DigestSignable(m, GetSigningDigestVersion(), nonce, h)
+}
+
+// legacyDigest is the LegacyDigest version of Digest.
+func (m *SharePiece) legacyDigest(nonce []byte, h hash.Hash) []byte {
 	h.Reset()
 
 	// Hash the signature piece
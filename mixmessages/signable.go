@@ -0,0 +1,328 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the versioned, domain-separated digest scheme shared by all
+// signable messages and the registry of those messages.
+
+package mixmessages
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"hash"
+	"math"
+	"sort"
+	"sync"
+)
+
+// DigestVersion identifies the scheme used to digest a signable message.
+type DigestVersion uint8
+
+const (
+	// LegacyDigest is the original hand-written serialization of each
+	// message type. It has no domain separation between message types.
+	LegacyDigest DigestVersion = 0
+
+	// CanonicalDigestV1 is the canonical encoding built from the signable
+	// registry. It is prefixed with the message's domain tag and the version.
+	CanonicalDigestV1 DigestVersion = 1
+)
+
+// canonicalDigestPrefix begins every canonical digest input. Legacy digests
+// begin with message content, so the prefix also separates the two schemes.
+const canonicalDigestPrefix = "xxdigest"
+
+// legacyDigester is implemented by signable messages which predate the
+// canonical scheme and must still produce their original digests.
+type legacyDigester interface {
+	legacyDigest(nonce []byte, h hash.Hash) []byte
+}
+
+// signableSpec describes how a registered message type is digested.
+type signableSpec struct {
+	domain   string
+	excluded map[protoreflect.Name]struct{}
+}
+
+// signables is the registry of all signable message types.
+var signables = struct {
+	sync.RWMutex
+	byType   map[protoreflect.FullName]*signableSpec
+	byDomain map[string]protoreflect.FullName
+}{
+	byType:   make(map[protoreflect.FullName]*signableSpec),
+	byDomain: make(map[string]protoreflect.FullName),
+}
+
+// RegisterSignable adds a message type to the signable registry under a
+// domain tag unique to that type. The canonical digest covers every field of
+// the message except those named in excluded, which is meant for the
+// message's own signature fields. Fields added to the message later are
+// covered automatically.
+//
+// It panics if the type or domain is already registered or if an excluded
+// field does not exist, so mistakes surface when the program starts.
+func RegisterSignable(msg proto.Message, domain string, excluded ...string) {
+	desc := msg.ProtoReflect().Descriptor()
+
+	signables.Lock()
+	defer signables.Unlock()
+
+	if _, exists := signables.byType[desc.FullName()]; exists {
+		jww.FATAL.Panicf("Signable %s is already registered", desc.FullName())
+	}
+	if other, exists := signables.byDomain[domain]; exists {
+		jww.FATAL.Panicf("Cannot register signable %s: domain %q is "+
+			"already used by %s", desc.FullName(), domain, other)
+	}
+	if domain == "" {
+		jww.FATAL.Panicf("Cannot register signable %s with an empty domain",
+			desc.FullName())
+	}
+
+	spec := &signableSpec{
+		domain:   domain,
+		excluded: make(map[protoreflect.Name]struct{}, len(excluded)),
+	}
+	for _, name := range excluded {
+		if desc.Fields().ByName(protoreflect.Name(name)) == nil {
+			jww.FATAL.Panicf("Cannot exclude %s from signable %s: no such "+
+				"field", name, desc.FullName())
+		}
+		spec.excluded[protoreflect.Name(name)] = struct{}{}
+	}
+
+	signables.byType[desc.FullName()] = spec
+	signables.byDomain[domain] = desc.FullName()
+}
+
+// Registers the signable messages of this package.
+func init() {
+	// The generated descriptors must exist before they can be registered
+	file_mixmessages_proto_init()
+
+	RegisterSignable(&RoundInfo{}, "xx.mixmessages.RoundInfo",
+		"Signature", "EccSignature", "Errors")
+	RegisterSignable(&RoundError{}, "xx.mixmessages.RoundError", "Signature")
+	RegisterSignable(&ClientError{}, "xx.mixmessages.ClientError")
+	RegisterSignable(&NDF{}, "xx.mixmessages.NDF", "Signature")
+	RegisterSignable(&SharePiece{}, "xx.mixmessages.SharePiece", "Signature")
+	RegisterSignable(&Identity{}, "xx.mixmessages.Identity")
+}
+
+// getSignableSpec returns the registry entry for the message's type.
+func getSignableSpec(m proto.Message) (*signableSpec, bool) {
+	signables.RLock()
+	defer signables.RUnlock()
+	spec, ok := signables.byType[m.ProtoReflect().Descriptor().FullName()]
+	return spec, ok
+}
+
+// DigestSignable hashes a registered signable message, including the nonce,
+// using the given version of the digest scheme. Messages without a legacy
+// digest use CanonicalDigestV1 in place of LegacyDigest.
+func DigestSignable(m proto.Message, version DigestVersion, nonce []byte,
+	h hash.Hash) []byte {
+	spec, ok := getSignableSpec(m)
+	if !ok {
+		jww.FATAL.Panicf("Cannot digest %s: not a registered signable",
+			m.ProtoReflect().Descriptor().FullName())
+	}
+
+	if version == LegacyDigest {
+		if ld, ok := m.(legacyDigester); ok {
+			return ld.legacyDigest(nonce, h)
+		}
+		version = CanonicalDigestV1
+	}
+
+	if version != CanonicalDigestV1 {
+		jww.FATAL.Panicf("Cannot digest %s: unknown digest version %d",
+			m.ProtoReflect().Descriptor().FullName(), version)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(canonicalDigestPrefix)
+	buf.WriteByte(byte(version))
+	writeCanonicalBytes(&buf, []byte(spec.domain))
+	writeCanonicalMessage(&buf, m.ProtoReflect(), spec.excluded)
+	writeCanonicalBytes(&buf, nonce)
+
+	h.Reset()
+	h.Write(buf.Bytes())
+	return h.Sum(nil)
+}
+
+// writeCanonicalMessage encodes every populated field of the message, other
+// than the excluded ones, in field number order. Each field is preceded by
+// its number so that unset fields, including ones added in later versions of
+// the message, do not change the encoding.
+func writeCanonicalMessage(buf *bytes.Buffer, m protoreflect.Message,
+	excluded map[protoreflect.Name]struct{}) {
+	fields := m.Descriptor().Fields()
+	ordered := make([]protoreflect.FieldDescriptor, 0, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		ordered = append(ordered, fields.Get(i))
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+		return ordered[i].Number() < ordered[j].Number()
+	})
+
+	for _, fd := range ordered {
+		if _, skip := excluded[fd.Name()]; skip || !m.Has(fd) {
+			continue
+		}
+		writeCanonicalUint64(buf, uint64(fd.Number()))
+
+		v := m.Get(fd)
+		switch {
+		case fd.IsList():
+			list := v.List()
+			writeCanonicalUint64(buf, uint64(list.Len()))
+			for i := 0; i < list.Len(); i++ {
+				writeCanonicalValue(buf, fd, list.Get(i))
+			}
+		case fd.IsMap():
+			writeCanonicalMap(buf, fd, v.Map())
+		default:
+			writeCanonicalValue(buf, fd, v)
+		}
+	}
+}
+
+// writeCanonicalMap encodes the entries of a map sorted by their encoded key.
+func writeCanonicalMap(buf *bytes.Buffer, fd protoreflect.FieldDescriptor,
+	m protoreflect.Map) {
+	type entry struct{ key, value []byte }
+	entries := make([]entry, 0, m.Len())
+	m.Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+		var key, value bytes.Buffer
+		writeCanonicalValue(&key, fd.MapKey(), k.Value())
+		writeCanonicalValue(&value, fd.MapValue(), v)
+		entries = append(entries, entry{key.Bytes(), value.Bytes()})
+		return true
+	})
+	sort.Slice(entries, func(i, j int) bool {
+		return bytes.Compare(entries[i].key, entries[j].key) < 0
+	})
+
+	writeCanonicalUint64(buf, uint64(len(entries)))
+	for _, e := range entries {
+		buf.Write(e.key)
+		buf.Write(e.value)
+	}
+}
+
+// writeCanonicalValue encodes a single value of the field's kind. Integers
+// are widened to 8 bytes and variable length values are length prefixed.
+func writeCanonicalValue(buf *bytes.Buffer, fd protoreflect.FieldDescriptor,
+	v protoreflect.Value) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		if v.Bool() {
+			buf.WriteByte(1)
+		} else {
+			buf.WriteByte(0)
+		}
+	case protoreflect.EnumKind:
+		writeCanonicalUint64(buf, uint64(v.Enum()))
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind,
+		protoreflect.Sfixed32Kind, protoreflect.Int64Kind,
+		protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		writeCanonicalUint64(buf, uint64(v.Int()))
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		writeCanonicalUint64(buf, v.Uint())
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		writeCanonicalUint64(buf, math.Float64bits(v.Float()))
+	case protoreflect.StringKind:
+		writeCanonicalBytes(buf, []byte(v.String()))
+	case protoreflect.BytesKind:
+		writeCanonicalBytes(buf, v.Bytes())
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		var nested bytes.Buffer
+		writeCanonicalMessage(&nested, v.Message(), nil)
+		writeCanonicalBytes(buf, nested.Bytes())
+	}
+}
+
+// writeCanonicalUint64 writes v as 8 big-endian bytes.
+func writeCanonicalUint64(buf *bytes.Buffer, v uint64) {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], v)
+	buf.Write(b[:])
+}
+
+// writeCanonicalBytes writes data prefixed with its length.
+func writeCanonicalBytes(buf *bytes.Buffer, data []byte) {
+	writeCanonicalUint64(buf, uint64(len(data)))
+	buf.Write(data)
+}
+
+// digestPolicy controls which digest version signable messages are signed
+// with and which versions are accepted when verifying them.
+var digestPolicy = struct {
+	sync.RWMutex
+	signing  DigestVersion
+	accepted []DigestVersion
+}{
+	signing:  LegacyDigest,
+	accepted: []DigestVersion{LegacyDigest, CanonicalDigestV1},
+}
+
+// SetDigestPolicy sets the digest version used when signing and the versions
+// accepted when verifying. Migrating to a new version is done in steps across
+// the network: first accept both versions while signing with the old one,
+// then sign with the new one, and finally stop accepting the old one. The
+// signing version must be one of the accepted versions.
+func SetDigestPolicy(signing DigestVersion, accepted ...DigestVersion) error {
+	found := false
+	for _, v := range accepted {
+		if v != LegacyDigest && v != CanonicalDigestV1 {
+			return errors.Errorf("Unknown digest version %d", v)
+		}
+		if v == signing {
+			found = true
+		}
+	}
+	if !found {
+		return errors.Errorf("Signing digest version %d must be accepted",
+			signing)
+	}
+
+	digestPolicy.Lock()
+	defer digestPolicy.Unlock()
+	digestPolicy.signing = signing
+	digestPolicy.accepted = append([]DigestVersion{}, accepted...)
+	return nil
+}
+
+// GetSigningDigestVersion returns the digest version used by the Digest
+// method of signable messages, and so the version used when signing.
+func GetSigningDigestVersion() DigestVersion {
+	digestPolicy.RLock()
+	defer digestPolicy.RUnlock()
+	return digestPolicy.signing
+}
+
+// GetAcceptedDigestVersions returns the digest versions accepted when
+// verifying signable messages, with the signing version first.
+func GetAcceptedDigestVersions() []DigestVersion {
+	digestPolicy.RLock()
+	defer digestPolicy.RUnlock()
+	versions := []DigestVersion{digestPolicy.signing}
+	for _, v := range digestPolicy.accepted {
+		if v != digestPolicy.signing {
+			versions = append(versions, v)
+		}
+	}
+	return versions
+}
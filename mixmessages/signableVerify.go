@@ -0,0 +1,100 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains signing and verification of signable messages at a specific
+// digest version, allowing a network to migrate between versions.
+
+package mixmessages
+
+import (
+	"github.com/pkg/errors"
+	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/comms/signature"
+	"gitlab.com/xx_network/crypto/signature/ec"
+	"gitlab.com/xx_network/crypto/signature/rsa"
+	"google.golang.org/protobuf/proto"
+	"hash"
+)
+
+// RsaSignable is a registered signable message signed with an RSA key.
+type RsaSignable interface {
+	proto.Message
+	GetSig() *messages.RSASignature
+}
+
+// EccSignable is a registered signable message signed with an EdDSA key.
+type EccSignable interface {
+	proto.Message
+	GetEccSig() *messages.ECCSignature
+}
+
+// versionedRsaSignable digests an RsaSignable at a fixed digest version.
+type versionedRsaSignable struct {
+	RsaSignable
+	version DigestVersion
+}
+
+// Digest hashes the message at the pinned digest version.
+func (v *versionedRsaSignable) Digest(nonce []byte, h hash.Hash) []byte {
+	return DigestSignable(v.RsaSignable, v.version, nonce, h)
+}
+
+// versionedEccSignable digests an EccSignable at a fixed digest version.
+type versionedEccSignable struct {
+	EccSignable
+	version DigestVersion
+}
+
+// Digest hashes the message at the pinned digest version.
+func (v *versionedEccSignable) Digest(nonce []byte, h hash.Hash) []byte {
+	return DigestSignable(v.EccSignable, v.version, nonce, h)
+}
+
+// RsaSignableAtVersion returns the message wrapped so that signing or
+// verifying it with the signature package uses the given digest version,
+// regardless of the digest policy.
+func RsaSignableAtVersion(m RsaSignable,
+	version DigestVersion) signature.GenericRsaSignable {
+	return &versionedRsaSignable{RsaSignable: m, version: version}
+}
+
+// EccSignableAtVersion returns the message wrapped so that signing or
+// verifying it with the signature package uses the given digest version,
+// regardless of the digest policy.
+func EccSignableAtVersion(m EccSignable,
+	version DigestVersion) signature.GenericEccSignable {
+	return &versionedEccSignable{EccSignable: m, version: version}
+}
+
+// VerifyRsa verifies the RSA signature on the message against each digest
+// version accepted by the digest policy, succeeding if any of them match.
+func VerifyRsa(m RsaSignable, pubKey *rsa.PublicKey) error {
+	var err error
+	for _, version := range GetAcceptedDigestVersions() {
+		err = signature.VerifyRsa(RsaSignableAtVersion(m, version), pubKey)
+		if err == nil {
+			return nil
+		}
+	}
+	return errors.Wrapf(err, "Signature on %s does not match any accepted "+
+		"digest version", m.ProtoReflect().Descriptor().FullName())
+}
+
+// VerifyEddsa verifies the EdDSA signature on the message against each
+// digest version accepted by the digest policy, succeeding if any of them
+// match.
+func VerifyEddsa(m EccSignable, pubKey *ec.PublicKey) error {
+	var err error
+	for _, version := range GetAcceptedDigestVersions() {
+		err = signature.VerifyEddsa(EccSignableAtVersion(m, version), pubKey)
+		if err == nil {
+			return nil
+		}
+	}
+	return errors.Wrapf(err, "Signature on %s does not match any accepted "+
+		"digest version", m.ProtoReflect().Descriptor().FullName())
+}
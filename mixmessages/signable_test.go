@@ -0,0 +1,265 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package mixmessages
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"gitlab.com/xx_network/comms/signature"
+	"gitlab.com/xx_network/crypto/signature/ec"
+	"gitlab.com/xx_network/crypto/signature/rsa"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"testing"
+)
+
+// setSampleField sets the field to a non-zero value of its kind.
+func setSampleField(m protoreflect.Message, fd protoreflect.FieldDescriptor) {
+	if fd.IsList() {
+		list := m.Mutable(fd).List()
+		if fd.Kind() == protoreflect.MessageKind {
+			list.Append(list.NewElement())
+		} else {
+			list.Append(sampleScalar(fd))
+		}
+		return
+	}
+	if fd.Kind() == protoreflect.MessageKind {
+		m.Set(fd, m.NewField(fd))
+		return
+	}
+	m.Set(fd, sampleScalar(fd))
+}
+
+// sampleScalar returns a non-zero value of the field's kind.
+func sampleScalar(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return protoreflect.ValueOfBool(true)
+	case protoreflect.EnumKind:
+		return protoreflect.ValueOfEnum(1)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind,
+		protoreflect.Sfixed32Kind:
+		return protoreflect.ValueOfInt32(7)
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind,
+		protoreflect.Sfixed64Kind:
+		return protoreflect.ValueOfInt64(7)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return protoreflect.ValueOfUint32(7)
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return protoreflect.ValueOfUint64(7)
+	case protoreflect.FloatKind:
+		return protoreflect.ValueOfFloat32(1.5)
+	case protoreflect.DoubleKind:
+		return protoreflect.ValueOfFloat64(1.5)
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString("sample")
+	default:
+		return protoreflect.ValueOfBytes([]byte("sample"))
+	}
+}
+
+// Tests that every field of every registered signable changes its canonical
+// digest, except for the excluded fields which must not.
+func TestDigestSignable_FieldCoverage(t *testing.T) {
+	for name, spec := range signables.byType {
+		mt, err := protoregistry.GlobalTypes.FindMessageByName(name)
+		if err != nil {
+			t.Fatalf("Failed to find %s: %+v", name, err)
+		}
+		empty := mt.New().Interface()
+		emptyDigest := DigestSignable(empty, CanonicalDigestV1, nil,
+			crypto.SHA256.New())
+
+		fields := mt.Descriptor().Fields()
+		for i := 0; i < fields.Len(); i++ {
+			fd := fields.Get(i)
+			m := mt.New()
+			setSampleField(m, fd)
+			digest := DigestSignable(m.Interface(), CanonicalDigestV1, nil,
+				crypto.SHA256.New())
+
+			_, excluded := spec.excluded[fd.Name()]
+			if excluded && !bytes.Equal(digest, emptyDigest) {
+				t.Errorf("Excluded field %s.%s changed the digest",
+					name, fd.Name())
+			} else if !excluded && bytes.Equal(digest, emptyDigest) {
+				t.Errorf("Field %s.%s is not covered by the digest",
+					name, fd.Name())
+			}
+		}
+	}
+}
+
+// Tests that messages of different types with the same content, which have
+// identical legacy digests, have different canonical digests.
+func TestDigestSignable_DomainSeparation(t *testing.T) {
+	nonce := []byte("nonce")
+	ndf := &NDF{}
+	clientError := &ClientError{}
+
+	legacyNdf := DigestSignable(ndf, LegacyDigest, nonce, crypto.SHA256.New())
+	legacyErr := DigestSignable(clientError, LegacyDigest, nonce,
+		crypto.SHA256.New())
+	if !bytes.Equal(legacyNdf, legacyErr) {
+		t.Fatalf("Expected legacy digests of empty messages to collide")
+	}
+
+	v1Ndf := DigestSignable(ndf, CanonicalDigestV1, nonce, crypto.SHA256.New())
+	v1Err := DigestSignable(clientError, CanonicalDigestV1, nonce,
+		crypto.SHA256.New())
+	if bytes.Equal(v1Ndf, v1Err) {
+		t.Errorf("Canonical digests of different types must differ")
+	}
+	if bytes.Equal(v1Ndf, legacyNdf) {
+		t.Errorf("Canonical and legacy digests must differ")
+	}
+}
+
+// Tests that the canonical digest does not depend on the field boundaries
+// of the content, unlike the legacy digest.
+func TestDigestSignable_FieldBoundaries(t *testing.T) {
+	a := &RoundError{NodeId: []byte("ab"), Error: "c"}
+	b := &RoundError{NodeId: []byte("a"), Error: "bc"}
+
+	if !bytes.Equal(DigestSignable(a, LegacyDigest, nil, crypto.SHA256.New()),
+		DigestSignable(b, LegacyDigest, nil, crypto.SHA256.New())) {
+		t.Fatalf("Expected legacy digests to collide")
+	}
+	if bytes.Equal(DigestSignable(a, CanonicalDigestV1, nil, crypto.SHA256.New()),
+		DigestSignable(b, CanonicalDigestV1, nil, crypto.SHA256.New())) {
+		t.Errorf("Canonical digests must not collide")
+	}
+}
+
+// Tests that Digest follows the signing version of the digest policy.
+func TestSetDigestPolicy_Digest(t *testing.T) {
+	defer resetDigestPolicy(t)
+	ri := &RoundInfo{ID: 5, Topology: [][]byte{[]byte("node")}}
+	nonce := []byte("nonce")
+
+	legacy := ri.legacyDigest(nonce, crypto.SHA256.New())
+	if !bytes.Equal(ri.Digest(nonce, crypto.SHA256.New()), legacy) {
+		t.Errorf("Default policy should sign with the legacy digest")
+	}
+
+	if err := SetDigestPolicy(CanonicalDigestV1, CanonicalDigestV1); err != nil {
+		t.Fatalf("SetDigestPolicy produced an error: %+v", err)
+	}
+	expected := DigestSignable(ri, CanonicalDigestV1, nonce, crypto.SHA256.New())
+	if !bytes.Equal(ri.Digest(nonce, crypto.SHA256.New()), expected) {
+		t.Errorf("Digest did not follow the signing version")
+	}
+}
+
+// Tests that SetDigestPolicy rejects a signing version it does not accept
+// and unknown versions.
+func TestSetDigestPolicy_Error(t *testing.T) {
+	defer resetDigestPolicy(t)
+	if err := SetDigestPolicy(CanonicalDigestV1, LegacyDigest); err == nil {
+		t.Errorf("Expected error when signing version is not accepted")
+	}
+	if err := SetDigestPolicy(LegacyDigest, LegacyDigest, 9); err == nil {
+		t.Errorf("Expected error for unknown digest version")
+	}
+}
+
+// Tests that VerifyRsa and VerifyEddsa accept signatures made at any
+// accepted version and reject those made at other versions.
+func TestVerify_DigestPolicy(t *testing.T) {
+	defer resetDigestPolicy(t)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("Failed to generate RSA key: %+v", err)
+	}
+	ecKey, err := ec.NewKeyPair(rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate EC key: %+v", err)
+	}
+
+	for _, version := range []DigestVersion{LegacyDigest, CanonicalDigestV1} {
+		ri := &RoundInfo{ID: 42, State: 3}
+		err = signature.SignRsa(RsaSignableAtVersion(ri, version), rsaKey)
+		if err != nil {
+			t.Fatalf("Failed to sign: %+v", err)
+		}
+		err = signature.SignEddsa(EccSignableAtVersion(ri, version), ecKey)
+		if err != nil {
+			t.Fatalf("Failed to sign: %+v", err)
+		}
+
+		if err = SetDigestPolicy(LegacyDigest, LegacyDigest,
+			CanonicalDigestV1); err != nil {
+			t.Fatalf("SetDigestPolicy produced an error: %+v", err)
+		}
+		if err = VerifyRsa(ri, rsaKey.GetPublic()); err != nil {
+			t.Errorf("Failed to verify RSA signature at version %d: %+v",
+				version, err)
+		}
+		if err = VerifyEddsa(ri, ecKey.GetPublic()); err != nil {
+			t.Errorf("Failed to verify EdDSA signature at version %d: %+v",
+				version, err)
+		}
+
+		// Only accept the other version
+		other := CanonicalDigestV1 - version
+		if err = SetDigestPolicy(other, other); err != nil {
+			t.Fatalf("SetDigestPolicy produced an error: %+v", err)
+		}
+		if VerifyRsa(ri, rsaKey.GetPublic()) == nil {
+			t.Errorf("RSA signature at version %d should not verify", version)
+		}
+		if VerifyEddsa(ri, ecKey.GetPublic()) == nil {
+			t.Errorf("EdDSA signature at version %d should not verify",
+				version)
+		}
+	}
+}
+
+// Tests that RegisterSignable panics on a duplicate type, a duplicate domain
+// and an excluded field which does not exist.
+func TestRegisterSignable_Panics(t *testing.T) {
+	tests := []struct {
+		name     string
+		msg      proto.Message
+		domain   string
+		excluded []string
+	}{
+		{"duplicate type", &RoundInfo{}, "xx.mixmessages.test", nil},
+		{"duplicate domain", &NDFHash{}, "xx.mixmessages.RoundInfo", nil},
+		{"empty domain", &NDFHash{}, "", nil},
+		{"unknown field", &NDFHash{}, "xx.mixmessages.test",
+			[]string{"NotAField"}},
+	}
+
+	for _, tt := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("RegisterSignable did not panic on %s", tt.name)
+				}
+			}()
+			RegisterSignable(tt.msg, tt.domain, tt.excluded...)
+		}()
+	}
+
+	if _, ok := getSignableSpec(&NDFHash{}); ok {
+		t.Errorf("Failed registration should not be added to the registry")
+	}
+}
+
+// resetDigestPolicy restores the default digest policy.
+func resetDigestPolicy(t *testing.T) {
+	err := SetDigestPolicy(LegacyDigest, LegacyDigest, CanonicalDigestV1)
+	if err != nil {
+		t.Fatalf("Failed to reset digest policy: %+v", err)
+	}
+}
@@ -0,0 +1,79 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the typed result of messages rejected by a gateway
+
+package mixmessages
+
+import (
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// SlotRejectedError is returned when a gateway rejects messages with a reason,
+// so the sender can decide whether and where to send them again.
+type SlotRejectedError struct {
+	Reason  SlotRejection
+	RoundID uint64
+
+	// Time to wait before sending again, zero if the gateway did not suggest
+	// one
+	RetryAfter time.Duration
+
+	// Round to send to instead, zero if the gateway did not suggest one
+	SuggestedRoundID uint64
+
+	// Description of the rejection from the gateway
+	Message string
+}
+
+// Error returns the reason and description of the rejection.
+func (e *SlotRejectedError) Error() string {
+	return fmt.Sprintf("gateway rejected messages for round %d (%s): %s",
+		e.RoundID, e.Reason, e.Message)
+}
+
+// SlotRejectionStatus returns the rejection in the response as a gRPC status
+// error carrying the response in its details. Senders which do not know of
+// rejection reasons still see the call fail, while those that do recover the
+// response with CheckSlotError.
+func SlotRejectionStatus(resp *GatewaySlotResponse) error {
+	code := codes.FailedPrecondition
+	if resp.GetRejection() == SlotRejection_RATE_LIMITED {
+		code = codes.ResourceExhausted
+	}
+	st, err := status.New(code, resp.GetError()).WithDetails(resp)
+	if err != nil {
+		return status.Error(code, resp.GetError())
+	}
+	return st.Err()
+}
+
+// CheckSlotError returns the response and a *SlotRejectedError if the error is
+// a rejection from SlotRejectionStatus. Any other error is returned unchanged.
+func CheckSlotError(err error) (*GatewaySlotResponse, error) {
+	st, ok := status.FromError(err)
+	if !ok || st == nil {
+		return nil, err
+	}
+	for _, detail := range st.Details() {
+		resp, ok := detail.(*GatewaySlotResponse)
+		if !ok || resp.GetRejection() == SlotRejection_NOT_REJECTED {
+			continue
+		}
+		return resp, &SlotRejectedError{
+			Reason:           resp.GetRejection(),
+			RoundID:          resp.GetRoundID(),
+			RetryAfter:       time.Duration(resp.GetRetryAfter()),
+			SuggestedRoundID: resp.GetSuggestedRoundID(),
+			Message:          resp.GetError(),
+		}
+	}
+	return nil, err
+}
@@ -0,0 +1,66 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package mixmessages
+
+import (
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+// Tests that a rejection from SlotRejectionStatus fails with a status error
+// and is recovered by CheckSlotError as a SlotRejectedError.
+func TestCheckSlotError(t *testing.T) {
+	resp := &GatewaySlotResponse{
+		RoundID:          5,
+		Rejection:        SlotRejection_ROUND_FULL,
+		RetryAfter:       int64(3 * time.Second),
+		SuggestedRoundID: 6,
+		Error:            "round is full",
+	}
+	err := SlotRejectionStatus(resp)
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Rejection does not fail with FailedPrecondition: %v", err)
+	}
+
+	// Send the status over the wire as gRPC does
+	received := status.FromProto(status.Convert(err).Proto()).Err()
+	decoded, err := CheckSlotError(received)
+	rejected, ok := err.(*SlotRejectedError)
+	if !ok {
+		t.Fatalf("Expected SlotRejectedError, received: %+v", err)
+	}
+	expected := &SlotRejectedError{
+		Reason:           SlotRejection_ROUND_FULL,
+		RoundID:          5,
+		RetryAfter:       3 * time.Second,
+		SuggestedRoundID: 6,
+		Message:          "round is full",
+	}
+	if *rejected != *expected {
+		t.Errorf("Unexpected rejection.\nexpected: %+v\nreceived: %+v",
+			expected, rejected)
+	}
+	if decoded.GetSuggestedRoundID() != 6 {
+		t.Errorf("Response not returned with the rejection: %+v", decoded)
+	}
+
+	rateLimited := SlotRejectionStatus(
+		&GatewaySlotResponse{Rejection: SlotRejection_RATE_LIMITED})
+	if status.Code(rateLimited) != codes.ResourceExhausted {
+		t.Errorf("Rate limit does not fail with ResourceExhausted: %v",
+			rateLimited)
+	}
+
+	other := errors.New("connection refused")
+	if decoded, err = CheckSlotError(other); err != other || decoded != nil {
+		t.Errorf("Other error not returned unchanged: %v", err)
+	}
+}
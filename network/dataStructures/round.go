@@ -11,7 +11,6 @@ import (
 	jww "github.com/spf13/jwalterweatherman"
 	pb "gitlab.com/elixxir/comms/mixmessages"
 	"gitlab.com/elixxir/primitives/states"
-	"gitlab.com/xx_network/comms/signature"
 	"gitlab.com/xx_network/crypto/signature/ec"
 	"gitlab.com/xx_network/crypto/signature/rsa"
 	"sync/atomic"
@@ -61,14 +60,14 @@ func (r *Round) Get() *pb.RoundInfo {
 	if atomic.LoadUint32(r.needsValidation) == 0 {
 		if r.rsaPubKey != nil {
 			// Check the sig, panic if failure
-			err := signature.VerifyRsa(r.info, r.rsaPubKey)
+			err := pb.VerifyRsa(r.info, r.rsaPubKey)
 			if err != nil {
 				jww.FATAL.Panicf("Could not validate "+
 					"the roundInfo signature: %+v: %v", r.info, err)
 			}
 		} else {
 			// Check the sig, panic if failure
-			err := signature.VerifyEddsa(r.info, r.ecPubKey)
+			err := pb.VerifyEddsa(r.info, r.ecPubKey)
 			if err != nil {
 				jww.FATAL.Panicf("Could not validate "+
 					"the roundInfo signature: %+v: %v", r.info, err)
@@ -19,8 +19,8 @@ import (
 	"gitlab.com/elixxir/crypto/cyclic"
 	"gitlab.com/elixxir/primitives/states"
 	"gitlab.com/xx_network/comms/connect"
-	"gitlab.com/xx_network/comms/signature"
 	"gitlab.com/xx_network/crypto/signature/ec"
+	"gitlab.com/xx_network/crypto/tls"
 	"gitlab.com/xx_network/primitives/id"
 	"gitlab.com/xx_network/primitives/ndf"
 	"gitlab.com/xx_network/primitives/netTime"
@@ -522,7 +522,7 @@ func (i *Instance) RoundUpdate(info *pb.RoundInfo) (*ds.Round, error) {
 	}
 
 	if i.validationLevel == Strict {
-		err := signature.VerifyRsa(info, perm.GetPubKey())
+		err := pb.VerifyRsa(info, perm.GetPubKey())
 		if err != nil {
 			return nil, errors.WithMessage(err, fmt.Sprintf("Could not validate "+
 				"the roundInfo signature: %+v", info))
@@ -688,6 +688,13 @@ func (i *Instance) updateConns(def *ndf.NetworkDefinition, isGateway, isNode boo
 			//check if an ip override is registered
 			addr := i.ipOverride.CheckOverride(gwid, gateway.Address)
 
+			// If this entity is a gateway, other gateway hosts
+			// should have auth enabled. Otherwise, disable auth
+			gwParams := connect.GetDefaultHostParams()
+			gwParams.MaxRetries = 3
+			gwParams.EnableCoolOff = true
+			gwParams.AuthEnabled = i.gatewayAuth
+
 			//check if the host exists
 			host, ok := i.comm.GetHost(gwid)
 			if !ok {
@@ -698,12 +705,6 @@ func (i *Instance) updateConns(def *ndf.NetworkDefinition, isGateway, isNode boo
 						"hard coded ID. Invalid ID: %v", gwid.Marshal())
 				}
 
-				// If this entity is a gateway, other gateway hosts
-				// should have auth enabled. Otherwise, disable auth
-				gwParams := connect.GetDefaultHostParams()
-				gwParams.MaxRetries = 3
-				gwParams.EnableCoolOff = true
-				gwParams.AuthEnabled = i.gatewayAuth
 				_, err := i.comm.AddHost(gwid, addr, []byte(gateway.TlsCertificate), gwParams)
 				if err != nil {
 					return errors.WithMessagef(err, "Could not add gateway host %s", gwid)
@@ -723,6 +724,11 @@ func (i *Instance) updateConns(def *ndf.NetworkDefinition, isGateway, isNode boo
 					}
 				}
 
+			} else if hostCertChanged(host, gateway.TlsCertificate) {
+				_, err = i.replaceHost(host, addr, gateway.TlsCertificate, gwParams)
+				if err != nil {
+					return errors.WithMessagef(err, "Could not replace gateway host %s", gwid)
+				}
 			} else if host.GetAddress() != addr {
 				host.UpdateAddress(addr)
 			}
@@ -769,6 +775,12 @@ func (i *Instance) updateConns(def *ndf.NetworkDefinition, isGateway, isNode boo
 					}
 				}
 
+			} else if hostCertChanged(host, node.TlsCertificate) {
+				host, err = i.replaceHost(host, addr, node.TlsCertificate, connect.GetDefaultHostParams())
+				if err != nil {
+					return errors.WithMessagef(err, "Could not replace isNode host %s", nid)
+				}
+				host.SetWindowSize(connect.MaxWindowSize)
 			} else if host.GetAddress() != addr {
 				host.UpdateAddress(addr)
 			}
@@ -777,6 +789,40 @@ func (i *Instance) updateConns(def *ndf.NetworkDefinition, isGateway, isNode boo
 	return nil
 }
 
+// hostCertChanged returns true if the certificate differs from the one the
+// host currently uses, comparing the public keys they carry. Hosts added
+// before their node rotated its credentials, including those not added from
+// the ndf, are therefore replaced.
+func hostCertChanged(host *connect.Host, cert string) bool {
+	current := host.GetPubKey()
+	if cert == "" {
+		return current != nil
+	}
+	key, err := tls.NewPublicKeyFromPEM([]byte(cert))
+	if err != nil || current == nil {
+		// Replacing the host reports an invalid certificate
+		return true
+	}
+	return !bytes.Equal(current.Bytes(), key.Bytes())
+}
+
+// replaceHost disconnects the host and replaces it with one connecting with
+// the rotated certificate
+func (i *Instance) replaceHost(host *connect.Host, addr, cert string,
+	params connect.HostParams) (*connect.Host, error) {
+	hid := host.GetId()
+	host.Disconnect()
+	i.comm.RemoveHost(hid)
+
+	newHost, err := i.comm.AddHost(hid, addr, []byte(cert), params)
+	if err != nil {
+		return nil, err
+	}
+
+	jww.INFO.Printf("Replaced host %s with its rotated certificate", hid)
+	return newHost, nil
+}
+
 // SetGatewayAuth will force authentication on all communications with gateways
 // intended for use between Gateway <-> Gateway communications
 func (i *Instance) SetGatewayAuthentication() {
@@ -543,6 +543,81 @@ func TestInstance_UpdateGatewayConnections_GatewayIdError(t *testing.T) {
 	}
 }
 
+// Tests that UpdateNodeConnections and UpdateGatewayConnections replace the
+// hosts of a node whose certificates have been rotated in the ndf, including
+// hosts which existed before the instance first saw the ndf, and keep the
+// hosts of nodes whose certificates are unchanged.
+func TestInstance_UpdateConnections_RotatedCertificate(t *testing.T) {
+	def, err := ndf.Unmarshal(testutils.ExampleNDF)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal ndf: %+v", err)
+	}
+	secured, _ := NewSecuredNdf(def)
+	pc := &connect.ProtoComms{
+		Manager: connect.NewManagerTesting(t),
+	}
+	i := Instance{
+		full:       secured,
+		comm:       pc,
+		ipOverride: ds.NewIpOverrideList(),
+	}
+	update := func() {
+		if err = i.UpdateNodeConnections(); err != nil {
+			t.Fatalf("Failed to update node connections: %+v", err)
+		}
+		if err = i.UpdateGatewayConnections(); err != nil {
+			t.Fatalf("Failed to update gateway connections: %+v", err)
+		}
+	}
+
+	// A host added before the ndf with a certificate since rotated
+	staleID, _ := id.Unmarshal(def.Nodes[2].ID)
+	staleHost, err := pc.AddHost(staleID, def.Nodes[2].Address,
+		testkeys.GetGatewayCert(), connect.GetDefaultHostParams())
+	if err != nil {
+		t.Fatalf("Failed to add host: %+v", err)
+	}
+	update()
+	if host, _ := pc.GetHost(staleID); host == staleHost {
+		t.Errorf("Host existing before the ndf was not replaced")
+	}
+
+	nid, _ := id.Unmarshal(def.Nodes[0].ID)
+	gwid := nid.DeepCopy()
+	gwid.SetType(id.Gateway)
+	otherID, _ := id.Unmarshal(def.Nodes[1].ID)
+	nodeHost, _ := pc.GetHost(nid)
+	gatewayHost, _ := pc.GetHost(gwid)
+	otherHost, _ := pc.GetHost(otherID)
+
+	// Rotate the certificates of the first node
+	rotated, err := ndf.Unmarshal(testutils.ExampleNDF)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal ndf: %+v", err)
+	}
+	rotated.Nodes[0].TlsCertificate = string(testkeys.GetGatewayCert())
+	rotated.Gateways[0].TlsCertificate = string(testkeys.GetNodeCert())
+	i.full, _ = NewSecuredNdf(rotated)
+	update()
+
+	if host, _ := pc.GetHost(nid); host == nodeHost {
+		t.Errorf("Node host was not replaced after its certificate rotated")
+	}
+	if host, _ := pc.GetHost(gwid); host == gatewayHost {
+		t.Errorf("Gateway host was not replaced after its certificate rotated")
+	}
+	if host, _ := pc.GetHost(otherID); host != otherHost {
+		t.Errorf("Host of a node with an unchanged certificate was replaced")
+	}
+
+	// Updating again with the same certificates keeps the new hosts
+	nodeHost, _ = pc.GetHost(nid)
+	update()
+	if host, _ := pc.GetHost(nid); host != nodeHost {
+		t.Errorf("Node host was replaced without its certificate changing")
+	}
+}
+
 func TestInstance_UpdateNodeConnections(t *testing.T) {
 	secured, _ := NewSecuredNdf(testutils.NDF)
 	testManager := connect.NewManagerTesting(t)
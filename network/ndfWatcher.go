@@ -0,0 +1,155 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Keeps an NDF current by watching the NDFs streamed by permissioning
+
+package network
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/ndf"
+	"sync"
+	"time"
+)
+
+// NdfWatcherParams configures an NdfWatcher.
+type NdfWatcherParams struct {
+	// Time waited before watching again after the stream fails. It doubles
+	// with each consecutive failure, up to MaxBackoff.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// DefaultNdfWatcherParams returns the default NdfWatcherParams.
+func DefaultNdfWatcherParams() NdfWatcherParams {
+	return NdfWatcherParams{
+		MinBackoff: 250 * time.Millisecond,
+		MaxBackoff: 30 * time.Second,
+	}
+}
+
+// NdfWatcher keeps an NDF current by streaming each new NDF from
+// permissioning, verifying its signature against the key of the host. The
+// stream is reopened with backoff whenever it fails.
+type NdfWatcher struct {
+	comms  *connect.ProtoComms
+	host   *connect.Host
+	params NdfWatcherParams
+	ndf    *SecuredNdf
+
+	mux       sync.Mutex
+	callbacks []func(def *ndf.NetworkDefinition)
+}
+
+// NewNdfWatcher returns an NdfWatcher for the NDFs streamed by the
+// permissioning host, starting from the current NDF, which may be nil.
+func NewNdfWatcher(comms *connect.ProtoComms, host *connect.Host,
+	current *ndf.NetworkDefinition, params NdfWatcherParams) (
+	*NdfWatcher, error) {
+	secured, err := NewSecuredNdf(current)
+	if err != nil {
+		return nil, err
+	}
+	return &NdfWatcher{
+		comms:  comms,
+		host:   host,
+		params: params,
+		ndf:    secured,
+	}, nil
+}
+
+// Get returns the current NDF. It is nil until the first NDF is received if
+// the watcher was created without one.
+func (w *NdfWatcher) Get() *ndf.NetworkDefinition {
+	return w.ndf.Get()
+}
+
+// OnUpdate registers a function called with each new NDF received.
+func (w *NdfWatcher) OnUpdate(f func(def *ndf.NetworkDefinition)) {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	w.callbacks = append(w.callbacks, f)
+}
+
+// Start watches for new NDFs until the returned function is called.
+func (w *NdfWatcher) Start() (stop func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		backoff := w.params.MinBackoff
+		for {
+			received, err := w.watch(ctx)
+			if ctx.Err() != nil {
+				return
+			}
+			if received {
+				backoff = w.params.MinBackoff
+			}
+			jww.WARN.Printf("Watching NDF from %s failed, retrying in %s: %+v",
+				w.host.GetId(), backoff, err)
+
+			select {
+			case <-time.After(backoff):
+			case <-ctx.Done():
+				return
+			}
+			if backoff *= 2; backoff > w.params.MaxBackoff {
+				backoff = w.params.MaxBackoff
+			}
+		}
+	}()
+	return cancel
+}
+
+// watch opens a stream of NDFs and updates the current NDF with each one
+// received until the stream fails. Returns true if any NDF was received.
+func (w *NdfWatcher) watch(ctx context.Context) (bool, error) {
+	f := func(conn connect.Connection) (interface{}, error) {
+		// Pack message into an authenticated message
+		authMsg, err := w.comms.PackAuthenticatedMessage(
+			&pb.NDFHash{Hash: w.ndf.GetHash()}, w.host, false)
+		if err != nil {
+			return nil, errors.New(err.Error())
+		}
+		// Send the message
+		clientStream, err := pb.NewRegistrationClient(conn.GetGrpcConn()).
+			WatchNdf(ctx, authMsg)
+		if err != nil {
+			return nil, errors.New(err.Error())
+		}
+		return clientStream, nil
+	}
+
+	resultClient, err := w.comms.Stream(w.host, f)
+	if err != nil {
+		return false, err
+	}
+	stream := resultClient.(pb.Registration_WatchNdfClient)
+
+	received := false
+	for {
+		m, err := stream.Recv()
+		if err != nil {
+			return received, err
+		}
+		if err = w.ndf.update(m, w.host.GetPubKey()); err != nil {
+			return received, err
+		}
+		received = true
+
+		jww.INFO.Printf("Received new NDF from %s", w.host.GetId())
+		w.mux.Lock()
+		callbacks := w.callbacks
+		w.mux.Unlock()
+		for _, callback := range callbacks {
+			callback(w.ndf.Get())
+		}
+	}
+}
@@ -13,7 +13,6 @@ import (
 	"github.com/pkg/errors"
 	pb "gitlab.com/elixxir/comms/mixmessages"
 	ds "gitlab.com/elixxir/comms/network/dataStructures"
-	"gitlab.com/xx_network/comms/signature"
 	"gitlab.com/xx_network/crypto/signature/rsa"
 	"gitlab.com/xx_network/primitives/ndf"
 )
@@ -37,7 +36,7 @@ func NewSecuredNdf(definition *ndf.NetworkDefinition) (*SecuredNdf, error) {
 
 // unexported NDF update code
 func (sndf *SecuredNdf) update(m *pb.NDF, key *rsa.PublicKey) error {
-	err := signature.VerifyRsa(m, key)
+	err := pb.VerifyRsa(m, key)
 	if err != nil {
 		return errors.WithMessage(err, "Could not validate NDF")
 	}
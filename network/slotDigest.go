@@ -8,6 +8,7 @@
 package network
 
 import (
+	"crypto"
 	"encoding/binary"
 	"gitlab.com/elixxir/comms/mixmessages"
 )
@@ -33,3 +34,37 @@ func GenerateSlotDigest(gatewaySlot *mixmessages.GatewaySlot) []byte {
 	return gatewaySlotDigest
 
 }
+
+// GenerateReplicaSlotDigest hashes the slot of a round with its index in the
+// batch, for the digests of a mixmessages.RoundReplica. Every field is
+// prefixed with its length, so bytes moved between fields change the digest.
+func GenerateReplicaSlotDigest(roundID uint64, slot *mixmessages.Slot) []byte {
+	h := crypto.SHA256.New()
+	writeField := func(field []byte) {
+		length := make([]byte, 8)
+		binary.BigEndian.PutUint64(length, uint64(len(field)))
+		h.Write(length)
+		h.Write(field)
+	}
+
+	writeField(slot.SenderID)
+	writeField(slot.PayloadA)
+	writeField(slot.PayloadB)
+
+	numKMACs := make([]byte, 8)
+	binary.BigEndian.PutUint64(numKMACs, uint64(len(slot.KMACs)))
+	writeField(numKMACs)
+	for _, kmac := range slot.KMACs {
+		writeField(kmac)
+	}
+
+	roundIdBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(roundIdBytes, roundID)
+	writeField(roundIdBytes)
+
+	indexBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(indexBytes, slot.Index)
+	writeField(indexBytes)
+
+	return h.Sum(nil)
+}
@@ -137,4 +137,60 @@ func TestGenerateSlotDigest_Inconsistency(t *testing.T) {
 	}
 }
 
+// Tests that GenerateReplicaSlotDigest differs for the same slot at a
+// different index in the batch
+func TestGenerateReplicaSlotDigest(t *testing.T) {
+	slot := &mixmessages.Slot{
+		Index:    3,
+		PayloadA: []byte("payloadA"),
+		PayloadB: []byte("payloadB"),
+		SenderID: []byte("senderId"),
+	}
+
+	digest := GenerateReplicaSlotDigest(42, slot)
+	if len(digest) != 32 {
+		t.Errorf("GenerateReplicaSlotDigest returned %d bytes, expected 32",
+			len(digest))
+	}
+	if !reflect.DeepEqual(digest, GenerateReplicaSlotDigest(42, slot)) {
+		t.Errorf("GenerateReplicaSlotDigest outputted different results " +
+			"with identical input.")
+	}
+
+	moved := &mixmessages.Slot{
+		Index:    4,
+		PayloadA: slot.PayloadA,
+		PayloadB: slot.PayloadB,
+		SenderID: slot.SenderID,
+	}
+	if reflect.DeepEqual(digest, GenerateReplicaSlotDigest(42, moved)) {
+		t.Errorf("GenerateReplicaSlotDigest outputted identical results " +
+			"for different indices.")
+	}
+}
+
+// Tests that GenerateReplicaSlotDigest differs when bytes are moved between
+// the fields of the slot
+func TestGenerateReplicaSlotDigest_FieldBoundaries(t *testing.T) {
+	slots := []*mixmessages.Slot{
+		{SenderID: []byte("ab"), PayloadA: []byte("c")},
+		{SenderID: []byte("a"), PayloadA: []byte("bc")},
+		{PayloadA: []byte("ab"), PayloadB: []byte("c")},
+		{PayloadB: []byte("abc")},
+		{PayloadB: []byte("ab"), KMACs: [][]byte{[]byte("c")}},
+		{KMACs: [][]byte{[]byte("abc")}},
+		{KMACs: [][]byte{[]byte("a"), []byte("bc")}},
+		{KMACs: [][]byte{[]byte("a"), []byte("bc"), {}}},
+	}
+
+	digests := make(map[string]int)
+	for i, slot := range slots {
+		digest := string(GenerateReplicaSlotDigest(42, slot))
+		if j, ok := digests[digest]; ok {
+			t.Errorf("Slots %d and %d have the same digest", j, i)
+		}
+		digests[digest] = i
+	}
+}
+
 var precannedGatewayDigest = []byte{115, 101, 110, 100, 101, 114, 73, 100, 112, 97, 121, 108, 111, 97, 100, 65, 112, 97, 121, 108, 111, 97, 100, 66, 107, 109, 97, 99, 49, 107, 109, 97, 99, 50, 0, 0, 0, 0, 0, 0, 44, 156}
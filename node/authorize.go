@@ -0,0 +1,197 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the authorization policy enforced on node endpoints before their
+// handlers are called
+
+package node
+
+import (
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/primitives/id"
+	"golang.org/x/net/context"
+	"strings"
+	"sync"
+)
+
+// TestingOnlyDisableAuthorization disables the enforcement of the
+// authorization policy so tests can call endpoints without authenticating.
+var TestingOnlyDisableAuthorization = false
+
+// SenderRole is a set of kinds of senders which may call an endpoint.
+type SenderRole uint8
+
+const (
+	// Permissioning is the permissioning server.
+	Permissioning SenderRole = 1 << iota
+
+	// Node is any node.
+	Node
+
+	// TeamNode is a node in the topology of the current round, as reported by
+	// the TopologyChecker.
+	TeamNode
+
+	// OwnGateway is the gateway of this node.
+	OwnGateway
+)
+
+// String returns the names of the roles in the set.
+func (r SenderRole) String() string {
+	names := []string{"Permissioning", "Node", "TeamNode", "OwnGateway"}
+	var roles []string
+	for i, name := range names {
+		if r&(1<<uint(i)) != 0 {
+			roles = append(roles, name)
+		}
+	}
+	if len(roles) == 0 {
+		return "None"
+	}
+	return strings.Join(roles, "|")
+}
+
+// AuthorizationPolicy lists the senders permitted to call each authenticated
+// endpoint. Endpoints which are not listed may not be called by anyone.
+type AuthorizationPolicy map[string]SenderRole
+
+// DefaultAuthorizationPolicy returns the policy enforced on node endpoints
+// unless replaced with SetAuthorizationPolicy.
+func DefaultAuthorizationPolicy() AuthorizationPolicy {
+	return AuthorizationPolicy{
+		"AskOnline":          Node,
+		"CreateNewRound":     TeamNode,
+		"UploadUnmixedBatch": OwnGateway,
+		"FinishRealtime":     TeamNode,
+		"PrecompTestBatch":   TeamNode,
+		"PostPhase":          TeamNode,
+		"StreamPostPhase":    TeamNode,
+		"GetRoundBufferInfo": OwnGateway,
+		"RequestClientKey":   OwnGateway,
+		"PostPrecompResult":  TeamNode,
+		"GetMeasure":         TeamNode,
+		"Poll":               OwnGateway,
+		"DownloadMixedBatch": OwnGateway,
+		"SendRoundTripPing":  TeamNode,
+		"RoundError":         TeamNode,
+		"StartSharePhase":    TeamNode,
+		"SharePhaseRound":    TeamNode,
+		"ShareFinalKey":      TeamNode,
+	}
+}
+
+// TopologyChecker reports whether the node is in the topology of the current
+// round.
+type TopologyChecker func(nodeID *id.ID) bool
+
+// authorizer enforces the authorization policy of the node.
+type authorizer struct {
+	mux      sync.RWMutex
+	policy   AuthorizationPolicy
+	topology TopologyChecker
+}
+
+// SetAuthorizationPolicy replaces the authorization policy enforced on the
+// endpoints of the node.
+func (s *Comms) SetAuthorizationPolicy(policy AuthorizationPolicy) {
+	s.authorizer.mux.Lock()
+	defer s.authorizer.mux.Unlock()
+	s.authorizer.policy = make(AuthorizationPolicy, len(policy))
+	for endpoint, roles := range policy {
+		s.authorizer.policy[endpoint] = roles
+	}
+}
+
+// SetTopologyChecker sets the check deciding which nodes are TeamNode
+// senders. Until it is set, every authenticated node is a TeamNode sender.
+func (s *Comms) SetTopologyChecker(topology TopologyChecker) {
+	s.authorizer.mux.Lock()
+	defer s.authorizer.mux.Unlock()
+	s.authorizer.topology = topology
+}
+
+// authorizedReceiver authenticates the message like AuthenticatedReceiver
+// and returns an error if the sender is not permitted to call the endpoint.
+func (s *Comms) authorizedReceiver(endpoint string,
+	msg *messages.AuthenticatedMessage, ctx context.Context) (
+	*connect.Auth, error) {
+	auth, err := s.AuthenticatedReceiver(msg, ctx)
+	if err != nil {
+		return nil, errors.Errorf("Unable handles reception of "+
+			"AuthenticatedMessage: %+v", err)
+	}
+
+	return auth, s.authorize(endpoint, auth)
+}
+
+// authorize returns an error if the sender is not permitted to call the
+// endpoint. Rejections are logged for auditing.
+func (s *Comms) authorize(endpoint string, auth *connect.Auth) error {
+	if TestingOnlyDisableAuthorization {
+		return nil
+	}
+
+	s.authorizer.mux.RLock()
+	roles, ok := s.authorizer.policy[endpoint]
+	topology := s.authorizer.topology
+	s.authorizer.mux.RUnlock()
+
+	sender := auth.Sender.GetId()
+	if !ok {
+		jww.WARN.Printf("[AUDIT] Rejected %s from %s at %s: endpoint has no "+
+			"authorization policy", endpoint, sender, auth.IpAddress)
+		return errors.Errorf("Endpoint %s has no authorization policy",
+			endpoint)
+	}
+
+	if !auth.IsAuthenticated {
+		jww.WARN.Printf("[AUDIT] Rejected %s from %s at %s: sender is not "+
+			"authenticated: %s", endpoint, sender, auth.IpAddress, auth.Reason)
+		return connect.AuthError(sender)
+	}
+
+	if s.senderRoles(sender, topology)&roles == 0 {
+		jww.WARN.Printf("[AUDIT] Rejected %s from %s at %s: sender is not "+
+			"one of %s", endpoint, sender, auth.IpAddress, roles)
+		return errors.Errorf("Sender %s is not authorized to call %s",
+			sender, endpoint)
+	}
+
+	return nil
+}
+
+// senderRoles returns the roles held by the authenticated sender.
+func (s *Comms) senderRoles(sender *id.ID,
+	topology TopologyChecker) SenderRole {
+	var roles SenderRole
+	if sender == nil {
+		return roles
+	}
+
+	if sender.Cmp(&id.Permissioning) {
+		roles |= Permissioning
+	}
+
+	switch sender.GetType() {
+	case id.Node:
+		roles |= Node
+		if topology == nil || topology(sender) {
+			roles |= TeamNode
+		}
+	case id.Gateway:
+		gatewayID := s.GetId()
+		gatewayID.SetType(id.Gateway)
+		if sender.Cmp(gatewayID) {
+			roles |= OwnGateway
+		}
+	}
+
+	return roles
+}
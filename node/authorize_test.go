@@ -0,0 +1,125 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package node
+
+import (
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
+	"testing"
+)
+
+// newAuthorizeTestComms returns a Comms for the node enforcing the default
+// authorization policy, without starting a server.
+func newAuthorizeTestComms(nodeID *id.ID, t *testing.T) *Comms {
+	pc, err := connect.CreateCommClient(nodeID, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Unable to create comms: %+v", err)
+	}
+	return &Comms{
+		ProtoComms: pc,
+		authorizer: authorizer{policy: DefaultAuthorizationPolicy()},
+	}
+}
+
+// Tests that authorize permits only the senders listed in the policy for
+// each endpoint.
+func TestComms_authorize(t *testing.T) {
+	TestingOnlyDisableAuthorization = false
+	defer func() { TestingOnlyDisableAuthorization = true }()
+
+	nodeID := id.NewIdFromString("node", id.Node, t)
+	server := newAuthorizeTestComms(nodeID, t)
+
+	teamNode := id.NewIdFromString("team", id.Node, t)
+	otherNode := id.NewIdFromString("other", id.Node, t)
+	ownGateway := nodeID.DeepCopy()
+	ownGateway.SetType(id.Gateway)
+	otherGateway := id.NewIdFromString("other", id.Gateway, t)
+	server.SetTopologyChecker(func(nodeID *id.ID) bool {
+		return nodeID.Cmp(teamNode)
+	})
+
+	tests := []struct {
+		endpoint      string
+		sender        *id.ID
+		authenticated bool
+		allowed       bool
+	}{
+		{"PostPhase", teamNode, true, true},
+		{"PostPhase", otherNode, true, false},
+		{"PostPhase", teamNode, false, false},
+		{"PostPhase", ownGateway, true, false},
+		{"AskOnline", otherNode, true, true},
+		{"AskOnline", &id.Permissioning, true, false},
+		{"Poll", ownGateway, true, true},
+		{"Poll", otherGateway, true, false},
+		{"Poll", teamNode, true, false},
+		{"NotAnEndpoint", teamNode, true, false},
+	}
+
+	for _, tt := range tests {
+		host, err := connect.NewHost(tt.sender, "0.0.0.0:0", nil,
+			connect.GetDefaultHostParams())
+		if err != nil {
+			t.Fatalf("Unable to create host: %+v", err)
+		}
+		auth := &connect.Auth{IsAuthenticated: tt.authenticated, Sender: host}
+
+		err = server.authorize(tt.endpoint, auth)
+		if tt.allowed && err != nil {
+			t.Errorf("%s from %s should be allowed: %+v",
+				tt.endpoint, tt.sender, err)
+		} else if !tt.allowed && err == nil {
+			t.Errorf("%s from %s (authenticated: %t) should be rejected",
+				tt.endpoint, tt.sender, tt.authenticated)
+		}
+	}
+}
+
+// Tests that SetAuthorizationPolicy replaces the policy enforced and that
+// TeamNode permits any node before a topology checker is set.
+func TestComms_SetAuthorizationPolicy(t *testing.T) {
+	TestingOnlyDisableAuthorization = false
+	defer func() { TestingOnlyDisableAuthorization = true }()
+
+	nodeID := id.NewIdFromString("node", id.Node, t)
+	server := newAuthorizeTestComms(nodeID, t)
+
+	host, err := connect.NewHost(&id.Permissioning, "0.0.0.0:0", nil,
+		connect.GetDefaultHostParams())
+	if err != nil {
+		t.Fatalf("Unable to create host: %+v", err)
+	}
+	permissioning := &connect.Auth{IsAuthenticated: true, Sender: host}
+	host, err = connect.NewHost(id.NewIdFromString("other", id.Node, t),
+		"0.0.0.0:0", nil, connect.GetDefaultHostParams())
+	if err != nil {
+		t.Fatalf("Unable to create host: %+v", err)
+	}
+	node := &connect.Auth{IsAuthenticated: true, Sender: host}
+
+	if err = server.authorize("CreateNewRound", node); err != nil {
+		t.Errorf("Any node should be a team node without a topology "+
+			"checker: %+v", err)
+	}
+	if server.authorize("CreateNewRound", permissioning) == nil {
+		t.Errorf("Permissioning should not be allowed by the default policy")
+	}
+
+	policy := DefaultAuthorizationPolicy()
+	policy["CreateNewRound"] = Permissioning
+	server.SetAuthorizationPolicy(policy)
+
+	if err = server.authorize("CreateNewRound", permissioning); err != nil {
+		t.Errorf("Permissioning should be allowed by the new policy: %+v",
+			err)
+	}
+	if server.authorize("CreateNewRound", node) == nil {
+		t.Errorf("Nodes should not be allowed by the new policy")
+	}
+}
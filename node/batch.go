@@ -20,7 +20,6 @@ import (
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/comms/messages"
 	"google.golang.org/grpc/metadata"
-	"io"
 )
 
 // ------------------------- PrecompTestBatchBroadcast Logic ---------------------------------------- //
@@ -29,63 +28,24 @@ import (
 // sending the completed batch of PrecompTestBatch, testing for connectivity.
 func (s *Comms) StreamPrecompTestBatch(host *connect.Host, info *pb.RoundInfo,
 	mockBatch *pb.CompletedBatch) error {
-	// Retrieve the streaming service
-	streamingClient, cancel, err := s.getPrecompTestBatchStreamClient(host, info)
-	if err != nil {
-		return errors.Errorf("Could not retrieve steaming service: %v",
-			err)
-	}
+	ctx, cancel := s.getPrecompTestBatchContext(info)
 	defer cancel()
 
-	// Stream each slot
-	for i, slot := range mockBatch.Slots {
-		if err = streamingClient.Send(slot); err != nil {
-			if err == io.EOF {
-				// Attempt to read an error
-				eofAck, eofErr := streamingClient.CloseAndRecv()
-				if eofErr != nil {
-					err = errors.Wrap(err, eofErr.Error())
-				} else {
-					err = errors.Wrap(err, eofAck.Error)
-				}
-			}
-			return errors.Errorf("Could not stream slot (%d/%d) "+
-				"for round %d: %v", i, len(mockBatch.Slots), info.ID, err)
-		}
+	open := func(ctx context.Context) (pb.SlotStreamClient, error) {
+		return s.getPrecompTestBatchStream(host, ctx)
 	}
 
-	// Receive ack and cancel client streaming context
-	ack, err := streamingClient.CloseAndRecv()
+	// Stream each slot
+	_, err := s.batchSharding.SendSlots(ctx, host.GetId().String(), open,
+		mockBatch.Slots)
 	if err != nil {
-		return errors.Errorf("Could not receive final "+
-			"acknowledgement on streaming batch: %v", err)
-	}
-
-	if ack != nil && ack.Error != "" {
-		return errors.Errorf("Remote Server Error: %v", ack.Error)
+		return errors.Errorf("Could not stream batch of %d slots for "+
+			"round %d: %v", len(mockBatch.Slots), info.ID, err)
 	}
 
 	return nil
 }
 
-// getPrecompTestBatchStreamClient gets the streaming client
-// using a header and returns the stream and the cancel context
-// if there are no connection errors.
-func (s *Comms) getPrecompTestBatchStreamClient(host *connect.Host,
-	info *pb.RoundInfo) (pb.Node_PrecompTestBatchClient,
-	context.CancelFunc, error) {
-
-	ctx, cancel := s.getPrecompTestBatchContext(info)
-
-	streamClient, err := s.getPrecompTestBatchStream(host, ctx)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return streamClient, cancel, nil
-
-}
-
 // getPrecompTestBatchContext is given roundInfo as a header,
 // and creates a streaming context. It adds the header to the context
 // and returns the context with the header and a cancel func.
@@ -143,9 +103,9 @@ func (s *Comms) PrecompTestBatch(stream pb.Node_PrecompTestBatchServer) error {
 		return errors.Errorf("Unable to extract authentication info: %+v", err)
 	}
 
-	authState, err := s.AuthenticatedReceiver(authMsg, stream.Context())
+	authState, err := s.authorizedReceiver("PrecompTestBatch", authMsg, stream.Context())
 	if err != nil {
-		return errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
+		return err
 	}
 
 	// Unmarshall the any message to the message type needed
@@ -154,7 +114,11 @@ func (s *Comms) PrecompTestBatch(stream pb.Node_PrecompTestBatchServer) error {
 		return errors.WithMessage(err, "Could not get test batch stream header")
 	}
 
-	return s.handler.PrecompTestBatch(stream, info, authState)
+	// Handle once every shard of the batch has arrived and been verified
+	return s.receiveBatch(stream, authState,
+		func(stream pb.SlotStreamServer) error {
+			return s.handler.PrecompTestBatch(stream, info, authState)
+		})
 }
 
 // GetPrecompTestBatchStreamHeader gets the header in the metadata from
@@ -190,13 +154,16 @@ func (s *Comms) UploadUnmixedBatch(server pb.Node_UploadUnmixedBatchServer) erro
 		return errors.Errorf("Unable to extract authentication info: %+v", err)
 	}
 
-	authState, err := s.AuthenticatedReceiver(authMsg, server.Context())
+	authState, err := s.authorizedReceiver("UploadUnmixedBatch", authMsg, server.Context())
 	if err != nil {
-		return errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
+		return err
 	}
 
-	// Verify the message authentication
-	return s.handler.UploadUnmixedBatch(server, authState)
+	// Handle once every shard of the batch has arrived and been verified
+	return s.receiveBatch(server, authState,
+		func(stream pb.SlotStreamServer) error {
+			return s.handler.UploadUnmixedBatch(stream, authState)
+		})
 }
 
 // GetUnmixedBatchStreamHeader gets the header in the metadata from
@@ -225,13 +192,14 @@ func GetUnmixedBatchStreamHeader(stream pb.Node_UploadUnmixedBatchServer) (*pb.B
 
 // ------------------------- DownloadMixedBatch Logic ---------------------------------------- //
 
-// DownloadMixedBatch streams the slots in the completed batch to the gateway
+// DownloadMixedBatch streams the slots in the completed batch to the gateway,
+// starting from the slot at the StartIndex of the request
 func (s *Comms) DownloadMixedBatch(authMsg *messages.AuthenticatedMessage,
 	stream pb.Node_DownloadMixedBatchServer) error {
 
-	authState, err := s.AuthenticatedReceiver(authMsg, stream.Context())
+	authState, err := s.authorizedReceiver("DownloadMixedBatch", authMsg, stream.Context())
 	if err != nil {
-		return errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
+		return err
 	}
 
 	// Unmarshall the any message to the message type needed
@@ -241,5 +209,13 @@ func (s *Comms) DownloadMixedBatch(authMsg *messages.AuthenticatedMessage,
 		return err
 	}
 
-	return s.handler.DownloadMixedBatch(stream, batchInfo, authState)
+	// Commit to the slots sent so the gateway can verify the batch. The
+	// handler sends the whole batch; slots before the start index are left out
+	// of the stream but kept in the commitment.
+	committingStream := pb.NewCommittingSlotStream(stream, batchInfo.StartIndex)
+	err = s.handler.DownloadMixedBatch(committingStream, batchInfo, authState)
+	if err != nil {
+		return err
+	}
+	return committingStream.SetCommitmentTrailer()
 }
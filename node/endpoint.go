@@ -21,14 +21,9 @@ import (
 // Handle a Broadcasted Ask Online event
 func (s *Comms) AskOnline(ctx context.Context, msg *messages.AuthenticatedMessage) (*messages.Ack, error) {
 	// Verify the message authentication
-	auth, err := s.AuthenticatedReceiver(msg, ctx)
+	_, err := s.authorizedReceiver("AskOnline", msg, ctx)
 	if err != nil {
-		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
-	}
-
-	//return an error if the connection is not authenticated
-	if !auth.IsAuthenticated {
-		return &messages.Ack{}, connect.AuthError(auth.Sender.GetId())
+		return nil, err
 	}
 
 	return &messages.Ack{}, s.handler.AskOnline()
@@ -51,9 +46,9 @@ func (s *Comms) RequestToken(context.Context, *messages.Ping) (*messages.AssignT
 // Handle a NewRound event
 func (s *Comms) CreateNewRound(ctx context.Context, msg *messages.AuthenticatedMessage) (*messages.Ack, error) {
 	// Verify the message authentication
-	authState, err := s.AuthenticatedReceiver(msg, ctx)
+	authState, err := s.authorizedReceiver("CreateNewRound", msg, ctx)
 	if err != nil {
-		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
+		return nil, err
 	}
 
 	// Unnmarshall the any message to the message type needed
@@ -71,9 +66,9 @@ func (s *Comms) CreateNewRound(ctx context.Context, msg *messages.AuthenticatedM
 func (s *Comms) PostPhase(ctx context.Context, msg *messages.AuthenticatedMessage) (*messages.Ack,
 	error) {
 	// Verify the message authentication
-	authState, err := s.AuthenticatedReceiver(msg, ctx)
+	authState, err := s.authorizedReceiver("PostPhase", msg, ctx)
 	if err != nil {
-		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
+		return nil, err
 	}
 	// Unmarshall the any message to the message type needed
 	batchMsg := &pb.Batch{}
@@ -97,13 +92,16 @@ func (s *Comms) StreamPostPhase(server pb.Node_StreamPostPhaseServer) error {
 		return errors.Errorf("Unable to extract authentication info: %+v", err)
 	}
 
-	authState, err := s.AuthenticatedReceiver(authMsg, server.Context())
+	authState, err := s.authorizedReceiver("StreamPostPhase", authMsg, server.Context())
 	if err != nil {
-		return errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
+		return err
 	}
 
-	// Verify the message authentication
-	return s.handler.StreamPostPhase(server, authState)
+	// Handle once every shard of the batch has arrived and been verified
+	return s.receiveBatch(server, authState,
+		func(stream pb.SlotStreamServer) error {
+			return s.handler.StreamPostPhase(stream, authState)
+		})
 }
 
 // GetBufferInfo returns buffer size (number of completed precomputations)
@@ -112,9 +110,9 @@ func (s *Comms) GetRoundBufferInfo(ctx context.Context,
 	*pb.RoundBufferInfo, error) {
 
 	// Verify the message authentication
-	authState, err := s.AuthenticatedReceiver(msg, ctx)
+	authState, err := s.authorizedReceiver("GetRoundBufferInfo", msg, ctx)
 	if err != nil {
-		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
+		return nil, err
 	}
 	bufSize, err := s.handler.GetRoundBufferInfo(authState)
 	if bufSize < 0 {
@@ -129,9 +127,9 @@ func (s *Comms) RequestClientKey(ctx context.Context,
 	msg *messages.AuthenticatedMessage) (*pb.SignedKeyResponse, error) {
 
 	// Verify the message authentication
-	authState, err := s.AuthenticatedReceiver(msg, ctx)
+	authState, err := s.authorizedReceiver("RequestClientKey", msg, ctx)
 	if err != nil {
-		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
+		return nil, err
 	}
 
 	//Marshall the any message to the message type needed
@@ -156,9 +154,9 @@ func (s *Comms) PostPrecompResult(ctx context.Context,
 	msg *messages.AuthenticatedMessage) (*messages.Ack, error) {
 
 	// Verify the message authentication
-	authState, err := s.AuthenticatedReceiver(msg, ctx)
+	authState, err := s.authorizedReceiver("PostPrecompResult", msg, ctx)
 	if err != nil {
-		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
+		return nil, err
 	}
 
 	//Unmarshall the any message to the message type needed
@@ -176,9 +174,9 @@ func (s *Comms) PostPrecompResult(ctx context.Context,
 
 func (s *Comms) GetMeasure(ctx context.Context, msg *messages.AuthenticatedMessage) (*pb.RoundMetrics, error) {
 	// Verify the message authentication
-	authState, err := s.AuthenticatedReceiver(msg, ctx)
+	authState, err := s.authorizedReceiver("GetMeasure", msg, ctx)
 	if err != nil {
-		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
+		return nil, err
 	}
 
 	//Unmarshall the any message to the message type needed
@@ -194,9 +192,9 @@ func (s *Comms) GetMeasure(ctx context.Context, msg *messages.AuthenticatedMessa
 
 // Gateway -> Server unified polling
 func (s *Comms) Poll(ctx context.Context, msg *messages.AuthenticatedMessage) (*pb.ServerPollResponse, error) {
-	authState, err := s.AuthenticatedReceiver(msg, ctx)
+	authState, err := s.authorizedReceiver("Poll", msg, ctx)
 	if err != nil {
-		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
+		return nil, err
 	}
 	//Unmarshall the any message to the message type needed
 	pollMsg := &pb.ServerPoll{}
@@ -209,9 +207,9 @@ func (s *Comms) Poll(ctx context.Context, msg *messages.AuthenticatedMessage) (*
 }
 
 func (s *Comms) RoundError(ctx context.Context, msg *messages.AuthenticatedMessage) (*messages.Ack, error) {
-	authState, err := s.AuthenticatedReceiver(msg, ctx)
+	authState, err := s.authorizedReceiver("RoundError", msg, ctx)
 	if err != nil {
-		return nil, errors.Errorf("Unable to handle reception of AuthenticatedMessage: %+v", err)
+		return nil, err
 	}
 	errMsg := &pb.RoundError{}
 	err = ptypes.UnmarshalAny(msg.Message, errMsg)
@@ -224,9 +222,9 @@ func (s *Comms) RoundError(ctx context.Context, msg *messages.AuthenticatedMessa
 
 func (s *Comms) SendRoundTripPing(ctx context.Context, msg *messages.AuthenticatedMessage) (*messages.Ack, error) {
 	// Verify the message authentication
-	authState, err := s.AuthenticatedReceiver(msg, ctx)
+	authState, err := s.authorizedReceiver("SendRoundTripPing", msg, ctx)
 	if err != nil {
-		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
+		return nil, err
 	}
 	//Marshall the any message to the message type needed
 	roundTripPing := &pb.RoundTripPing{}
@@ -251,9 +249,9 @@ func (s *Comms) GetPermissioningAddress(context.Context, *messages.Ping) (*pb.St
 // Server -> Server initiating multi-party round DH key generation
 func (s *Comms) StartSharePhase(ctx context.Context, msg *messages.AuthenticatedMessage) (*messages.Ack, error) {
 	// Verify the message authentication
-	authState, err := s.AuthenticatedReceiver(msg, ctx)
+	authState, err := s.authorizedReceiver("StartSharePhase", msg, ctx)
 	if err != nil {
-		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
+		return nil, err
 	}
 	//Marshall the any message to the message type needed
 	startShare := &pb.RoundInfo{}
@@ -270,9 +268,9 @@ func (s *Comms) StartSharePhase(ctx context.Context, msg *messages.Authenticated
 // Server -> Server passing state of multi-party round DH key generation
 func (s *Comms) SharePhaseRound(ctx context.Context, msg *messages.AuthenticatedMessage) (*messages.Ack, error) {
 	// Verify the message authentication
-	authState, err := s.AuthenticatedReceiver(msg, ctx)
+	authState, err := s.authorizedReceiver("SharePhaseRound", msg, ctx)
 	if err != nil {
-		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
+		return nil, err
 	}
 
 	//Marshall the any message to the message type needed
@@ -289,9 +287,9 @@ func (s *Comms) SharePhaseRound(ctx context.Context, msg *messages.Authenticated
 // Server -> Server sending multi-party round DH final key
 func (s *Comms) ShareFinalKey(ctx context.Context, msg *messages.AuthenticatedMessage) (*messages.Ack, error) {
 	// Verify the message authentication
-	authState, err := s.AuthenticatedReceiver(msg, ctx)
+	authState, err := s.authorizedReceiver("ShareFinalKey", msg, ctx)
 	if err != nil {
-		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
+		return nil, err
 	}
 
 	//Marshall the any message to the message type needed
@@ -20,74 +20,35 @@ import (
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/comms/messages"
 	"google.golang.org/grpc/metadata"
-	"io"
 )
 
 /* ------------------- Broadcast functions ------------------- */
 
 // SendFinishRealtime is a node to node comm which streams a completed batch
-// to all nodes within a round.
+// to all nodes within a round. The batch is split across parallel streams if
+// sharding is enabled with SetBatchShards.
 func (s *Comms) SendFinishRealtime(host *connect.Host,
 	roundInfo *pb.RoundInfo, batch *pb.CompletedBatch) (*messages.Ack, error) {
 
-	// Retrieve the streaming service
-	streamingClient, cancel, err := s.getFinishRealtimeStreamClient(
-		host, roundInfo)
-	if err != nil {
-		return nil, errors.Errorf("Could not retrieve steaming service: %v",
-			err)
-	}
+	ctx, cancel := s.getFinishRealtimeContext(roundInfo)
 	defer cancel()
 
-	// Stream each slot
-	for i, slot := range batch.Slots {
-		if err = streamingClient.Send(slot); err != nil {
-			if err == io.EOF {
-				// Attempt to read an error
-				eofAck, eofErr := streamingClient.CloseAndRecv()
-				if eofErr != nil {
-					err = errors.Wrap(err, eofErr.Error())
-				} else {
-					err = errors.Wrap(err, eofAck.Error)
-				}
-			}
-			return nil, errors.Errorf("Could not stream slot (%d/%d) to %s"+
-				"for round %d to %s: %v", i, len(batch.Slots), host.GetId(),
-				roundInfo.ID, host.GetId(), err)
-		}
+	open := func(ctx context.Context) (pb.SlotStreamClient, error) {
+		return s.getFinishRealtimeStream(host, ctx)
 	}
 
-	// Receive ack and cancel client streaming context
-	ack, err := streamingClient.CloseAndRecv()
+	// Stream each slot
+	_, err := s.batchSharding.SendSlots(ctx, host.GetId().String(), open,
+		batch.Slots)
 	if err != nil {
-		return nil, errors.Errorf("Could not receive final "+
-			"acknowledgement on streaming batch: %v", err)
-	}
-
-	if ack != nil && ack.Error != "" {
-		return nil, errors.Errorf("Remote Server Error: %v", ack.Error)
+		return nil, errors.Errorf("Could not stream batch of %d slots for "+
+			"round %d to %s: %v", len(batch.Slots), roundInfo.ID,
+			host.GetId(), err)
 	}
 
 	return nil, nil
 }
 
-// getFinishRealtimeStreamClient gets the streaming client
-// using a header and returns the stream and the cancel context
-// if there are no connection errors.
-func (s *Comms) getFinishRealtimeStreamClient(host *connect.Host,
-	info *pb.RoundInfo) (pb.Node_FinishRealtimeClient, context.CancelFunc,
-	error) {
-
-	ctx, cancel := s.getFinishRealtimeContext(info)
-
-	streamClient, err := s.getFinishRealtimeStream(host, ctx)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return streamClient, cancel, nil
-}
-
 // getFinishRealtimeContext is given roundInfo as a header,
 // and creates a streaming context. It adds the header to the context
 // and returns the context with the header and a cancel func.
@@ -148,9 +109,9 @@ func (s *Comms) FinishRealtime(stream pb.Node_FinishRealtimeServer) error {
 		return errors.Errorf("Unable to extract authentication info: %+v", err)
 	}
 
-	authState, err := s.AuthenticatedReceiver(authMsg, stream.Context())
+	authState, err := s.authorizedReceiver("FinishRealtime", authMsg, stream.Context())
 	if err != nil {
-		return errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
+		return err
 	}
 
 	// Unmarshall the any message to the message type needed
@@ -159,8 +120,11 @@ func (s *Comms) FinishRealtime(stream pb.Node_FinishRealtimeServer) error {
 		return errors.WithMessage(err, "Could not get realtime stream header")
 	}
 
-	// Handle
-	return s.handler.FinishRealtime(info, stream, authState)
+	// Handle once every shard of the batch has arrived and been verified
+	return s.receiveBatch(stream, authState,
+		func(stream pb.SlotStreamServer) error {
+			return s.handler.FinishRealtime(info, stream, authState)
+		})
 }
 
 // GetFinishRealtimeStreamHeader gets the header in the metadata from
@@ -10,8 +10,11 @@
 package node
 
 import (
+	"context"
 	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/elixxir/comms/drain"
 	"gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/recorder"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/comms/interconnect"
 	"gitlab.com/xx_network/comms/messages"
@@ -26,6 +29,14 @@ type Comms struct {
 	handler Handler
 	*mixmessages.UnimplementedNodeServer
 	*messages.UnimplementedGenericServer
+	recorder.Tap
+	drain.Drainer
+
+	// Splits and reassembles batches streamed across parallel streams
+	batchSharding mixmessages.BatchSharding
+
+	// Enforces which senders may call each endpoint
+	authorizer authorizer
 }
 
 // Starts a new server on the address:port specified by listeningAddr
@@ -42,10 +53,13 @@ func StartNode(id *id.ID, localServer string, interconnectPort int, handler Hand
 	mixmessageServer := Comms{
 		ProtoComms: pc,
 		handler:    handler,
+		authorizer: authorizer{policy: DefaultAuthorizationPolicy()},
 	}
 	// Register GRPC services to the listening address
-	mixmessages.RegisterNodeServer(mixmessageServer.GetServer(), &mixmessageServer)
+	mixmessageServer.GetServer().RegisterService(mixmessageServer.TrackService(
+		mixmessageServer.WrapService(&mixmessages.Node_ServiceDesc)), &mixmessageServer)
 	messages.RegisterGenericServer(mixmessageServer.GetServer(), &mixmessageServer)
+	mixmessageServer.RegisterHealth(mixmessageServer.GetServer())
 
 	// Start up interconnect service
 	if interconnectPort != 0 {
@@ -60,12 +74,20 @@ func StartNode(id *id.ID, localServer string, interconnectPort int, handler Hand
 	return &mixmessageServer
 }
 
+// GracefulStop drains the calls in flight, cancelling any left once ctx is
+// done, and shuts down the server.
+func (s *Comms) GracefulStop(ctx context.Context) error {
+	return drain.GracefulStop(ctx, &s.Drainer, s.ProtoComms)
+}
+
 type Handler interface {
 	// Server interface for starting New Rounds
 	CreateNewRound(message *mixmessages.RoundInfo, auth *connect.Auth) error
 	// Server interface for sending a new batch
 	UploadUnmixedBatch(server mixmessages.Node_UploadUnmixedBatchServer, auth *connect.Auth) error
-	// Server interface for handling a mixed batch request
+	// Server interface for handling a mixed batch request. Every slot of the
+	// batch must be sent; those before the StartIndex of the request are
+	// dropped by comms.
 	DownloadMixedBatch(stream mixmessages.Node_DownloadMixedBatchServer,
 		batchInfo *mixmessages.BatchReady, auth *connect.Auth) error
 	// Server interface for broadcasting when realtime is complete
@@ -126,7 +148,9 @@ type implementationFunctions struct {
 	CreateNewRound func(message *mixmessages.RoundInfo, auth *connect.Auth) error
 	// Server interface for sending a new batch
 	UploadUnmixedBatch func(stream mixmessages.Node_UploadUnmixedBatchServer, auth *connect.Auth) error
-	// Server interface for gateway requesting a new batch
+	// Server interface for gateway requesting a new batch. Every slot of the
+	// batch must be sent; those before the StartIndex of the request are
+	// dropped by comms.
 	DownloadMixedBatch func(stream mixmessages.Node_DownloadMixedBatchServer,
 		batchInfo *mixmessages.BatchReady, auth *connect.Auth) error
 
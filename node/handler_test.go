@@ -9,16 +9,21 @@
 package node
 
 import (
+	"context"
 	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/elixxir/comms/drain"
 	pb "gitlab.com/elixxir/comms/mixmessages"
 	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
 	"os"
 	"testing"
+	"time"
 )
 
 func TestMain(m *testing.M) {
 	jww.SetStdoutThreshold(jww.LevelTrace)
 	connect.TestingOnlyDisableTLS = true
+	TestingOnlyDisableAuthorization = true
 	os.Exit(m.Run())
 }
 
@@ -37,3 +42,59 @@ func (m TestInterface) PostPrecompResult(roundID uint64,
 	slots []*pb.Slot) error {
 	return nil
 }
+
+// Tests that GracefulStop waits for a call in flight to finish before
+// shutting down the node.
+func TestComms_GracefulStop(t *testing.T) {
+	started, release := make(chan struct{}), make(chan struct{})
+	impl := NewImplementation()
+	impl.Functions.PostPhase = func(m *pb.Batch, auth *connect.Auth) error {
+		close(started)
+		<-release
+		return nil
+	}
+
+	serverAddress := getNextServerAddress()
+	testID := id.NewIdFromString("test", id.Node, t)
+	server := StartNode(testID, serverAddress, 0, impl, nil, nil)
+	server.SetDrainParams(drain.DrainParams{HealthGrace: 10 * time.Millisecond})
+
+	manager := connect.NewManagerTesting(t)
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(testID, serverAddress, nil, params)
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+
+	sent := make(chan error)
+	go func() {
+		_, err := server.SendPostPhase(host, &pb.Batch{})
+		sent <- err
+	}()
+	<-started
+
+	stopped := make(chan error)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		stopped <- server.GracefulStop(ctx)
+	}()
+
+	select {
+	case <-stopped:
+		t.Fatalf("GracefulStop returned before the call in flight finished")
+	case <-time.After(50 * time.Millisecond):
+	}
+	if !server.IsDraining() {
+		t.Errorf("Node should be draining during GracefulStop")
+	}
+
+	close(release)
+	if err = <-sent; err != nil {
+		t.Errorf("Call in flight produced an error: %+v", err)
+	}
+	if err = <-stopped; err != nil {
+		t.Errorf("GracefulStop produced an error: %+v", err)
+	}
+}
@@ -58,6 +58,33 @@ func (s *Comms) SendPostPhase(host *connect.Host,
 	return result, ptypes.UnmarshalAny(resultMsg, result)
 }
 
+// SendStreamPostPhase streams the slots of a batch to the next node to run
+// the phase. The batch is split across parallel streams if sharding is
+// enabled with SetBatchShards.
+func (s *Comms) SendStreamPostPhase(host *connect.Host,
+	batch *pb.Batch) (*messages.Ack, error) {
+	ctx, cancel := s.getPostPhaseStreamContext(&pb.BatchInfo{
+		Round:     batch.Round,
+		FromPhase: batch.FromPhase,
+		BatchSize: uint32(len(batch.Slots)),
+	})
+	defer cancel()
+
+	open := func(ctx context.Context) (pb.SlotStreamClient, error) {
+		return s.getPostPhaseStream(host, ctx)
+	}
+
+	ack, err := s.batchSharding.SendSlots(ctx, host.GetId().String(), open,
+		batch.Slots)
+	if err != nil {
+		return nil, errors.Errorf("Could not stream batch of %d slots for "+
+			"round %d to %s: %v", len(batch.Slots), batch.Round.GetID(),
+			host.GetId(), err)
+	}
+
+	return ack, nil
+}
+
 // GetPostPhaseStreamClient gets the streaming client
 // using a header and returns the stream and the cancel context
 // if there are no connection errors
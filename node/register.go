@@ -139,3 +139,65 @@ func (s *Comms) SendAuthorizerAuth(host *connect.Host,
 	result := &messages.Ack{}
 	return result, ptypes.UnmarshalAny(resultMsg, result)
 }
+
+// Server -> Registration Send Function
+func (s *Comms) SendRotateNodeCredentials(host *connect.Host,
+	message *pb.NodeCredentialRotation) error {
+
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContext()
+		defer cancel()
+		// Pack the message for server, signed so that it can be told apart
+		// from replays
+		authMsg, err := s.PackAuthenticatedMessage(message, host, true)
+		if err != nil {
+			return nil, errors.New(err.Error())
+		}
+
+		// Send the message
+		_, err = pb.NewRegistrationClient(conn.GetGrpcConn()).
+			RotateNodeCredentials(ctx, authMsg)
+		if err != nil {
+			err = errors.New(err.Error())
+		}
+		return nil, err
+	}
+
+	// Execute the Send function
+	jww.TRACE.Printf("Sending Node Credential Rotation message: %+v", message)
+	_, err := s.Send(host, f)
+	return err
+}
+
+// Server -> Registration Send Function
+func (s *Comms) SendDeregisterNode(host *connect.Host,
+	message *pb.NodeDeregistration) error {
+
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContext()
+		defer cancel()
+		// Pack the message for server, signed so that it can be told apart
+		// from replays
+		authMsg, err := s.PackAuthenticatedMessage(message, host, true)
+		if err != nil {
+			return nil, errors.New(err.Error())
+		}
+
+		// Send the message
+		_, err = pb.NewRegistrationClient(conn.GetGrpcConn()).
+			DeregisterNode(ctx, authMsg)
+		if err != nil {
+			err = errors.New(err.Error())
+		}
+		return nil, err
+	}
+
+	// Execute the Send function
+	jww.TRACE.Printf("Sending Node Deregistration message: %+v", message)
+	_, err := s.Send(host, f)
+	return err
+}
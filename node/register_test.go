@@ -8,11 +8,18 @@
 package node
 
 import (
+	"context"
 	pb "gitlab.com/elixxir/comms/mixmessages"
 	"gitlab.com/elixxir/comms/registration"
+	"gitlab.com/elixxir/comms/replayguard"
+	"gitlab.com/elixxir/comms/testkeys"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/proto"
+	"net"
 	"testing"
+	"time"
 )
 
 // Smoke test SendNodeRegistration
@@ -100,3 +107,82 @@ func TestComms_SendRegistrationCheck(t *testing.T) {
 	}
 
 }
+
+// Tests that SendRotateNodeCredentials and SendDeregisterNode reach the
+// registration handler and that replayed requests are rejected.
+func TestComms_SendRotateNodeCredentials(t *testing.T) {
+	RegAddress := getNextServerAddress()
+	testId := id.NewIdFromString("rotate", id.Generic, t)
+	// The node signs the requests with its key
+	server := StartNode(testId, getNextServerAddress(), 0, NewImplementation(),
+		testkeys.GetNodeCert(), testkeys.GetNodeKey())
+	impl := registration.NewImplementation()
+	rotations := make(chan *pb.NodeCredentialRotation, 1)
+	impl.Functions.RotateNodeCredentials = func(
+		msg *pb.NodeCredentialRotation, auth *connect.Auth) error {
+		rotations <- msg
+		return nil
+	}
+	deregistrations := make(chan *pb.NodeDeregistration, 1)
+	impl.Functions.DeregisterNode = func(
+		msg *pb.NodeDeregistration, auth *connect.Auth) error {
+		deregistrations <- msg
+		return nil
+	}
+	reg := registration.StartRegistrationServer(testId, RegAddress, impl,
+		nil, nil, nil)
+	defer server.Shutdown()
+	defer reg.Shutdown()
+	manager := connect.NewManagerTesting(t)
+
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(testId, RegAddress, nil, params)
+	if err != nil {
+		t.Errorf("Unable to call NewHost: %+v", err)
+	}
+
+	rotation := &pb.NodeCredentialRotation{
+		Salt:          []byte("salt"),
+		ServerTlsCert: "cert",
+		Timestamp:     time.Now().UnixNano(),
+	}
+	err = server.SendRotateNodeCredentials(host, rotation)
+	if err != nil {
+		t.Errorf("SendRotateNodeCredentials: Error received: %+v", err)
+	}
+	if received := <-rotations; !proto.Equal(received, rotation) {
+		t.Errorf("Received rotation %v, expected %v", received, rotation)
+	}
+
+	// Each request is signed anew, so a node may repeat a request
+	err = server.SendRotateNodeCredentials(host, rotation)
+	if err != nil {
+		t.Errorf("SendRotateNodeCredentials: Error received for repeated "+
+			"rotation: %+v", err)
+	}
+	<-rotations
+
+	// Replaying a signed request is rejected
+	authMsg, err := server.PackAuthenticatedMessage(rotation, host, true)
+	if err != nil {
+		t.Fatalf("Failed to pack rotation: %+v", err)
+	}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.IPv4(1, 2, 3, 4), Port: 5}})
+	if _, err = reg.RotateNodeCredentials(ctx, authMsg); err != nil {
+		t.Errorf("RotateNodeCredentials: Error received: %+v", err)
+	}
+	<-rotations
+	_, err = reg.RotateNodeCredentials(ctx, authMsg)
+	if err != replayguard.ErrReplayed {
+		t.Errorf("Replayed rotation should be rejected: %v", err)
+	}
+
+	err = server.SendDeregisterNode(host,
+		&pb.NodeDeregistration{Timestamp: time.Now().UnixNano()})
+	if err != nil {
+		t.Errorf("SendDeregisterNode: Error received: %+v", err)
+	}
+	<-deregistrations
+}
@@ -0,0 +1,32 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the replayer for captures of node traffic
+
+package node
+
+import (
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/recorder"
+	"gitlab.com/xx_network/comms/connect"
+	"io"
+)
+
+// ReplayCapture feeds the Node calls recorded in capture into handler, in
+// the order they were made, and reports how each replayed call compared to
+// the recording. Senders are authenticated against the hosts known to pc;
+// calls recorded with their tokens redacted arrive unauthenticated. The
+// default authorization policy is enforced, as for a started node.
+func ReplayCapture(pc *connect.ProtoComms, handler Handler,
+	capture io.Reader) ([]*recorder.Result, error) {
+	s := &Comms{
+		ProtoComms: pc,
+		handler:    handler,
+		authorizer: authorizer{policy: DefaultAuthorizationPolicy()},
+	}
+	return recorder.Replay(capture, &pb.Node_ServiceDesc, s)
+}
@@ -0,0 +1,95 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package node
+
+import (
+	"bytes"
+	"context"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/recorder"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/proto"
+	"net"
+	"testing"
+)
+
+// Tests that ReplayCapture enforces the default authorization policy on the
+// calls it replays, so an unauthenticated call recorded while authorization
+// was disabled is rejected before reaching the handler.
+func TestReplayCapture_Authorization(t *testing.T) {
+	nodeID := id.NewIdFromString("node", id.Node, t)
+	senderID := id.NewIdFromString("sender", id.Node, t)
+	pc, err := connect.CreateCommClient(nodeID, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Unable to create comms: %+v", err)
+	}
+	_, err = pc.AddHost(senderID, "0.0.0.0:0", nil,
+		connect.GetDefaultHostParams())
+	if err != nil {
+		t.Fatalf("Unable to add host: %+v", err)
+	}
+
+	calls := 0
+	impl := NewImplementation()
+	impl.Functions.AskOnline = func() error {
+		calls++
+		return nil
+	}
+
+	// Record the call with authorization disabled, as set by TestMain
+	var tap recorder.Tap
+	var buf bytes.Buffer
+	r, err := recorder.NewRecorder(&buf)
+	if err != nil {
+		t.Fatalf("Failed to create recorder: %+v", err)
+	}
+	tap.SetRecorder(r)
+	desc := tap.WrapService(&pb.Node_ServiceDesc)
+	recorded := &Comms{ProtoComms: pc, handler: impl}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.IPv4(1, 2, 3, 4), Port: 5}})
+	msg := &messages.AuthenticatedMessage{ID: senderID.Marshal()}
+	for _, md := range desc.Methods {
+		if md.MethodName != "AskOnline" {
+			continue
+		}
+		dec := func(in interface{}) error {
+			proto.Merge(in.(proto.Message), msg)
+			return nil
+		}
+		if _, err = md.Handler(recorded, ctx, dec, nil); err != nil {
+			t.Fatalf("Recorded call failed: %+v", err)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("Handler called %d times while recording, expected 1", calls)
+	}
+
+	TestingOnlyDisableAuthorization = false
+	defer func() { TestingOnlyDisableAuthorization = true }()
+
+	results, err := ReplayCapture(pc, impl, bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("Replay failed: %+v", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("Expected 1 result, received %d", len(results))
+	}
+	if calls != 1 {
+		t.Errorf("Handler called for unauthenticated replayed call")
+	}
+	expected := connect.AuthError(senderID).Error()
+	if results[0].ReplayedError == nil ||
+		results[0].ReplayedError.Error() != expected {
+		t.Errorf("Unexpected replayed error.\nexpected: %s\nreceived: %v",
+			expected, results[0].ReplayedError)
+	}
+}
@@ -0,0 +1,51 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains logic for receiving batches streamed across parallel streams
+
+package node
+
+import (
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+)
+
+// SetBatchShards sets the number of parallel streams used when streaming a
+// batch to another node, split by slot index range. The receiving node may
+// accept fewer. Values below 2 stream each batch over a single stream.
+func (s *Comms) SetBatchShards(shards int) {
+	s.batchSharding.SetBatchShards(shards)
+}
+
+// SetMaxBatchShards sets the maximum number of parallel streams accepted for
+// a batch streamed to this node. Values below 2 refuse sharding.
+func (s *Comms) SetMaxBatchShards(maxShards int) {
+	s.batchSharding.SetMaxBatchShards(maxShards)
+}
+
+// getShardSender returns the identity used to group the shards of a batch
+// so that shards from different senders are never combined.
+func getShardSender(auth *connect.Auth) string {
+	if auth.IsAuthenticated && auth.Sender != nil {
+		return auth.Sender.GetId().String()
+	}
+	return auth.IpAddress
+}
+
+// receiveBatch gathers the shards of a streamed batch, verifies the batch
+// against its commitment and passes it to handle as a single stream.
+func (s *Comms) receiveBatch(stream pb.SlotStreamServer, auth *connect.Auth,
+	handle func(stream pb.SlotStreamServer) error) error {
+	return s.batchSharding.ReceiveSlots(stream, getShardSender(auth),
+		func(stream pb.SlotStreamServer) error {
+			verified, err := pb.VerifySlotStream(stream)
+			if err != nil {
+				return err
+			}
+			return handle(verified)
+		})
+}
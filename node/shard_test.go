@@ -0,0 +1,231 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package node
+
+import (
+	"github.com/golang/protobuf/proto"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/testkeys"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc/metadata"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Tests that a batch streamed with SendStreamPostPhase reaches the handler
+// once, whole and in order, for a range of requested and accepted shards.
+func TestComms_SendStreamPostPhase_Sharded(t *testing.T) {
+	keyData := testkeys.LoadFromPath(testkeys.GetNodeKeyPath())
+	certData := testkeys.LoadFromPath(testkeys.GetNodeCertPath())
+
+	tests := []struct {
+		shards, maxShards, slots int
+	}{
+		{1, 0, 10},
+		{4, 0, 10},
+		{4, 2, 10},
+		{4, 1, 10},
+		{16, 0, 10},
+		{4, 0, 0},
+	}
+
+	for _, tt := range tests {
+		var mux sync.Mutex
+		var calls int
+		var received []*pb.Slot
+
+		receiverImpl := NewImplementation()
+		receiverImpl.Functions.StreamPostPhase = func(
+			server pb.Node_StreamPostPhaseServer, auth *connect.Auth) error {
+			mux.Lock()
+			defer mux.Unlock()
+			calls++
+			for {
+				slot, err := server.Recv()
+				if err == io.EOF {
+					return server.SendAndClose(&messages.Ack{})
+				} else if err != nil {
+					return err
+				}
+				received = append(received, slot)
+			}
+		}
+
+		testID := id.NewIdFromString("test", id.Node, t)
+		receiverAddress := getNextServerAddress()
+		receiver := StartNode(testID, receiverAddress, 0, receiverImpl,
+			certData, keyData)
+		receiver.SetMaxBatchShards(tt.maxShards)
+		sender := StartNode(testID, getNextServerAddress(), 0,
+			NewImplementation(), certData, keyData)
+		sender.SetBatchShards(tt.shards)
+
+		manager := connect.NewManagerTesting(t)
+		params := connect.GetDefaultHostParams()
+		params.AuthEnabled = false
+		host, err := manager.AddHost(testID, receiverAddress, certData, params)
+		if err != nil {
+			t.Fatalf("Unable to call NewHost: %+v", err)
+		}
+
+		batch := &pb.Batch{
+			Round:     &pb.RoundInfo{ID: 5},
+			FromPhase: 2,
+		}
+		for i := 0; i < tt.slots; i++ {
+			batch.Slots = append(batch.Slots,
+				&pb.Slot{Index: uint32(i), PayloadA: []byte{byte(i)}})
+		}
+
+		_, err = sender.SendStreamPostPhase(host, batch)
+		if err != nil {
+			t.Errorf("SendStreamPostPhase with %d shards produced an "+
+				"error: %+v", tt.shards, err)
+		}
+
+		mux.Lock()
+		if calls != 1 {
+			t.Errorf("Handler called %d times with %d shards, expected once",
+				calls, tt.shards)
+		}
+		if len(received) != tt.slots {
+			t.Errorf("Received %d slots with %d shards, expected %d",
+				len(received), tt.shards, tt.slots)
+		}
+		for i, slot := range received {
+			if slot.Index != uint32(i) {
+				t.Errorf("Slot %d received out of order with %d shards: %d",
+					i, tt.shards, slot.Index)
+			}
+		}
+		mux.Unlock()
+
+		receiver.Shutdown()
+		sender.Shutdown()
+	}
+}
+
+// Tests that an error returned by the handler is returned to the sender of
+// a sharded batch.
+func TestComms_SendFinishRealtime_ShardedError(t *testing.T) {
+	keyData := testkeys.LoadFromPath(testkeys.GetNodeKeyPath())
+	certData := testkeys.LoadFromPath(testkeys.GetNodeCertPath())
+
+	receiverImpl := NewImplementation()
+	receiverImpl.Functions.FinishRealtime = func(roundInfo *pb.RoundInfo,
+		server pb.Node_FinishRealtimeServer, auth *connect.Auth) error {
+		for {
+			_, err := server.Recv()
+			if err == io.EOF {
+				return server.SendAndClose(
+					&messages.Ack{Error: "batch rejected"})
+			} else if err != nil {
+				return err
+			}
+		}
+	}
+
+	testID := id.NewIdFromString("test", id.Node, t)
+	receiverAddress := getNextServerAddress()
+	receiver := StartNode(testID, receiverAddress, 0, receiverImpl,
+		certData, keyData)
+	defer receiver.Shutdown()
+	sender := StartNode(testID, getNextServerAddress(), 0,
+		NewImplementation(), certData, keyData)
+	defer sender.Shutdown()
+	sender.SetBatchShards(3)
+
+	manager := connect.NewManagerTesting(t)
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(testID, receiverAddress, certData, params)
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+
+	batch := &pb.CompletedBatch{}
+	for i := 0; i < 9; i++ {
+		batch.Slots = append(batch.Slots, &pb.Slot{Index: uint32(i)})
+	}
+
+	_, err = sender.SendFinishRealtime(host, &pb.RoundInfo{ID: 1}, batch)
+	if err == nil {
+		t.Errorf("Expected error from rejected sharded batch")
+	}
+}
+
+// Tests that a streamed batch which does not match its commitment is
+// rejected before reaching the handler.
+func TestComms_StreamPostPhase_CommitmentMismatch(t *testing.T) {
+	keyData := testkeys.LoadFromPath(testkeys.GetNodeKeyPath())
+	certData := testkeys.LoadFromPath(testkeys.GetNodeCertPath())
+
+	handled := false
+	receiverImpl := NewImplementation()
+	receiverImpl.Functions.StreamPostPhase = func(
+		server pb.Node_StreamPostPhaseServer, auth *connect.Auth) error {
+		handled = true
+		return server.SendAndClose(&messages.Ack{})
+	}
+
+	testID := id.NewIdFromString("test", id.Node, t)
+	receiverAddress := getNextServerAddress()
+	receiver := StartNode(testID, receiverAddress, 0, receiverImpl,
+		certData, keyData)
+	defer receiver.Shutdown()
+	sender := StartNode(testID, getNextServerAddress(), 0,
+		NewImplementation(), certData, keyData)
+	defer sender.Shutdown()
+
+	manager := connect.NewManagerTesting(t)
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(testID, receiverAddress, certData, params)
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+
+	// Commit to three slots but only send two of them
+	slots := []*pb.Slot{{Index: 0}, {Index: 1}, {Index: 2}}
+	commitment, err := pb.NewBatchCommitment(slots)
+	if err != nil {
+		t.Fatalf("NewBatchCommitment produced an error: %+v", err)
+	}
+	ctx, cancel := sender.getPostPhaseStreamContext(
+		&pb.BatchInfo{Round: &pb.RoundInfo{ID: 1}})
+	defer cancel()
+	encoded, err := proto.Marshal(commitment)
+	if err != nil {
+		t.Fatalf("Unable to marshal commitment: %+v", err)
+	}
+	ctx = metadata.AppendToOutgoingContext(ctx, pb.BatchCommitmentHeader,
+		string(encoded))
+
+	stream, err := sender.getPostPhaseStream(host, ctx)
+	if err != nil {
+		t.Fatalf("Unable to get streaming client: %+v", err)
+	}
+	for _, slot := range slots[:2] {
+		if err = stream.Send(slot); err != nil {
+			t.Fatalf("Unable to send slot: %+v", err)
+		}
+	}
+
+	_, err = stream.CloseAndRecv()
+	if err == nil || !strings.Contains(err.Error(),
+		"Batch integrity check failed for slots [0, 3)") {
+		t.Errorf("Expected batch integrity error, received: %v", err)
+	}
+	if handled {
+		t.Errorf("Handler should not receive a batch failing verification")
+	}
+}
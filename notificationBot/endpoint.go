@@ -16,6 +16,8 @@ import (
 	pb "gitlab.com/elixxir/comms/mixmessages"
 	"gitlab.com/xx_network/comms/messages"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Handles validation of reverse-authentication tokens
@@ -66,23 +68,90 @@ func (nb *Comms) ReceiveNotificationBatch(ctx context.Context, msg *messages.Aut
 		return nil, err
 	}
 
+	// Batches are retried by gateways, so only handle each round once. A
+	// batch arriving while another for its round is being handled is
+	// rejected, so it is retried if handling the other fails.
+	roundID := notificationBatch.GetRoundID()
+	switch nb.receivedRounds.reserve(roundID) {
+	case roundHandled:
+		jww.DEBUG.Printf("Ignoring duplicate notification batch for round %d",
+			roundID)
+		return &messages.Ack{}, nil
+	case roundHandling:
+		return nil, status.Errorf(codes.Unavailable, "Notification batch "+
+			"for round %d is being handled", roundID)
+	}
+
 	err = nb.handler.ReceiveNotificationBatch(notificationBatch, authState)
+	nb.receivedRounds.release(roundID, err == nil)
 
 	return &messages.Ack{}, err
 }
 
 func (nb *Comms) RegisterToken(ctx context.Context, msg *pb.RegisterTokenRequest) (*messages.Ack, error) {
-	return &messages.Ack{}, nb.handler.RegisterToken(msg)
+	done, err := nb.CheckReplay("RegisterToken", msg.GetTransmissionRsaPem(),
+		msg.GetRequestTimestamp(), msg.GetTokenSignature())
+	if err != nil {
+		return nil, err
+	}
+	err = nb.handler.RegisterToken(msg)
+	done(err)
+	return &messages.Ack{}, err
 }
 
 func (nb *Comms) UnregisterToken(ctx context.Context, msg *pb.UnregisterTokenRequest) (*messages.Ack, error) {
-	return &messages.Ack{}, nb.handler.UnregisterToken(msg)
+	done, err := nb.CheckReplay("UnregisterToken", msg.GetTransmissionRsaPem(),
+		msg.GetRequestTimestamp(), msg.GetTokenSignature())
+	if err != nil {
+		return nil, err
+	}
+	err = nb.handler.UnregisterToken(msg)
+	done(err)
+	return &messages.Ack{}, err
 }
 
 func (nb *Comms) RegisterTrackedID(ctx context.Context, msg *pb.RegisterTrackedIdRequest) (*messages.Ack, error) {
-	return &messages.Ack{}, nb.handler.RegisterTrackedID(msg)
+	done, err := nb.CheckReplay("RegisterTrackedID",
+		msg.GetRequest().GetTransmissionRsaPem(),
+		msg.GetRequest().GetRequestTimestamp(), msg.GetRequest().GetSignature())
+	if err != nil {
+		return nil, err
+	}
+	err = nb.handler.RegisterTrackedID(msg)
+	done(err)
+	return &messages.Ack{}, err
 }
 
 func (nb *Comms) UnregisterTrackedID(ctx context.Context, msg *pb.UnregisterTrackedIdRequest) (*messages.Ack, error) {
-	return &messages.Ack{}, nb.handler.UnregisterTrackedID(msg)
+	done, err := nb.CheckReplay("UnregisterTrackedID",
+		msg.GetRequest().GetTransmissionRsaPem(),
+		msg.GetRequest().GetRequestTimestamp(), msg.GetRequest().GetSignature())
+	if err != nil {
+		return nil, err
+	}
+	err = nb.handler.UnregisterTrackedID(msg)
+	done(err)
+	return &messages.Ack{}, err
+}
+
+func (nb *Comms) ListRegistrations(ctx context.Context, msg *pb.ListRegistrationsRequest) (*pb.RegistrationList, error) {
+	done, err := nb.CheckReplay("ListRegistrations", msg.GetTransmissionRsaPem(),
+		msg.GetRequestTimestamp(), msg.GetSignature())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := nb.handler.ListRegistrations(msg)
+	done(err)
+	return resp, err
+}
+
+func (nb *Comms) RefreshRegistration(ctx context.Context, msg *pb.RefreshRegistrationRequest) (*pb.RegistrationList, error) {
+	done, err := nb.CheckReplay("RefreshRegistration", msg.GetTransmissionRsaPem(),
+		msg.GetRequestTimestamp(), msg.GetSignature())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := nb.handler.RefreshRegistration(msg)
+	done(err)
+	return resp, err
 }
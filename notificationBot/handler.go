@@ -10,8 +10,13 @@
 package notificationBot
 
 import (
+	"context"
 	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/elixxir/comms/certificates"
+	"gitlab.com/elixxir/comms/drain"
 	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/recorder"
+	"gitlab.com/elixxir/comms/replayguard"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/comms/messages"
 	"gitlab.com/xx_network/primitives/id"
@@ -30,6 +35,11 @@ type Handler interface {
 	UnregisterTrackedID(msg *pb.UnregisterTrackedIdRequest) error
 	RegisterToken(msg *pb.RegisterTokenRequest) error
 	UnregisterToken(msg *pb.UnregisterTokenRequest) error
+	// ListRegistrations returns the tokens and tracked IDs registered to a user
+	ListRegistrations(msg *pb.ListRegistrationsRequest) (*pb.RegistrationList, error)
+	// RefreshRegistration extends all registrations of a user before they
+	// expire, returning their new expiries
+	RefreshRegistration(msg *pb.RefreshRegistrationRequest) (*pb.RegistrationList, error)
 }
 
 // NotificationBot object used to implement
@@ -39,6 +49,12 @@ type Comms struct {
 	handler Handler
 	*pb.UnimplementedNotificationBotServer
 	*messages.UnimplementedGenericServer
+	recorder.Tap
+	drain.Drainer
+	certificates.HttpsServer
+	replayguard.Guard
+
+	receivedRounds receivedRounds
 }
 
 // Starts a new server on the address:port specified by localServer
@@ -57,13 +73,31 @@ func StartNotificationBot(id *id.ID, localServer string, handler Handler,
 		ProtoComms: pc,
 		handler:    handler,
 	}
-	pb.RegisterNotificationBotServer(notificationBot.GetServer(), &notificationBot)
+	notificationBot.GetServer().RegisterService(notificationBot.TrackService(
+		notificationBot.WrapService(&pb.NotificationBot_ServiceDesc)), &notificationBot)
 	messages.RegisterGenericServer(notificationBot.GetServer(), &notificationBot)
+	notificationBot.RegisterHealth(notificationBot.GetServer())
 
 	pc.ServeWithWeb()
 	return &notificationBot
 }
 
+// GracefulStop drains the calls in flight, cancelling any left once ctx is
+// done, and shuts down the server and its HTTPS listener.
+func (nb *Comms) GracefulStop(ctx context.Context) error {
+	err := drain.GracefulStop(ctx, &nb.Drainer, nb.ProtoComms)
+	nb.StopHttps()
+	return err
+}
+
+// ServeHttpsReloading serves the gRPC-web endpoints of the notification bot over
+// HTTPS on a listener of its own at the address, presenting the certificate
+// held by the reloader to each new handshake.
+func (nb *Comms) ServeHttpsReloading(address string,
+	reloader *certificates.Reloader) error {
+	return nb.StartHttps(nb.ProtoComms, address, reloader)
+}
+
 // Handler implementation for the NotificationBot
 type implementationFunctions struct {
 	RegisterForNotifications   func(request *pb.NotificationRegisterRequest) error
@@ -73,6 +107,8 @@ type implementationFunctions struct {
 	UnregisterTrackedID        func(msg *pb.UnregisterTrackedIdRequest) error
 	RegisterToken              func(msg *pb.RegisterTokenRequest) error
 	UnregisterToken            func(msg *pb.UnregisterTokenRequest) error
+	ListRegistrations          func(msg *pb.ListRegistrationsRequest) (*pb.RegistrationList, error)
+	RefreshRegistration        func(msg *pb.RefreshRegistrationRequest) (*pb.RegistrationList, error)
 }
 
 // Implementation allows users of the client library to set the
@@ -120,6 +156,14 @@ func NewImplementation() *Implementation {
 				warn(um)
 				return nil
 			},
+			ListRegistrations: func(msg *pb.ListRegistrationsRequest) (*pb.RegistrationList, error) {
+				warn(um)
+				return &pb.RegistrationList{}, nil
+			},
+			RefreshRegistration: func(msg *pb.RefreshRegistrationRequest) (*pb.RegistrationList, error) {
+				warn(um)
+				return &pb.RegistrationList{}, nil
+			},
 		},
 	}
 }
@@ -152,3 +196,9 @@ func (s *Implementation) RegisterToken(msg *pb.RegisterTokenRequest) error {
 func (s *Implementation) UnregisterToken(msg *pb.UnregisterTokenRequest) error {
 	return s.Functions.UnregisterToken(msg)
 }
+func (s *Implementation) ListRegistrations(msg *pb.ListRegistrationsRequest) (*pb.RegistrationList, error) {
+	return s.Functions.ListRegistrations(msg)
+}
+func (s *Implementation) RefreshRegistration(msg *pb.RefreshRegistrationRequest) (*pb.RegistrationList, error) {
+	return s.Functions.RefreshRegistration(msg)
+}
@@ -8,13 +8,23 @@
 package notificationBot
 
 import (
+	"context"
 	"fmt"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
 	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
 	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/messages"
 	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"net"
 	"os"
 	"sync"
 	"testing"
+	"time"
 )
 
 func TestMain(m *testing.M) {
@@ -51,3 +61,101 @@ func TestBadCerts(t *testing.T) {
 	_ = StartNotificationBot(testID, Address, NewImplementation(),
 		[]byte("bad cert"), []byte("bad key"))
 }
+
+// Tests that RegisterToken rejects stale and replayed requests before they
+// reach the handler.
+func TestComms_RegisterToken_Replay(t *testing.T) {
+	received := 0
+	impl := NewImplementation()
+	impl.Functions.RegisterToken = func(*pb.RegisterTokenRequest) error {
+		received++
+		return nil
+	}
+	nb := &Comms{handler: impl}
+
+	msg := &pb.RegisterTokenRequest{
+		RequestTimestamp: time.Now().UnixNano(),
+		TokenSignature:   []byte("signature"),
+	}
+	if _, err := nb.RegisterToken(context.Background(), msg); err != nil {
+		t.Fatalf("Fresh request produced an error: %+v", err)
+	}
+	if _, err := nb.RegisterToken(context.Background(), msg); status.Code(err) !=
+		codes.AlreadyExists {
+		t.Errorf("Replayed request should be rejected: %v", err)
+	}
+
+	msg = &pb.RegisterTokenRequest{
+		RequestTimestamp: time.Now().Add(-time.Hour).UnixNano(),
+		TokenSignature:   []byte("other signature"),
+	}
+	if _, err := nb.RegisterToken(context.Background(), msg); status.Code(err) !=
+		codes.InvalidArgument {
+		t.Errorf("Stale request should be rejected: %v", err)
+	}
+
+	if received != 1 {
+		t.Errorf("Handler received %d requests, expected 1", received)
+	}
+}
+
+// Tests that ReceiveNotificationBatch handles the batch of each round once,
+// rejecting batches for a round while its batch is being handled and
+// accepting them again if handling fails.
+func TestComms_ReceiveNotificationBatch_Dedupe(t *testing.T) {
+	pc, err := connect.CreateCommClient(&id.NotificationBot, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Unable to create comms: %+v", err)
+	}
+	calls := make(chan struct{}, 10)
+	results := make(chan error)
+	impl := NewImplementation()
+	impl.Functions.ReceiveNotificationBatch = func(
+		*pb.NotificationBatch, *connect.Auth) error {
+		calls <- struct{}{}
+		return <-results
+	}
+	nb := &Comms{ProtoComms: pc, handler: impl}
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.IPv4(1, 2, 3, 4), Port: 5}})
+	batch, err := ptypes.MarshalAny(&pb.NotificationBatch{RoundID: 5})
+	if err != nil {
+		t.Fatalf("Failed to marshal batch: %+v", err)
+	}
+	msg := &messages.AuthenticatedMessage{
+		ID:      id.NewIdFromString("gateway", id.Gateway, t).Marshal(),
+		Message: batch,
+	}
+	receive := func() error {
+		_, err := nb.ReceiveNotificationBatch(ctx, msg)
+		return err
+	}
+
+	// A batch arriving while the first is handled is rejected
+	first := make(chan error)
+	go func() { first <- receive() }()
+	<-calls
+	if err = receive(); status.Code(err) != codes.Unavailable {
+		t.Errorf("Batch for round being handled should be rejected: %v", err)
+	}
+
+	// Once handling fails, the batch is handled again
+	results <- errors.New("handling failed")
+	if err = <-first; err == nil {
+		t.Errorf("Expected error from failed handling")
+	}
+	go func() { results <- nil }()
+	if err = receive(); err != nil {
+		t.Errorf("Batch should be handled after failure: %+v", err)
+	}
+	<-calls
+
+	// Once handled, batches for the round are ignored
+	if err = receive(); err != nil {
+		t.Errorf("Duplicate batch produced an error: %+v", err)
+	}
+	if len(calls) != 0 {
+		t.Errorf("Handler called for duplicate batch")
+	}
+}
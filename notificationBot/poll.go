@@ -15,7 +15,9 @@ import (
 	"github.com/pkg/errors"
 	jww "github.com/spf13/jwalterweatherman"
 	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/network"
 	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/ndf"
 )
 
 // PollNdf gets the NDF from the permissioning server
@@ -49,3 +51,12 @@ func (nb *Comms) PollNdf(host *connect.Host, ndfHash []byte) (*pb.NDF, error) {
 	err = ptypes.UnmarshalAny(resultMsg, result)
 	return result, err
 }
+
+// NewNdfWatcher returns a watcher keeping the NDF current by streaming each new
+// NDF from the permissioning host, starting from the current NDF, which may be
+// nil. Call Start on the watcher to begin watching.
+func (nb *Comms) NewNdfWatcher(host *connect.Host,
+	current *ndf.NetworkDefinition, params network.NdfWatcherParams) (
+	*network.NdfWatcher, error) {
+	return network.NewNdfWatcher(nb.ProtoComms, host, current, params)
+}
@@ -0,0 +1,81 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the record of rounds whose notification batch has been handled
+
+package notificationBot
+
+import (
+	"container/list"
+	"sync"
+)
+
+// receivedRoundsSize is the number of rounds remembered. Gateways retry
+// batches for recent rounds, so older rounds can be forgotten.
+const receivedRoundsSize = 1 << 14
+
+// receivedRounds remembers the rounds whose notification batch has been
+// handled, so batches delivered again are not handled twice. Its zero value is
+// ready to use.
+type receivedRounds struct {
+	mux   sync.Mutex
+	seen  map[uint64]*list.Element
+	order list.List
+
+	// Rounds whose batch is being handled
+	handling map[uint64]struct{}
+}
+
+// roundState is the state of the notification batch of a round.
+type roundState uint8
+
+const (
+	// The batch has not been handled
+	roundNew roundState = iota
+
+	// A batch is being handled
+	roundHandling
+
+	// The batch has been handled
+	roundHandled
+)
+
+// reserve returns the state of the batch of the round, claiming the round for
+// handling its batch if it is new. A claimed round must be released with
+// release.
+func (r *receivedRounds) reserve(roundID uint64) roundState {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	if r.seen == nil {
+		r.seen = make(map[uint64]*list.Element)
+		r.handling = make(map[uint64]struct{})
+	}
+	if _, ok := r.seen[roundID]; ok {
+		return roundHandled
+	}
+	if _, ok := r.handling[roundID]; ok {
+		return roundHandling
+	}
+	r.handling[roundID] = struct{}{}
+	return roundNew
+}
+
+// release ends the handling of the reserved round. If handled, the round is
+// remembered, forgetting the oldest round once full; otherwise it may be
+// reserved again.
+func (r *receivedRounds) release(roundID uint64, handled bool) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	delete(r.handling, roundID)
+	if !handled {
+		return
+	}
+	r.seen[roundID] = r.order.PushBack(roundID)
+	if r.order.Len() > receivedRoundsSize {
+		delete(r.seen, r.order.Remove(r.order.Front()).(uint64))
+	}
+}
@@ -0,0 +1,287 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the on-disk capture format used to record comms traffic
+
+package recorder
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+	"io"
+	"time"
+)
+
+// captureMagic prefixes every capture file.
+var captureMagic = []byte("XXCAP")
+
+// captureVersion is the version of the capture format written by this
+// package. Readers reject captures with an unknown version.
+const captureVersion = 1
+
+// maxRecordSize bounds the size of a single record so a corrupt length
+// prefix cannot cause an enormous allocation while reading a capture.
+const maxRecordSize = 1 << 30
+
+// Kind describes what a Record within a capture represents.
+type Kind uint8
+
+const (
+	// CallStart opens an RPC and carries its metadata and peer address.
+	CallStart Kind = iota + 1
+	// Recv is a message received by the server from the caller.
+	Recv
+	// Send is a message sent by the server to the caller.
+	Send
+	// CallEnd closes an RPC and carries the error returned, if any.
+	CallEnd
+)
+
+// String returns a human-readable name for the Kind.
+func (k Kind) String() string {
+	switch k {
+	case CallStart:
+		return "CallStart"
+	case Recv:
+		return "Recv"
+	case Send:
+		return "Send"
+	case CallEnd:
+		return "CallEnd"
+	default:
+		return "Unknown"
+	}
+}
+
+// Record is a single timestamped event within a capture. All records for one
+// RPC share the same Call number.
+type Record struct {
+	Timestamp time.Time
+	Kind      Kind
+	Call      uint64
+
+	// Full gRPC method name, e.g. "/mixmessages.Gateway/PutMessage"
+	Method string
+
+	// Set on CallStart records only
+	Peer     string
+	Metadata metadata.MD
+
+	// Protobuf wire encoding of the message, set on Recv and Send records
+	Message []byte
+
+	// Error returned by the endpoint, set on CallEnd records
+	Error string
+}
+
+// captureWriter writes length-prefixed records to an underlying io.Writer.
+type captureWriter struct {
+	w io.Writer
+}
+
+// newCaptureWriter writes the capture header to w and returns a writer for
+// the records which follow it.
+func newCaptureWriter(w io.Writer) (*captureWriter, error) {
+	header := append(append([]byte{}, captureMagic...), captureVersion)
+	if _, err := w.Write(header); err != nil {
+		return nil, errors.Errorf("Failed to write capture header: %+v", err)
+	}
+	return &captureWriter{w: w}, nil
+}
+
+// write serializes the record and writes it with a 4-byte length prefix in a
+// single call to the underlying writer.
+func (cw *captureWriter) write(r *Record) error {
+	body := r.marshal()
+	buf := make([]byte, 4, 4+len(body))
+	binary.BigEndian.PutUint32(buf, uint32(len(body)))
+	buf = append(buf, body...)
+	_, err := cw.w.Write(buf)
+	return err
+}
+
+// marshal serializes the record body.
+func (r *Record) marshal() []byte {
+	var b bytes.Buffer
+	var scratch [binary.MaxVarintLen64]byte
+
+	putUvarint := func(v uint64) {
+		n := binary.PutUvarint(scratch[:], v)
+		b.Write(scratch[:n])
+	}
+	putBytes := func(data []byte) {
+		putUvarint(uint64(len(data)))
+		b.Write(data)
+	}
+
+	n := binary.PutVarint(scratch[:], r.Timestamp.UnixNano())
+	b.Write(scratch[:n])
+	b.WriteByte(byte(r.Kind))
+	putUvarint(r.Call)
+	putBytes([]byte(r.Method))
+	putBytes([]byte(r.Peer))
+
+	putUvarint(uint64(len(r.Metadata)))
+	for key, values := range r.Metadata {
+		putBytes([]byte(key))
+		putUvarint(uint64(len(values)))
+		for _, v := range values {
+			putBytes([]byte(v))
+		}
+	}
+
+	putBytes(r.Message)
+	putBytes([]byte(r.Error))
+	return b.Bytes()
+}
+
+// CaptureReader reads the records of a capture in the order they were
+// written.
+type CaptureReader struct {
+	r *bufio.Reader
+}
+
+// NewCaptureReader validates the capture header and returns a reader for the
+// records in the capture.
+func NewCaptureReader(r io.Reader) (*CaptureReader, error) {
+	br := bufio.NewReader(r)
+	header := make([]byte, len(captureMagic)+1)
+	if _, err := io.ReadFull(br, header); err != nil {
+		return nil, errors.Errorf("Failed to read capture header: %+v", err)
+	}
+	if !bytes.Equal(header[:len(captureMagic)], captureMagic) {
+		return nil, errors.New("Input is not a comms capture")
+	}
+	if v := header[len(captureMagic)]; v != captureVersion {
+		return nil, errors.Errorf("Unsupported capture version %d", v)
+	}
+	return &CaptureReader{r: br}, nil
+}
+
+// Next returns the next record in the capture. It returns io.EOF once every
+// record has been read.
+func (cr *CaptureReader) Next() (*Record, error) {
+	var lenBuf [4]byte
+	if _, err := io.ReadFull(cr.r, lenBuf[:]); err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		return nil, errors.Errorf("Failed to read record length: %+v", err)
+	}
+	size := binary.BigEndian.Uint32(lenBuf[:])
+	if size > maxRecordSize {
+		return nil, errors.Errorf("Record of %d bytes exceeds maximum of %d",
+			size, maxRecordSize)
+	}
+
+	body := make([]byte, size)
+	if _, err := io.ReadFull(cr.r, body); err != nil {
+		return nil, errors.Errorf("Failed to read record of %d bytes: %+v",
+			size, err)
+	}
+
+	return unmarshalRecord(body)
+}
+
+// ReadAll reads every remaining record in the capture.
+func (cr *CaptureReader) ReadAll() ([]*Record, error) {
+	var records []*Record
+	for {
+		r, err := cr.Next()
+		if err == io.EOF {
+			return records, nil
+		} else if err != nil {
+			return records, err
+		}
+		records = append(records, r)
+	}
+}
+
+// unmarshalRecord deserializes a record body written by Record.marshal.
+func unmarshalRecord(body []byte) (*Record, error) {
+	buf := bytes.NewReader(body)
+	getUvarint := func() (uint64, error) {
+		return binary.ReadUvarint(buf)
+	}
+	getBytes := func() ([]byte, error) {
+		n, err := getUvarint()
+		if err != nil {
+			return nil, err
+		}
+		if n > uint64(buf.Len()) {
+			return nil, io.ErrUnexpectedEOF
+		}
+		data := make([]byte, n)
+		_, err = io.ReadFull(buf, data)
+		return data, err
+	}
+	getString := func() (string, error) {
+		data, err := getBytes()
+		return string(data), err
+	}
+
+	r := &Record{}
+	ts, err := binary.ReadVarint(buf)
+	if err != nil {
+		return nil, errors.Errorf("Malformed record timestamp: %+v", err)
+	}
+	r.Timestamp = time.Unix(0, ts)
+
+	kind, err := buf.ReadByte()
+	if err != nil {
+		return nil, errors.Errorf("Malformed record kind: %+v", err)
+	}
+	r.Kind = Kind(kind)
+
+	if r.Call, err = getUvarint(); err != nil {
+		return nil, errors.Errorf("Malformed record call number: %+v", err)
+	}
+	if r.Method, err = getString(); err != nil {
+		return nil, errors.Errorf("Malformed record method: %+v", err)
+	}
+	if r.Peer, err = getString(); err != nil {
+		return nil, errors.Errorf("Malformed record peer: %+v", err)
+	}
+
+	numKeys, err := getUvarint()
+	if err != nil {
+		return nil, errors.Errorf("Malformed record metadata: %+v", err)
+	}
+	if numKeys > 0 {
+		r.Metadata = metadata.MD{}
+	}
+	for i := uint64(0); i < numKeys; i++ {
+		key, err := getString()
+		if err != nil {
+			return nil, errors.Errorf("Malformed record metadata key: %+v", err)
+		}
+		numValues, err := getUvarint()
+		if err != nil {
+			return nil, errors.Errorf("Malformed record metadata: %+v", err)
+		}
+		for j := uint64(0); j < numValues; j++ {
+			value, err := getString()
+			if err != nil {
+				return nil, errors.Errorf(
+					"Malformed record metadata value: %+v", err)
+			}
+			r.Metadata[key] = append(r.Metadata[key], value)
+		}
+	}
+
+	if r.Message, err = getBytes(); err != nil {
+		return nil, errors.Errorf("Malformed record message: %+v", err)
+	}
+	if r.Error, err = getString(); err != nil {
+		return nil, errors.Errorf("Malformed record error: %+v", err)
+	}
+
+	return r, nil
+}
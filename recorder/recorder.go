@@ -0,0 +1,269 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package recorder captures the requests and responses passing through the
+// endpoints of a comms server so that they can later be replayed into a
+// Handler to reproduce a bug deterministically.
+
+package recorder
+
+import (
+	"context"
+	jww "github.com/spf13/jwalterweatherman"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Recorder writes the traffic passing through the endpoints of a comms server
+// to a capture. It is safe for concurrent use by many RPCs.
+type Recorder struct {
+	mux       sync.Mutex
+	writer    *captureWriter
+	redactors []Redactor
+
+	// Number of the most recently started call
+	calls uint64
+
+	// Set once a write has failed so the failure is only logged once
+	failed bool
+}
+
+// NewRecorder writes a capture header to w and returns a Recorder which
+// appends records to it. Every recorded message and its metadata are passed
+// through the redactors, in order, before being written.
+func NewRecorder(w io.Writer, redactors ...Redactor) (*Recorder, error) {
+	cw, err := newCaptureWriter(w)
+	if err != nil {
+		return nil, err
+	}
+	return &Recorder{
+		writer:    cw,
+		redactors: redactors,
+	}, nil
+}
+
+// write appends the record to the capture. A failure to record must never
+// fail the RPC being recorded, so errors are logged rather than returned.
+func (r *Recorder) write(rec *Record) {
+	rec.Timestamp = time.Now()
+
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	if err := r.writer.write(rec); err != nil && !r.failed {
+		r.failed = true
+		jww.ERROR.Printf("Failed to write %s record for %s to capture, "+
+			"capture will be incomplete: %+v", rec.Kind, rec.Method, err)
+	}
+}
+
+// startCall records the opening of an RPC along with its incoming metadata
+// and returns the call number used by the rest of its records.
+func (r *Recorder) startCall(ctx context.Context, method string) uint64 {
+	rec := &Record{
+		Kind:   CallStart,
+		Call:   atomic.AddUint64(&r.calls, 1),
+		Method: method,
+	}
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		rec.Peer = p.Addr.String()
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		rec.Metadata = md.Copy()
+		for _, redactor := range r.redactors {
+			redactor.RedactMetadata(method, rec.Metadata)
+		}
+	}
+
+	r.write(rec)
+	return rec.Call
+}
+
+// recordMessage records a message received or sent within the call.
+func (r *Recorder) recordMessage(call uint64, method string, kind Kind,
+	msg interface{}) {
+	pm, ok := msg.(proto.Message)
+	if !ok {
+		jww.WARN.Printf("Cannot record non-protobuf %T on %s", msg, method)
+		return
+	}
+
+	if len(r.redactors) > 0 {
+		pm = proto.Clone(pm)
+		for _, redactor := range r.redactors {
+			redactor.RedactMessage(method, pm)
+		}
+	}
+
+	data, err := proto.Marshal(pm)
+	if err != nil {
+		jww.WARN.Printf("Failed to marshal %T on %s for capture: %+v",
+			msg, method, err)
+		return
+	}
+
+	r.write(&Record{
+		Kind:    kind,
+		Call:    call,
+		Method:  method,
+		Message: data,
+	})
+}
+
+// endCall records the closing of an RPC and the error it returned.
+func (r *Recorder) endCall(call uint64, method string, err error) {
+	rec := &Record{
+		Kind:   CallEnd,
+		Call:   call,
+		Method: method,
+	}
+	if err != nil {
+		rec.Error = err.Error()
+	}
+	r.write(rec)
+}
+
+// Tap gives a comms server an opt-in Recorder. Services are registered
+// through WrapService when the server starts; while no Recorder is set, the
+// wrapped endpoints pass every call straight through.
+type Tap struct {
+	mux      sync.RWMutex
+	recorder *Recorder
+}
+
+// SetRecorder starts recording all subsequent calls to the Recorder. Passing
+// nil stops recording.
+func (t *Tap) SetRecorder(r *Recorder) {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	t.recorder = r
+}
+
+// getRecorder returns the current Recorder, or nil if recording is off.
+func (t *Tap) getRecorder() *Recorder {
+	t.mux.RLock()
+	defer t.mux.RUnlock()
+	return t.recorder
+}
+
+// WrapService returns a copy of the service description whose method and
+// stream handlers record each call through the Tap.
+func (t *Tap) WrapService(desc *grpc.ServiceDesc) *grpc.ServiceDesc {
+	wrapped := *desc
+
+	wrapped.Methods = make([]grpc.MethodDesc, len(desc.Methods))
+	for i, md := range desc.Methods {
+		wrapped.Methods[i] = grpc.MethodDesc{
+			MethodName: md.MethodName,
+			Handler: t.wrapMethod(
+				fullMethod(desc.ServiceName, md.MethodName), md.Handler),
+		}
+	}
+
+	wrapped.Streams = make([]grpc.StreamDesc, len(desc.Streams))
+	for i, sd := range desc.Streams {
+		wrapped.Streams[i] = sd
+		wrapped.Streams[i].Handler = t.wrapStream(
+			fullMethod(desc.ServiceName, sd.StreamName), sd.Handler)
+	}
+
+	return &wrapped
+}
+
+// methodHandler matches the signature of grpc.MethodDesc.Handler.
+type methodHandler = func(srv interface{}, ctx context.Context,
+	dec func(interface{}) error,
+	interceptor grpc.UnaryServerInterceptor) (interface{}, error)
+
+// wrapMethod records the request, response and error of a unary call.
+func (t *Tap) wrapMethod(method string, handler methodHandler) methodHandler {
+	return func(srv interface{}, ctx context.Context,
+		dec func(interface{}) error,
+		interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		r := t.getRecorder()
+		if r == nil {
+			return handler(srv, ctx, dec, interceptor)
+		}
+
+		call := r.startCall(ctx, method)
+		recordingDec := func(in interface{}) error {
+			err := dec(in)
+			if err == nil {
+				r.recordMessage(call, method, Recv, in)
+			}
+			return err
+		}
+
+		resp, err := handler(srv, ctx, recordingDec, interceptor)
+		if err == nil && resp != nil {
+			r.recordMessage(call, method, Send, resp)
+		}
+		r.endCall(call, method, err)
+		return resp, err
+	}
+}
+
+// wrapStream records every message received and sent on a stream along with
+// the error that ended it.
+func (t *Tap) wrapStream(method string,
+	handler grpc.StreamHandler) grpc.StreamHandler {
+	return func(srv interface{}, stream grpc.ServerStream) error {
+		r := t.getRecorder()
+		if r == nil {
+			return handler(srv, stream)
+		}
+
+		call := r.startCall(stream.Context(), method)
+		err := handler(srv, &recordingStream{
+			ServerStream: stream,
+			recorder:     r,
+			method:       method,
+			call:         call,
+		})
+		r.endCall(call, method, err)
+		return err
+	}
+}
+
+// recordingStream wraps a grpc.ServerStream to record the messages passing
+// through it.
+type recordingStream struct {
+	grpc.ServerStream
+	recorder *Recorder
+	method   string
+	call     uint64
+}
+
+// SendMsg records the message and sends it on the underlying stream.
+func (rs *recordingStream) SendMsg(m interface{}) error {
+	err := rs.ServerStream.SendMsg(m)
+	if err == nil {
+		rs.recorder.recordMessage(rs.call, rs.method, Send, m)
+	}
+	return err
+}
+
+// RecvMsg receives a message from the underlying stream and records it.
+func (rs *recordingStream) RecvMsg(m interface{}) error {
+	err := rs.ServerStream.RecvMsg(m)
+	if err == nil {
+		rs.recorder.recordMessage(rs.call, rs.method, Recv, m)
+	}
+	return err
+}
+
+// fullMethod builds the full gRPC method name used in captures.
+func fullMethod(service, method string) string {
+	return "/" + service + "/" + method
+}
@@ -0,0 +1,325 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package recorder
+
+import (
+	"bytes"
+	"context"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/messages"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"reflect"
+	"testing"
+)
+
+// testGatewayServer records calls to RequestHistoricalRounds and answers
+// with the number of rounds it was asked for.
+type testGatewayServer struct {
+	*pb.UnimplementedGatewayServer
+	received []*pb.HistoricalRounds
+	ips      []string
+}
+
+func (s *testGatewayServer) RequestHistoricalRounds(ctx context.Context,
+	msg *pb.HistoricalRounds) (*pb.HistoricalRoundsResponse, error) {
+	s.received = append(s.received, msg)
+	if p, ok := peer.FromContext(ctx); ok {
+		s.ips = append(s.ips, p.Addr.String())
+	}
+	if len(msg.Rounds) == 0 {
+		return nil, errors.New("no rounds requested")
+	}
+	return &pb.HistoricalRoundsResponse{
+		Rounds: make([]*pb.RoundInfo, len(msg.Rounds)),
+	}, nil
+}
+
+// callHistoricalRounds invokes RequestHistoricalRounds through the wrapped
+// Gateway service description, as the gRPC server would.
+func callHistoricalRounds(t *testing.T, tap *Tap, srv *testGatewayServer,
+	msg *pb.HistoricalRounds) (interface{}, error) {
+	desc := tap.WrapService(&pb.Gateway_ServiceDesc)
+	for _, md := range desc.Methods {
+		if md.MethodName != "RequestHistoricalRounds" {
+			continue
+		}
+		ctx := metadata.NewIncomingContext(context.Background(),
+			metadata.Pairs("id", "sender", "token", "secret"))
+		ctx = peer.NewContext(ctx, &peer.Peer{Addr: replayAddr("1.2.3.4:5")})
+		dec := func(in interface{}) error {
+			proto.Merge(in.(proto.Message), msg)
+			return nil
+		}
+		return md.Handler(srv, ctx, dec, nil)
+	}
+	t.Fatalf("RequestHistoricalRounds not found in service description")
+	return nil, nil
+}
+
+// Tests that records written by a Recorder are read back unchanged.
+func TestCaptureReader_Next(t *testing.T) {
+	var buf bytes.Buffer
+	r, err := NewRecorder(&buf)
+	if err != nil {
+		t.Fatalf("Failed to create recorder: %+v", err)
+	}
+
+	method := "/mixmessages.Gateway/RequestHistoricalRounds"
+	ctx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("a", "1", "a", "2"))
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: replayAddr("1.2.3.4:5")})
+	msg := &pb.HistoricalRounds{Rounds: []uint64{1, 2, 3}}
+
+	call := r.startCall(ctx, method)
+	r.recordMessage(call, method, Recv, msg)
+	r.endCall(call, method, errors.New("failure"))
+
+	cr, err := NewCaptureReader(&buf)
+	if err != nil {
+		t.Fatalf("Failed to read capture header: %+v", err)
+	}
+	records, err := cr.ReadAll()
+	if err != nil {
+		t.Fatalf("Failed to read capture: %+v", err)
+	}
+
+	expectedKinds := []Kind{CallStart, Recv, CallEnd}
+	if len(records) != len(expectedKinds) {
+		t.Fatalf("Expected %d records, read %d",
+			len(expectedKinds), len(records))
+	}
+	for i, rec := range records {
+		if rec.Kind != expectedKinds[i] || rec.Call != call ||
+			rec.Method != method {
+			t.Errorf("Unexpected record %d: %+v", i, rec)
+		}
+		if rec.Timestamp.IsZero() {
+			t.Errorf("Record %d has no timestamp", i)
+		}
+	}
+
+	if records[0].Peer != "1.2.3.4:5" {
+		t.Errorf("Unexpected peer.\nexpected: %s\nreceived: %s",
+			"1.2.3.4:5", records[0].Peer)
+	}
+	if !reflect.DeepEqual(records[0].Metadata["a"], []string{"1", "2"}) {
+		t.Errorf("Unexpected metadata: %v", records[0].Metadata)
+	}
+
+	received := &pb.HistoricalRounds{}
+	if err = proto.Unmarshal(records[1].Message, received); err != nil {
+		t.Fatalf("Failed to unmarshal recorded message: %+v", err)
+	}
+	if !proto.Equal(received, msg) {
+		t.Errorf("Unexpected message.\nexpected: %v\nreceived: %v",
+			msg, received)
+	}
+
+	if records[2].Error != "failure" {
+		t.Errorf("Unexpected error: %q", records[2].Error)
+	}
+}
+
+// Tests that NewCaptureReader rejects input which is not a capture.
+func TestNewCaptureReader_BadHeader(t *testing.T) {
+	_, err := NewCaptureReader(bytes.NewReader([]byte("NOTACAPTURE")))
+	if err == nil {
+		t.Errorf("Expected error for input that is not a capture")
+	}
+}
+
+// Tests that a truncated record is reported as an error rather than EOF.
+func TestCaptureReader_Next_Truncated(t *testing.T) {
+	var buf bytes.Buffer
+	r, err := NewRecorder(&buf)
+	if err != nil {
+		t.Fatalf("Failed to create recorder: %+v", err)
+	}
+	r.startCall(context.Background(), "/test/Method")
+
+	cr, err := NewCaptureReader(bytes.NewReader(buf.Bytes()[:buf.Len()-1]))
+	if err != nil {
+		t.Fatalf("Failed to read capture header: %+v", err)
+	}
+	if _, err = cr.Next(); err == nil || err == io.EOF {
+		t.Errorf("Expected error for truncated record, received: %v", err)
+	}
+}
+
+// Tests that the KeyMaterialRedactor clears key material from nested
+// messages, messages packed in an Any and metadata.
+func TestKeyMaterialRedactor(t *testing.T) {
+	inner := &pb.ClientKeyRequest{
+		Salt:           []byte("salt"),
+		ClientDHPubKey: []byte("public"),
+	}
+	anyMsg, err := ptypes.MarshalAny(inner)
+	if err != nil {
+		t.Fatalf("Failed to marshal Any: %+v", err)
+	}
+	msg := &messages.AuthenticatedMessage{
+		ID:      []byte("id"),
+		Token:   []byte("token"),
+		Message: anyMsg,
+		Client:  &messages.ClientID{Salt: []byte("salt")},
+	}
+	md := metadata.Pairs("token", "secret", "id", "sender")
+
+	kmr := NewKeyMaterialRedactor()
+	kmr.RedactMessage("/test/Method", msg)
+	kmr.RedactMetadata("/test/Method", md)
+
+	if len(msg.Token) != 0 || len(msg.Client.Salt) != 0 {
+		t.Errorf("Key material not redacted: %v", msg)
+	}
+	if !bytes.Equal(msg.ID, []byte("id")) {
+		t.Errorf("ID should not be redacted: %v", msg)
+	}
+
+	redactedInner := &pb.ClientKeyRequest{}
+	if err = ptypes.UnmarshalAny(msg.Message, redactedInner); err != nil {
+		t.Fatalf("Failed to unmarshal redacted Any: %+v", err)
+	}
+	if len(redactedInner.Salt) != 0 {
+		t.Errorf("Salt within Any not redacted: %v", redactedInner)
+	}
+	if !bytes.Equal(redactedInner.ClientDHPubKey, inner.ClientDHPubKey) {
+		t.Errorf("Public key within Any should not be redacted: %v",
+			redactedInner)
+	}
+
+	if md.Get("token")[0] != redactedValue || md.Get("id")[0] != "sender" {
+		t.Errorf("Unexpected redacted metadata: %v", md)
+	}
+}
+
+// Tests that a Tap without a Recorder passes calls through unrecorded and
+// that a Tap with a Recorder records them with redaction applied.
+func TestTap_WrapService(t *testing.T) {
+	var tap Tap
+	srv := &testGatewayServer{}
+	msg := &pb.HistoricalRounds{Rounds: []uint64{5}}
+
+	if _, err := callHistoricalRounds(t, &tap, srv, msg); err != nil {
+		t.Fatalf("Unrecorded call failed: %+v", err)
+	}
+
+	var buf bytes.Buffer
+	r, err := NewRecorder(&buf, NewKeyMaterialRedactor())
+	if err != nil {
+		t.Fatalf("Failed to create recorder: %+v", err)
+	}
+	tap.SetRecorder(r)
+	if _, err = callHistoricalRounds(t, &tap, srv, msg); err != nil {
+		t.Fatalf("Recorded call failed: %+v", err)
+	}
+	tap.SetRecorder(nil)
+	if _, err = callHistoricalRounds(t, &tap, srv, msg); err != nil {
+		t.Fatalf("Unrecorded call failed: %+v", err)
+	}
+
+	if len(srv.received) != 3 {
+		t.Errorf("Server should have handled 3 calls, handled %d",
+			len(srv.received))
+	}
+
+	cr, err := NewCaptureReader(&buf)
+	if err != nil {
+		t.Fatalf("Failed to read capture header: %+v", err)
+	}
+	records, err := cr.ReadAll()
+	if err != nil {
+		t.Fatalf("Failed to read capture: %+v", err)
+	}
+
+	expectedKinds := []Kind{CallStart, Recv, Send, CallEnd}
+	if len(records) != len(expectedKinds) {
+		t.Fatalf("Expected %d records, read %d",
+			len(expectedKinds), len(records))
+	}
+	for i, rec := range records {
+		if rec.Kind != expectedKinds[i] {
+			t.Errorf("Record %d has kind %s, expected %s",
+				i, rec.Kind, expectedKinds[i])
+		}
+	}
+	if records[0].Metadata.Get("token")[0] != redactedValue {
+		t.Errorf("Token metadata not redacted: %v", records[0].Metadata)
+	}
+}
+
+// Tests that Replay feeds a recorded call into a server, restoring its peer
+// address, and compares the responses against the recording.
+func TestReplay(t *testing.T) {
+	var tap Tap
+	var buf bytes.Buffer
+	r, err := NewRecorder(&buf)
+	if err != nil {
+		t.Fatalf("Failed to create recorder: %+v", err)
+	}
+	tap.SetRecorder(r)
+
+	recorded := &testGatewayServer{}
+	requests := []*pb.HistoricalRounds{
+		{Rounds: []uint64{1, 2}},
+		{},
+		{Rounds: []uint64{3}},
+	}
+	for _, msg := range requests {
+		_, _ = callHistoricalRounds(t, &tap, recorded, msg)
+	}
+
+	replayed := &testGatewayServer{}
+	results, err := Replay(bytes.NewReader(buf.Bytes()),
+		&pb.Gateway_ServiceDesc, replayed)
+	if err != nil {
+		t.Fatalf("Replay failed: %+v", err)
+	}
+
+	if len(results) != len(requests) {
+		t.Fatalf("Expected %d results, received %d",
+			len(requests), len(results))
+	}
+	for i, result := range results {
+		if !result.Matches() {
+			t.Errorf("Replay of call %d does not match recording: %+v",
+				i, result)
+		}
+		if !proto.Equal(replayed.received[i], requests[i]) {
+			t.Errorf("Unexpected replayed request %d."+
+				"\nexpected: %v\nreceived: %v",
+				i, requests[i], replayed.received[i])
+		}
+		if replayed.ips[i] != "1.2.3.4:5" {
+			t.Errorf("Unexpected replayed peer: %s", replayed.ips[i])
+		}
+	}
+	if results[1].RecordedError == "" {
+		t.Errorf("Expected recorded error for call with no rounds")
+	}
+
+	// Replaying into a server which behaves differently must not match
+	var tap2 Tap
+	results, err = Replay(bytes.NewReader(buf.Bytes()),
+		tap2.WrapService(&pb.Gateway_ServiceDesc),
+		&pb.UnimplementedGatewayServer{})
+	if err != nil {
+		t.Fatalf("Replay failed: %+v", err)
+	}
+	for i, result := range results {
+		if result.Matches() {
+			t.Errorf("Replay of call %d into unimplemented server "+
+				"should not match recording", i)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains hooks for removing key material from captured traffic
+
+package recorder
+
+import (
+	jww "github.com/spf13/jwalterweatherman"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+	"strings"
+)
+
+// Redactor scrubs sensitive data from traffic before it is written to a
+// capture. Both functions modify their argument in place; they are always
+// handed a copy, never the message or metadata seen by the endpoint.
+type Redactor interface {
+	RedactMessage(method string, msg proto.Message)
+	RedactMetadata(method string, md metadata.MD)
+}
+
+// redactedValue replaces the values of redacted metadata keys.
+const redactedValue = "REDACTED"
+
+// DefaultRedactedFields are the message fields holding secret key material
+// which are cleared by NewKeyMaterialRedactor.
+var DefaultRedactedFields = []string{
+	"Token", "Salt", "TransmissionSalt", "Key", "EncryptedClientKey",
+	"EncryptedClientKeyHMAC", "EncryptedPayloadAKeys", "EncryptedPayloadBKeys",
+	"ACMEToken", "PasswordHash",
+}
+
+// DefaultRedactedMetadata are the metadata keys holding secret key material
+// which are replaced by NewKeyMaterialRedactor.
+var DefaultRedactedMetadata = []string{"token"}
+
+// KeyMaterialRedactor clears fields from messages by name and replaces the
+// values of metadata keys. Messages packed into Any fields, such as the
+// payload of an AuthenticatedMessage, are redacted as well.
+type KeyMaterialRedactor struct {
+	fields       map[protoreflect.Name]struct{}
+	metadataKeys []string
+}
+
+// NewKeyMaterialRedactor returns a Redactor for the DefaultRedactedFields and
+// DefaultRedactedMetadata.
+func NewKeyMaterialRedactor() *KeyMaterialRedactor {
+	return NewFieldRedactor(DefaultRedactedFields, DefaultRedactedMetadata)
+}
+
+// NewFieldRedactor returns a Redactor which clears every message field named
+// in fields and replaces the value of every metadata key in metadataKeys.
+func NewFieldRedactor(fields, metadataKeys []string) *KeyMaterialRedactor {
+	kmr := &KeyMaterialRedactor{
+		fields:       make(map[protoreflect.Name]struct{}, len(fields)),
+		metadataKeys: make([]string, len(metadataKeys)),
+	}
+	for _, f := range fields {
+		kmr.fields[protoreflect.Name(f)] = struct{}{}
+	}
+	for i, key := range metadataKeys {
+		kmr.metadataKeys[i] = strings.ToLower(key)
+	}
+	return kmr
+}
+
+// RedactMessage clears the configured fields anywhere within msg.
+func (kmr *KeyMaterialRedactor) RedactMessage(method string, msg proto.Message) {
+	kmr.redact(method, msg.ProtoReflect())
+}
+
+// RedactMetadata replaces the values of the configured metadata keys.
+func (kmr *KeyMaterialRedactor) RedactMetadata(_ string, md metadata.MD) {
+	for _, key := range kmr.metadataKeys {
+		if _, ok := md[key]; ok {
+			md.Set(key, redactedValue)
+		}
+	}
+}
+
+// redact recursively clears the configured fields within m.
+func (kmr *KeyMaterialRedactor) redact(method string, m protoreflect.Message) {
+	if any, ok := m.Interface().(*anypb.Any); ok {
+		kmr.redactAny(method, any)
+		return
+	}
+
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if _, ok := kmr.fields[fd.Name()]; ok {
+			m.Clear(fd)
+			return true
+		}
+
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				v.Map().Range(
+					func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+						kmr.redact(method, mv.Message())
+						return true
+					})
+			}
+		case fd.Message() == nil:
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				kmr.redact(method, list.Get(i).Message())
+			}
+		default:
+			kmr.redact(method, v.Message())
+		}
+		return true
+	})
+}
+
+// redactAny unpacks the message held within an Any, redacts it and packs it
+// back in. Any messages of a type unknown to this binary are left untouched.
+func (kmr *KeyMaterialRedactor) redactAny(method string, any *anypb.Any) {
+	inner, err := any.UnmarshalNew()
+	if err != nil {
+		jww.TRACE.Printf("Not redacting Any of type %s on %s: %+v",
+			any.GetTypeUrl(), method, err)
+		return
+	}
+	kmr.redact(method, inner.ProtoReflect())
+	if err = any.MarshalFrom(inner); err != nil {
+		// Drop the payload rather than keep the unredacted original
+		jww.WARN.Printf("Failed to repack redacted Any of type %s on %s: %+v",
+			any.GetTypeUrl(), method, err)
+		any.Value = nil
+	}
+}
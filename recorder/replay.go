@@ -0,0 +1,225 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the replayer which feeds a capture back into a comms server
+
+package recorder
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/proto"
+	"io"
+)
+
+// Result is the outcome of replaying a single recorded call.
+type Result struct {
+	Call   uint64
+	Method string
+
+	// Messages sent by the server when the call was recorded, in wire format
+	Recorded [][]byte
+	// Error returned by the server when the call was recorded
+	RecordedError string
+
+	// Messages sent by the server during the replay
+	Replayed []proto.Message
+	// Error returned by the server during the replay
+	ReplayedError error
+}
+
+// Matches returns true if the replayed call sent the same messages and
+// returned the same error as the recorded call.
+func (r *Result) Matches() bool {
+	replayedErr := ""
+	if r.ReplayedError != nil {
+		replayedErr = r.ReplayedError.Error()
+	}
+	if replayedErr != r.RecordedError || len(r.Replayed) != len(r.Recorded) {
+		return false
+	}
+
+	for i, replayed := range r.Replayed {
+		recorded := replayed.ProtoReflect().New().Interface()
+		if err := proto.Unmarshal(r.Recorded[i], recorded); err != nil {
+			return false
+		}
+		if !proto.Equal(recorded, replayed) {
+			return false
+		}
+	}
+	return true
+}
+
+// recordedCall gathers the records of a single call from a capture.
+type recordedCall struct {
+	start    *Record
+	received [][]byte
+	result   *Result
+}
+
+// Replay feeds every call to the service described by desc found in the
+// capture into srv, one at a time and in the order the calls were started.
+// srv is the comms server, built around the Handler under test, which would
+// normally be registered with desc. Calls to other services are skipped.
+//
+// Each call receives the metadata and peer address recorded with it, so the
+// server sees the same headers and IP address as the original. Fields removed
+// by a Redactor when recording, such as authentication tokens, are replayed
+// in their redacted form.
+func Replay(capture io.Reader, desc *grpc.ServiceDesc,
+	srv interface{}) ([]*Result, error) {
+	cr, err := NewCaptureReader(capture)
+	if err != nil {
+		return nil, err
+	}
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	methods := make(map[string]grpc.MethodDesc, len(desc.Methods))
+	for _, md := range desc.Methods {
+		methods[fullMethod(desc.ServiceName, md.MethodName)] = md
+	}
+	streams := make(map[string]grpc.StreamDesc, len(desc.Streams))
+	for _, sd := range desc.Streams {
+		streams[fullMethod(desc.ServiceName, sd.StreamName)] = sd
+	}
+
+	// Group the records of each call, keeping the order calls were started
+	calls := make(map[uint64]*recordedCall)
+	var order []*recordedCall
+	for _, rec := range records {
+		_, isMethod := methods[rec.Method]
+		_, isStream := streams[rec.Method]
+		if !isMethod && !isStream {
+			continue
+		}
+
+		if rec.Kind == CallStart {
+			rc := &recordedCall{
+				start: rec,
+				result: &Result{
+					Call:   rec.Call,
+					Method: rec.Method,
+				},
+			}
+			calls[rec.Call] = rc
+			order = append(order, rc)
+			continue
+		}
+
+		rc, ok := calls[rec.Call]
+		if !ok {
+			jww.WARN.Printf("Skipping %s record for call %d to %s which "+
+				"has no start record", rec.Kind, rec.Call, rec.Method)
+			continue
+		}
+
+		switch rec.Kind {
+		case Recv:
+			rc.received = append(rc.received, rec.Message)
+		case Send:
+			rc.result.Recorded = append(rc.result.Recorded, rec.Message)
+		case CallEnd:
+			rc.result.RecordedError = rec.Error
+		}
+	}
+
+	results := make([]*Result, 0, len(order))
+	for _, rc := range order {
+		ctx := rc.start.context()
+		stream := &replayStream{ctx: ctx, received: rc.received}
+
+		if md, ok := methods[rc.start.Method]; ok {
+			var resp interface{}
+			resp, rc.result.ReplayedError = md.Handler(srv, ctx, stream.RecvMsg, nil)
+			if pm, ok := resp.(proto.Message); ok && rc.result.ReplayedError == nil {
+				stream.sent = append(stream.sent, pm)
+			}
+		} else {
+			sd := streams[rc.start.Method]
+			rc.result.ReplayedError = sd.Handler(srv, stream)
+		}
+
+		rc.result.Replayed = stream.sent
+		results = append(results, rc.result)
+	}
+
+	return results, nil
+}
+
+// context rebuilds the incoming context of a call from its start record.
+func (r *Record) context() context.Context {
+	ctx := context.Background()
+	if r.Metadata != nil {
+		ctx = metadata.NewIncomingContext(ctx, r.Metadata.Copy())
+	}
+	if r.Peer != "" {
+		ctx = peer.NewContext(ctx, &peer.Peer{Addr: replayAddr(r.Peer)})
+	}
+	return ctx
+}
+
+// replayAddr is the net.Addr of a recorded peer.
+type replayAddr string
+
+// Network always returns "tcp" as all comms servers listen over TCP.
+func (a replayAddr) Network() string { return "tcp" }
+
+// String returns the recorded address.
+func (a replayAddr) String() string { return string(a) }
+
+// replayStream is a grpc.ServerStream which delivers the recorded messages of
+// a call and collects the messages sent by the server.
+type replayStream struct {
+	ctx      context.Context
+	received [][]byte
+	sent     []proto.Message
+}
+
+// SetHeader is a no-op; headers sent during replay are discarded.
+func (rs *replayStream) SetHeader(metadata.MD) error { return nil }
+
+// SendHeader is a no-op; headers sent during replay are discarded.
+func (rs *replayStream) SendHeader(metadata.MD) error { return nil }
+
+// SetTrailer is a no-op; trailers sent during replay are discarded.
+func (rs *replayStream) SetTrailer(metadata.MD) {}
+
+// Context returns the rebuilt context of the recorded call.
+func (rs *replayStream) Context() context.Context { return rs.ctx }
+
+// SendMsg collects a message sent by the server.
+func (rs *replayStream) SendMsg(m interface{}) error {
+	pm, ok := m.(proto.Message)
+	if !ok {
+		return errors.Errorf("Cannot replay non-protobuf %T", m)
+	}
+	rs.sent = append(rs.sent, proto.Clone(pm))
+	return nil
+}
+
+// RecvMsg delivers the next recorded message, returning io.EOF once every
+// recorded message has been delivered.
+func (rs *replayStream) RecvMsg(m interface{}) error {
+	if len(rs.received) == 0 {
+		return io.EOF
+	}
+	pm, ok := m.(proto.Message)
+	if !ok {
+		return errors.Errorf("Cannot replay into non-protobuf %T", m)
+	}
+	next := rs.received[0]
+	rs.received = rs.received[1:]
+	return proto.Unmarshal(next, pm)
+}
@@ -108,3 +108,62 @@ func (r *Comms) CheckRegistration(ctx context.Context, msg *pb.RegisteredNodeChe
 	//Return the new ndf
 	return r.handler.CheckRegistration(msg)
 }
+
+// Node -> Permissioning request to replace its credentials
+func (r *Comms) RotateNodeCredentials(ctx context.Context,
+	msg *messages.AuthenticatedMessage) (*messages.Ack, error) {
+	authState, err := r.AuthenticatedReceiver(msg, ctx)
+	if err != nil {
+		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
+	}
+
+	rotationMsg := &pb.NodeCredentialRotation{}
+	err = ptypes.UnmarshalAny(msg.Message, rotationMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	done, err := r.CheckReplay("RotateNodeCredentials",
+		replaySender(authState), rotationMsg.GetTimestamp(), msg.GetSignature())
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.handler.RotateNodeCredentials(rotationMsg, authState)
+	done(err)
+	return &messages.Ack{}, err
+}
+
+// Node -> Permissioning request to leave the network
+func (r *Comms) DeregisterNode(ctx context.Context,
+	msg *messages.AuthenticatedMessage) (*messages.Ack, error) {
+	authState, err := r.AuthenticatedReceiver(msg, ctx)
+	if err != nil {
+		return nil, errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
+	}
+
+	deregistrationMsg := &pb.NodeDeregistration{}
+	err = ptypes.UnmarshalAny(msg.Message, deregistrationMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	done, err := r.CheckReplay("DeregisterNode",
+		replaySender(authState), deregistrationMsg.GetTimestamp(), msg.GetSignature())
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.handler.DeregisterNode(deregistrationMsg, authState)
+	done(err)
+	return &messages.Ack{}, err
+}
+
+// replaySender returns the ID of the sender which replayed requests are told
+// apart by, as found by AuthenticatedReceiver.
+func replaySender(auth *connect.Auth) []byte {
+	if auth.Sender == nil || auth.Sender.GetId() == nil {
+		return nil
+	}
+	return auth.Sender.GetId().Marshal()
+}
@@ -10,8 +10,12 @@
 package registration
 
 import (
+	"context"
 	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/elixxir/comms/drain"
 	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/recorder"
+	"gitlab.com/elixxir/comms/replayguard"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/comms/messages"
 	"gitlab.com/xx_network/primitives/id"
@@ -25,6 +29,12 @@ type Comms struct {
 	handler Handler
 	*pb.UnimplementedRegistrationServer
 	*messages.UnimplementedGenericServer
+	recorder.Tap
+	drain.Drainer
+	replayguard.Guard
+
+	// Latest NDFs streamed to watchers
+	ndfs ndfPublisher
 }
 
 // Starts a new server on the address:port specified by localServer
@@ -43,13 +53,21 @@ func StartRegistrationServer(id *id.ID, localServer string, handler Handler,
 		ProtoComms: pc,
 		handler:    handler,
 	}
-	pb.RegisterRegistrationServer(registrationServer.GetServer(), &registrationServer)
+	registrationServer.GetServer().RegisterService(registrationServer.TrackService(
+		registrationServer.WrapService(&pb.Registration_ServiceDesc)), &registrationServer)
 	messages.RegisterGenericServer(registrationServer.GetServer(), &registrationServer)
+	registrationServer.RegisterHealth(registrationServer.GetServer())
 
 	pc.Serve()
 	return &registrationServer
 }
 
+// GracefulStop drains the calls in flight, cancelling any left once ctx is
+// done, and shuts down the server.
+func (r *Comms) GracefulStop(ctx context.Context) error {
+	return drain.GracefulStop(ctx, &r.Drainer, r.ProtoComms)
+}
+
 type Handler interface {
 	RegisterUser(msg *pb.ClientRegistration) (confirmation *pb.SignedClientRegistrationConfirmations, err error)
 	RegisterNode(salt []byte, serverAddr, serverTlsCert, gatewayAddr,
@@ -58,15 +76,23 @@ type Handler interface {
 	Poll(msg *pb.PermissioningPoll, auth *connect.Auth) (*pb.
 		PermissionPollResponse, error)
 	CheckRegistration(msg *pb.RegisteredNodeCheck) (*pb.RegisteredNodeConfirmation, error)
+	// RotateNodeCredentials replaces the certificates and salt of the node
+	// sending the request, which must be checked to be authenticated
+	RotateNodeCredentials(msg *pb.NodeCredentialRotation, auth *connect.Auth) error
+	// DeregisterNode removes the node sending the request from the network,
+	// which must be checked to be authenticated
+	DeregisterNode(msg *pb.NodeDeregistration, auth *connect.Auth) error
 }
 
 type implementationFunctions struct {
 	RegisterUser func(msg *pb.ClientRegistration) (confirmation *pb.SignedClientRegistrationConfirmations, err error)
 	RegisterNode func(salt []byte, serverAddr, serverTlsCert, gatewayAddr,
 		gatewayTlsCert, registrationCode string) error
-	PollNdf           func(ndfHash []byte) (*pb.NDF, error)
-	Poll              func(msg *pb.PermissioningPoll, auth *connect.Auth) (*pb.PermissionPollResponse, error)
-	CheckRegistration func(msg *pb.RegisteredNodeCheck) (*pb.RegisteredNodeConfirmation, error)
+	PollNdf               func(ndfHash []byte) (*pb.NDF, error)
+	Poll                  func(msg *pb.PermissioningPoll, auth *connect.Auth) (*pb.PermissionPollResponse, error)
+	CheckRegistration     func(msg *pb.RegisteredNodeCheck) (*pb.RegisteredNodeConfirmation, error)
+	RotateNodeCredentials func(msg *pb.NodeCredentialRotation, auth *connect.Auth) error
+	DeregisterNode        func(msg *pb.NodeDeregistration, auth *connect.Auth) error
 }
 
 // Implementation allows users of the client library to set the
@@ -109,6 +135,14 @@ func NewImplementation() *Implementation {
 				warn(um)
 				return &pb.RegisteredNodeConfirmation{}, nil
 			},
+			RotateNodeCredentials: func(msg *pb.NodeCredentialRotation, auth *connect.Auth) error {
+				warn(um)
+				return nil
+			},
+			DeregisterNode: func(msg *pb.NodeDeregistration, auth *connect.Auth) error {
+				warn(um)
+				return nil
+			},
 		},
 	}
 }
@@ -136,3 +170,13 @@ func (s *Implementation) CheckRegistration(msg *pb.RegisteredNodeCheck) (*pb.
 	RegisteredNodeConfirmation, error) {
 	return s.Functions.CheckRegistration(msg)
 }
+
+func (s *Implementation) RotateNodeCredentials(msg *pb.NodeCredentialRotation,
+	auth *connect.Auth) error {
+	return s.Functions.RotateNodeCredentials(msg, auth)
+}
+
+func (s *Implementation) DeregisterNode(msg *pb.NodeDeregistration,
+	auth *connect.Auth) error {
+	return s.Functions.DeregisterNode(msg, auth)
+}
@@ -0,0 +1,106 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the streaming of new NDFs to the nodes, gateways and clients
+// watching them
+
+package registration
+
+import (
+	"bytes"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	ds "gitlab.com/elixxir/comms/network/dataStructures"
+	"gitlab.com/xx_network/comms/messages"
+	"sync"
+)
+
+// ndfPublisher holds the latest signed NDFs streamed to watchers.
+type ndfPublisher struct {
+	mux     sync.Mutex
+	full    *pb.NDF
+	partial *pb.NDF
+
+	// Closed and replaced each time new NDFs are published
+	updated chan struct{}
+}
+
+// PublishNdf streams the signed NDFs to everyone watching them; the full NDF
+// to authenticated callers and the partial NDF to all others. A nil NDF
+// leaves the one previously published in place.
+func (r *Comms) PublishNdf(full, partial *pb.NDF) {
+	r.ndfs.mux.Lock()
+	defer r.ndfs.mux.Unlock()
+	if full != nil {
+		r.ndfs.full = full
+	}
+	if partial != nil {
+		r.ndfs.partial = partial
+	}
+	if r.ndfs.updated != nil {
+		close(r.ndfs.updated)
+	}
+	r.ndfs.updated = make(chan struct{})
+}
+
+// get returns the latest full or partial NDF, which may be nil if none has
+// been published, and a channel closed once it is replaced.
+func (p *ndfPublisher) get(full bool) (*pb.NDF, <-chan struct{}) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	if p.updated == nil {
+		p.updated = make(chan struct{})
+	}
+	if full {
+		return p.full, p.updated
+	}
+	return p.partial, p.updated
+}
+
+// WatchNdf streams each NDF published after the one whose hash the caller
+// sent, until the caller cancels the stream. Authenticated callers receive
+// the full NDF and all others the partial NDF.
+func (r *Comms) WatchNdf(msg *messages.AuthenticatedMessage,
+	stream pb.Registration_WatchNdfServer) error {
+	authState, err := r.AuthenticatedReceiver(msg, stream.Context())
+	if err != nil {
+		return errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
+	}
+
+	ndfHash := &pb.NDFHash{}
+	err = ptypes.UnmarshalAny(msg.Message, ndfHash)
+	if err != nil {
+		return err
+	}
+
+	lastHash := ndfHash.GetHash()
+	for {
+		f, updated := r.ndfs.get(authState.IsAuthenticated)
+		if f != nil {
+			hash, err := ds.GenerateNDFHash(f)
+			if err != nil {
+				return err
+			}
+			if !bytes.Equal(hash, lastHash) {
+				if err = stream.Send(f); err != nil {
+					return err
+				}
+				lastHash = hash
+			}
+		}
+
+		select {
+		case <-updated:
+		case <-stream.Context().Done():
+			jww.DEBUG.Printf("Stopped streaming NDFs to %s: %v",
+				authState.IpAddress, stream.Context().Err())
+			return nil
+		}
+	}
+}
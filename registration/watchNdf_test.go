@@ -0,0 +1,102 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package registration
+
+import (
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/network"
+	"gitlab.com/elixxir/comms/testkeys"
+	"gitlab.com/elixxir/comms/testutils"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/signature"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/ndf"
+	"testing"
+	"time"
+)
+
+// signTestNdf returns the NDF signed with the test node key.
+func signTestNdf(def *ndf.NetworkDefinition, t *testing.T) *pb.NDF {
+	privKey, err := testutils.LoadPrivateKeyTesting(t)
+	if err != nil {
+		t.Fatalf("Unable to load private key: %+v", err)
+	}
+	f := &pb.NDF{}
+	if f.Ndf, err = def.Marshal(); err != nil {
+		t.Fatalf("Unable to marshal NDF: %+v", err)
+	}
+	if err = signature.SignRsa(f, privKey); err != nil {
+		t.Fatalf("Unable to sign NDF: %+v", err)
+	}
+	return f
+}
+
+// Tests that an NdfWatcher receives the NDF published when it starts and
+// each one published after.
+func TestComms_WatchNdf(t *testing.T) {
+	cert := testkeys.LoadFromPath(testkeys.GetNodeCertPath())
+	key := testkeys.LoadFromPath(testkeys.GetNodeKeyPath())
+	regAddr := getNextServerAddress()
+	reg := StartRegistrationServer(&id.Permissioning, regAddr,
+		NewImplementation(), cert, key, nil)
+	defer reg.Shutdown()
+
+	def, err := ndf.Unmarshal(testutils.ExampleNDF)
+	if err != nil {
+		t.Fatalf("Unable to unmarshal NDF: %+v", err)
+	}
+	reg.PublishNdf(nil, signTestNdf(def, t))
+
+	testId := id.NewIdFromString("test", id.Generic, t)
+	comms, err := connect.CreateCommClient(testId, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Unable to create client comms: %+v", err)
+	}
+	hostParams := connect.GetDefaultHostParams()
+	hostParams.AuthEnabled = false
+	host, err := comms.AddHost(&id.Permissioning, regAddr, cert, hostParams)
+	if err != nil {
+		t.Fatalf("Unable to add host: %+v", err)
+	}
+
+	watcher, err := network.NewNdfWatcher(comms, host, nil,
+		network.DefaultNdfWatcherParams())
+	if err != nil {
+		t.Fatalf("Unable to create watcher: %+v", err)
+	}
+	updates := make(chan *ndf.NetworkDefinition, 2)
+	watcher.OnUpdate(func(def *ndf.NetworkDefinition) { updates <- def })
+	stop := watcher.Start()
+	defer stop()
+
+	select {
+	case received := <-updates:
+		if !received.Timestamp.Equal(def.Timestamp) {
+			t.Errorf("Received NDF from %s, expected %s",
+				received.Timestamp, def.Timestamp)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Did not receive the published NDF")
+	}
+
+	def.Timestamp = def.Timestamp.Add(time.Hour)
+	reg.PublishNdf(nil, signTestNdf(def, t))
+
+	select {
+	case received := <-updates:
+		if !received.Timestamp.Equal(def.Timestamp) {
+			t.Errorf("Received NDF from %s, expected %s",
+				received.Timestamp, def.Timestamp)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Did not receive the updated NDF")
+	}
+	if !watcher.Get().Timestamp.Equal(def.Timestamp) {
+		t.Errorf("Watcher did not keep the updated NDF")
+	}
+}
@@ -7,8 +7,14 @@ import (
 	pb "gitlab.com/elixxir/comms/mixmessages"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/comms/messages"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// ErrModified is returned by ConditionalWrite when the path has been modified
+// since the expected timestamp.
+var ErrModified = errors.New("path has been modified since the expected timestamp")
+
 // Login to the server, receiving an authentication token
 func (rc *Comms) Login(host *connect.Host, msg *pb.RsAuthenticationRequest) (*pb.RsAuthenticationResponse, error) {
 	// Create the Send Function
@@ -47,7 +53,8 @@ func (rc *Comms) Read(host *connect.Host, msg *pb.RsReadRequest) (*pb.RsReadResp
 		resultMsg, err := pb.NewRemoteSyncClient(conn.GetGrpcConn()).
 			Read(ctx, msg)
 		if err != nil {
-			return nil, errors.New(err.Error())
+			// Keep the status of the error, which IsNotFound checks
+			return nil, err
 		}
 		return ptypes.MarshalAny(resultMsg)
 	}
@@ -155,7 +162,8 @@ func (rc *Comms) ReadDir(host *connect.Host, msg *pb.RsReadRequest) (*pb.RsReadD
 		resultMsg, err := pb.NewRemoteSyncClient(conn.GetGrpcConn()).
 			ReadDir(ctx, msg)
 		if err != nil {
-			return nil, errors.New(err.Error())
+			// Keep the status of the error, which IsNotFound checks
+			return nil, err
 		}
 		return ptypes.MarshalAny(resultMsg)
 	}
@@ -170,3 +178,145 @@ func (rc *Comms) ReadDir(host *connect.Host, msg *pb.RsReadRequest) (*pb.RsReadD
 	result := &pb.RsReadDirResponse{}
 	return result, ptypes.UnmarshalAny(resultMsg, result)
 }
+
+// Delete removes a path from a RemoteSync server.
+func (rc *Comms) Delete(host *connect.Host, msg *pb.RsReadRequest) (*messages.Ack, error) {
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContext()
+		defer cancel()
+		// Send the message
+		resultMsg, err := pb.NewRemoteSyncClient(conn.GetGrpcConn()).
+			Delete(ctx, msg)
+		if err != nil {
+			return nil, errors.New(err.Error())
+		}
+		return ptypes.MarshalAny(resultMsg)
+	}
+
+	// Execute the Send function
+	resultMsg, err := rc.Send(host, f)
+	if err != nil {
+		return nil, err
+	}
+
+	// Marshall the result
+	result := &messages.Ack{}
+	return result, ptypes.UnmarshalAny(resultMsg, result)
+}
+
+// Rename moves a path to a new path at a RemoteSync server.
+func (rc *Comms) Rename(host *connect.Host, msg *pb.RsRenameRequest) (*messages.Ack, error) {
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContext()
+		defer cancel()
+		// Send the message
+		resultMsg, err := pb.NewRemoteSyncClient(conn.GetGrpcConn()).
+			Rename(ctx, msg)
+		if err != nil {
+			return nil, errors.New(err.Error())
+		}
+		return ptypes.MarshalAny(resultMsg)
+	}
+
+	// Execute the Send function
+	resultMsg, err := rc.Send(host, f)
+	if err != nil {
+		return nil, err
+	}
+
+	// Marshall the result
+	result := &messages.Ack{}
+	return result, ptypes.UnmarshalAny(resultMsg, result)
+}
+
+// Stat returns the size, type and last modified time of a path.
+func (rc *Comms) Stat(host *connect.Host, msg *pb.RsReadRequest) (*pb.RsStatResponse, error) {
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContext()
+		defer cancel()
+		// Send the message
+		resultMsg, err := pb.NewRemoteSyncClient(conn.GetGrpcConn()).
+			Stat(ctx, msg)
+		if err != nil {
+			// Keep the status of the error, which IsNotFound checks
+			return nil, err
+		}
+		return ptypes.MarshalAny(resultMsg)
+	}
+
+	// Execute the Send function
+	resultMsg, err := rc.Send(host, f)
+	if err != nil {
+		return nil, err
+	}
+
+	// Marshall the result
+	result := &pb.RsStatResponse{}
+	return result, ptypes.UnmarshalAny(resultMsg, result)
+}
+
+// ConditionalWrite writes data to a path at a RemoteSync server if the path
+// has not been modified since msg.ExpectedLastModified, or does not exist if
+// it is zero. Returns ErrModified otherwise.
+func (rc *Comms) ConditionalWrite(host *connect.Host, msg *pb.RsConditionalWriteRequest) (*messages.Ack, error) {
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContext()
+		defer cancel()
+		// Send the message
+		resultMsg, err := pb.NewRemoteSyncClient(conn.GetGrpcConn()).
+			ConditionalWrite(ctx, msg)
+		if err != nil {
+			if status.Code(err) == codes.FailedPrecondition {
+				return nil, ErrModified
+			}
+			return nil, errors.New(err.Error())
+		}
+		return ptypes.MarshalAny(resultMsg)
+	}
+
+	// Execute the Send function
+	resultMsg, err := rc.Send(host, f)
+	if err != nil {
+		return nil, err
+	}
+
+	// Marshall the result
+	result := &messages.Ack{}
+	return result, ptypes.UnmarshalAny(resultMsg, result)
+}
+
+// GetUploadOffset returns the number of bytes stored of the unfinished upload
+// to a path.
+func (rc *Comms) GetUploadOffset(host *connect.Host, msg *pb.RsReadRequest) (*pb.RsUploadOffset, error) {
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContext()
+		defer cancel()
+		// Send the message
+		resultMsg, err := pb.NewRemoteSyncClient(conn.GetGrpcConn()).
+			GetUploadOffset(ctx, msg)
+		if err != nil {
+			return nil, errors.New(err.Error())
+		}
+		return ptypes.MarshalAny(resultMsg)
+	}
+
+	// Execute the Send function
+	resultMsg, err := rc.Send(host, f)
+	if err != nil {
+		return nil, err
+	}
+
+	// Marshall the result
+	result := &pb.RsUploadOffset{}
+	return result, ptypes.UnmarshalAny(resultMsg, result)
+}
@@ -0,0 +1,86 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package client
+
+import (
+	"fmt"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/remoteSync/server"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/primitives/id"
+	"os"
+	"sync"
+	"testing"
+)
+
+var serverPortLock sync.Mutex
+var serverPort = 17100
+
+func getNextServerAddress() string {
+	serverPortLock.Lock()
+	defer func() {
+		serverPort++
+		serverPortLock.Unlock()
+	}()
+	return fmt.Sprintf("localhost:%d", serverPort)
+}
+
+func TestMain(m *testing.M) {
+	connect.TestingOnlyDisableTLS = true
+	os.Exit(m.Run())
+}
+
+// startTestServer starts a RemoteSync server with the handler and returns a
+// client and a host connected to it.
+func startTestServer(t *testing.T, handler server.Handler) (
+	*Comms, *connect.Host) {
+	addr := getNextServerAddress()
+	serverID := id.NewIdFromString("remoteSync", id.Generic, t)
+	rs := server.StartRemoteSync(serverID, addr, handler, nil, nil)
+	t.Cleanup(rs.Shutdown)
+
+	c, err := NewClientComms(&id.DummyUser, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("NewClientComms produced an error: %+v", err)
+	}
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := c.AddHost(serverID, addr, nil, params)
+	if err != nil {
+		t.Fatalf("Unable to add host: %+v", err)
+	}
+	return c, host
+}
+
+// Tests that ConditionalWrite returns ErrModified when the server rejects the
+// write because the path has been modified.
+func TestComms_ConditionalWrite_Modified(t *testing.T) {
+	impl := server.NewImplementation()
+	impl.Functions.ConditionalWrite = func(
+		msg *pb.RsConditionalWriteRequest) (*messages.Ack, error) {
+		if msg.GetExpectedLastModified() != 5 {
+			return nil, server.ErrModified
+		}
+		return &messages.Ack{}, nil
+	}
+	c, host := startTestServer(t, impl)
+
+	_, err := c.ConditionalWrite(host, &pb.RsConditionalWriteRequest{
+		Path: "file", ExpectedLastModified: 5})
+	if err != nil {
+		t.Errorf("ConditionalWrite produced an error: %+v", err)
+	}
+
+	_, err = c.ConditionalWrite(host, &pb.RsConditionalWriteRequest{
+		Path: "file", ExpectedLastModified: 4})
+	if err != ErrModified {
+		t.Errorf("Expected ErrModified for a stale timestamp, received: %v",
+			err)
+	}
+}
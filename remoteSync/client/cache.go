@@ -0,0 +1,264 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the Cache which keeps a local copy of remote sync paths
+
+package client
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cache keeps a local copy of the files under a prefix on a RemoteSync
+// server, updated from a Watch stream. After reconnecting, it resumes from the
+// last change it applied, or fetches every file again if the server no longer
+// has the changes since.
+type Cache struct {
+	comms  *Comms
+	host   *connect.Host
+	prefix string
+	token  func() ([]byte, error)
+
+	mux       sync.RWMutex
+	files     map[string][]byte
+	sequence  uint64
+	callbacks []func(event *pb.RsChangeEvent)
+}
+
+// NewCache returns a Cache of the files under the prefix, which is a
+// directory or empty for all of the user's files. token returns a current
+// login token, and is called each time the Cache connects.
+func (rc *Comms) NewCache(host *connect.Host, prefix string,
+	token func() ([]byte, error)) *Cache {
+	return &Cache{
+		comms:  rc,
+		host:   host,
+		prefix: cleanPath(prefix),
+		token:  token,
+		files:  make(map[string][]byte),
+	}
+}
+
+// Get returns the contents of the file at the path, and whether it exists.
+func (c *Cache) Get(p string) ([]byte, bool) {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+	data, ok := c.files[cleanPath(p)]
+	return data, ok
+}
+
+// Paths returns the sorted paths of all files.
+func (c *Cache) Paths() []string {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+	paths := make([]string, 0, len(c.files))
+	for p := range c.files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// Sequence returns the sequence number of the last change applied, or zero if
+// the Cache has not fetched the files yet.
+func (c *Cache) Sequence() uint64 {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+	return c.sequence
+}
+
+// OnChange registers a function called with each change once it is applied.
+// It is called with a started event each time every file has been fetched.
+func (c *Cache) OnChange(f func(event *pb.RsChangeEvent)) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.callbacks = append(c.callbacks, f)
+}
+
+// Start keeps the Cache in sync until the returned function is called,
+// reconnecting after the interval if the stream fails.
+func (c *Cache) Start(retryInterval time.Duration) (stop func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		for {
+			err := c.sync(ctx)
+			if ctx.Err() != nil {
+				return
+			}
+			jww.WARN.Printf("Remote sync cache of %q disconnected, "+
+				"reconnecting in %s: %+v", c.prefix, retryInterval, err)
+			select {
+			case <-time.After(retryInterval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return cancel
+}
+
+// sync applies the changes from a Watch stream until it fails or ctx is done.
+func (c *Cache) sync(ctx context.Context) error {
+	token, err := c.token()
+	if err != nil {
+		return errors.WithMessage(err, "Unable to get token")
+	}
+	stream, cancel, err := c.comms.Watch(c.host, &pb.RsWatchRequest{
+		Prefix:        c.prefix,
+		Token:         token,
+		AfterSequence: c.Sequence(),
+	})
+	if err != nil {
+		return err
+	}
+	defer cancel()
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			cancel()
+		case <-done:
+		}
+	}()
+
+	for {
+		event, err := stream.Recv()
+		if status.Code(err) == codes.OutOfRange {
+			// Changes were missed, so fetch every file again
+			c.mux.Lock()
+			c.sequence = 0
+			c.mux.Unlock()
+			return errors.New(err.Error())
+		} else if err != nil {
+			return errors.New(err.Error())
+		}
+		if err = c.apply(token, event); err != nil {
+			return errors.WithMessagef(err, "Unable to apply change %d",
+				event.GetSequence())
+		}
+	}
+}
+
+// apply fetches the files changed by the event and updates the Cache.
+func (c *Cache) apply(token []byte, event *pb.RsChangeEvent) error {
+	fetched := make(map[string][]byte)
+	var removed []string
+	var err error
+	switch event.GetType() {
+	case pb.RsChangeStarted:
+		// Changes from here on are streamed, so every file can be fetched
+		err = c.fetch(token, c.prefix, fetched)
+	case pb.RsChangeWritten:
+		removed = []string{event.GetPath()}
+		if underPrefix(event.GetPath(), c.prefix) {
+			err = c.fetch(token, event.GetPath(), fetched)
+		}
+	case pb.RsChangeDeleted:
+		removed = []string{event.GetPath()}
+	case pb.RsChangeRenamed:
+		removed = []string{event.GetPath(), event.GetNewPath()}
+		if underPrefix(event.GetNewPath(), c.prefix) {
+			err = c.fetch(token, event.GetNewPath(), fetched)
+		}
+	default:
+		jww.WARN.Printf("Ignoring remote sync change %d of unknown type %d",
+			event.GetSequence(), event.GetType())
+	}
+	if err != nil {
+		return err
+	}
+
+	c.mux.Lock()
+	if event.GetType() == pb.RsChangeStarted {
+		c.files = fetched
+	} else {
+		for p := range c.files {
+			for _, r := range removed {
+				if underPrefix(p, r) {
+					delete(c.files, p)
+				}
+			}
+		}
+		for p, data := range fetched {
+			c.files[p] = data
+		}
+	}
+	c.sequence = event.GetSequence()
+	callbacks := c.callbacks
+	c.mux.Unlock()
+
+	for _, callback := range callbacks {
+		callback(event)
+	}
+	return nil
+}
+
+// fetch reads the file at the path, or every file under it if it is a
+// directory, into files. Paths which no longer exist are skipped, as their
+// removal is streamed later.
+func (c *Cache) fetch(token []byte, p string, files map[string][]byte) error {
+	if p != "" {
+		stat, err := c.comms.Stat(c.host, &pb.RsReadRequest{Path: p, Token: token})
+		if IsNotFound(err) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if !stat.GetIsDir() {
+			read, err := c.comms.Read(c.host,
+				&pb.RsReadRequest{Path: p, Token: token})
+			if IsNotFound(err) {
+				return nil
+			} else if err != nil {
+				return err
+			}
+			files[p] = read.GetData()
+			return nil
+		}
+	}
+
+	dir, err := c.comms.ReadDir(c.host, &pb.RsReadRequest{Path: p, Token: token})
+	if IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, name := range dir.GetData() {
+		if err = c.fetch(token, path.Join(p, name), files); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsNotFound returns true if the error is a RemoteSync server reporting that
+// a path does not exist, as returned by Stat, Read and ReadDir.
+func IsNotFound(err error) bool {
+	return status.Code(err) == codes.NotFound
+}
+
+// cleanPath returns the path in the form used in change events.
+func cleanPath(p string) string {
+	return strings.TrimPrefix(path.Clean("/"+p), "/")
+}
+
+// underPrefix returns true if the path is the prefix or inside it.
+func underPrefix(p, prefix string) bool {
+	return prefix == "" || p == prefix || strings.HasPrefix(p, prefix+"/")
+}
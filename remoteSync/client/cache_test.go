@@ -0,0 +1,123 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package client
+
+import (
+	"github.com/pkg/errors"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/remoteSync/server"
+	"testing"
+	"time"
+)
+
+// Tests that a Cache resuming from a sequence number the server no longer has
+// the changes since fetches every file again, then applies new changes.
+func TestCache_HistoryLost(t *testing.T) {
+	fs, err := server.NewFileSystem(server.DefaultFileSystemParams(t.TempDir()))
+	if err != nil {
+		t.Fatalf("NewFileSystem produced an error: %+v", err)
+	}
+	err = fs.AddUser("alice", []byte("password"), []byte("salt"))
+	if err != nil {
+		t.Fatalf("AddUser produced an error: %+v", err)
+	}
+	c, host := startTestServer(t, fs)
+
+	login, err := c.Login(host, &pb.RsAuthenticationRequest{
+		Username: "alice", PasswordHash: []byte("password"),
+		Salt: []byte("salt")})
+	if err != nil {
+		t.Fatalf("Login produced an error: %+v", err)
+	}
+	token := login.GetToken()
+	write := func(p, data string) {
+		_, err := c.Write(host,
+			&pb.RsWriteRequest{Path: p, Token: token, Data: []byte(data)})
+		if err != nil {
+			t.Fatalf("Write produced an error: %+v", err)
+		}
+	}
+	write("dir/a", "a")
+	write("other/b", "b")
+
+	cache := c.NewCache(host, "dir", func() ([]byte, error) {
+		return token, nil
+	})
+	events := make(chan *pb.RsChangeEvent, 10)
+	cache.OnChange(func(event *pb.RsChangeEvent) { events <- event })
+	waitFor := func(eventType uint32) {
+		for {
+			select {
+			case event := <-events:
+				if event.GetType() == eventType {
+					return
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatalf("Timed out waiting for event of type %d", eventType)
+			}
+		}
+	}
+
+	// A sequence number from before the server started
+	cache.sequence = 1
+	stop := cache.Start(10 * time.Millisecond)
+	defer stop()
+	waitFor(pb.RsChangeStarted)
+	if data, ok := cache.Get("dir/a"); !ok || string(data) != "a" {
+		t.Errorf("Cache did not fetch dir/a: %q", data)
+	}
+	if _, ok := cache.Get("other/b"); ok {
+		t.Error("Cache fetched a file outside its prefix")
+	}
+
+	write("dir/c", "c")
+	waitFor(pb.RsChangeWritten)
+	if paths := cache.Paths(); len(paths) != 2 || paths[1] != "dir/c" {
+		t.Errorf("Unexpected paths after write: %v", paths)
+	}
+}
+
+// Tests that IsNotFound matches the status of Stat, Read and ReadDir of a
+// missing path, and not errors which only quote a NotFound status.
+func TestIsNotFound(t *testing.T) {
+	fs, err := server.NewFileSystem(server.DefaultFileSystemParams(t.TempDir()))
+	if err != nil {
+		t.Fatalf("NewFileSystem produced an error: %+v", err)
+	}
+	err = fs.AddUser("alice", []byte("password"), []byte("salt"))
+	if err != nil {
+		t.Fatalf("AddUser produced an error: %+v", err)
+	}
+	c, host := startTestServer(t, fs)
+
+	login, err := c.Login(host, &pb.RsAuthenticationRequest{
+		Username: "alice", PasswordHash: []byte("password"),
+		Salt: []byte("salt")})
+	if err != nil {
+		t.Fatalf("Login produced an error: %+v", err)
+	}
+	missing := &pb.RsReadRequest{Path: "missing", Token: login.GetToken()}
+
+	if _, err = c.Stat(host, missing); !IsNotFound(err) {
+		t.Errorf("Stat of a missing path is not NotFound: %v", err)
+	}
+	if _, err = c.Read(host, missing); !IsNotFound(err) {
+		t.Errorf("Read of a missing path is not NotFound: %v", err)
+	}
+	if _, err = c.ReadDir(host, missing); !IsNotFound(err) {
+		t.Errorf("ReadDir of a missing path is not NotFound: %v", err)
+	}
+
+	quoted := errors.New(server.ErrNotFound.Error())
+	if IsNotFound(quoted) {
+		t.Errorf("Error quoting a NotFound status matched: %v", quoted)
+	}
+	if IsNotFound(nil) {
+		t.Errorf("nil error matched")
+	}
+}
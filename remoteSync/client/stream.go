@@ -0,0 +1,281 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the streaming reads, writes and watches of remote sync paths
+
+package client
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+	"io"
+)
+
+// DefaultChunkSize is the size of the chunks sent by a Writer, well below the
+// gRPC message size limit.
+const DefaultChunkSize = 512 * 1024
+
+// ErrCorruptChunk is returned by a Reader when a chunk does not match its
+// hash.
+var ErrCorruptChunk = errors.New("chunk does not match its hash")
+
+// Reader is an io.ReadCloser streaming a range of a path from a RemoteSync
+// server.
+type Reader struct {
+	stream pb.RemoteSync_ReadStreamClient
+	cancel context.CancelFunc
+	offset int64
+	buf    []byte
+}
+
+// NewReader opens a stream of the range of the path in msg. If reading fails,
+// a new Reader may continue from the Offset of the failed one.
+func (rc *Comms) NewReader(host *connect.Host,
+	msg *pb.RsReadStreamRequest) (*Reader, error) {
+	ctx, cancel := connect.StreamingContext()
+
+	// Create the Stream Function
+	f := func(conn connect.Connection) (interface{}, error) {
+		streamClient, err := pb.NewRemoteSyncClient(conn.GetGrpcConn()).
+			ReadStream(ctx, msg)
+		if err != nil {
+			return nil, errors.New(err.Error())
+		}
+		return streamClient, nil
+	}
+
+	jww.TRACE.Printf("Streaming ReadStream of %s", msg.GetPath())
+
+	// Execute the Stream function
+	resultClient, err := rc.Stream(host, f)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	return &Reader{
+		stream: resultClient.(pb.RemoteSync_ReadStreamClient),
+		cancel: cancel,
+		offset: msg.GetOffset(),
+	}, nil
+}
+
+// Read reads the next bytes of the range, returning io.EOF at its end.
+func (r *Reader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		chunk, err := r.stream.Recv()
+		if err == io.EOF {
+			return 0, io.EOF
+		} else if err != nil {
+			return 0, errors.New(err.Error())
+		}
+		if !chunk.Verify() {
+			return 0, ErrCorruptChunk
+		}
+		if chunk.GetOffset() != r.offset {
+			return 0, errors.Errorf("Received chunk at offset %d, "+
+				"expected %d", chunk.GetOffset(), r.offset)
+		}
+		r.buf = chunk.GetData()
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	r.offset += int64(n)
+	return n, nil
+}
+
+// Offset returns the offset in the path of the next byte read.
+func (r *Reader) Offset() int64 {
+	return r.offset
+}
+
+// Close closes the stream.
+func (r *Reader) Close() error {
+	r.cancel()
+	return nil
+}
+
+// Writer is an io.WriteCloser uploading to a path on a RemoteSync server in
+// chunks. The path is written once the Writer is closed.
+type Writer struct {
+	stream    pb.RemoteSync_WriteStreamClient
+	cancel    context.CancelFunc
+	path      string
+	token     []byte
+	chunkSize int
+
+	// Offset of the start of buf in the upload
+	offset int64
+	buf    []byte
+	err    error
+}
+
+// NewWriter starts a new upload to the path, replacing any unfinished one.
+func (rc *Comms) NewWriter(host *connect.Host, path string, token []byte,
+	chunkSize int) (*Writer, error) {
+	return rc.newWriter(host, path, token, chunkSize, 0)
+}
+
+// ResumeWriter continues the unfinished upload to the path. Writes continue
+// from the Offset of the returned Writer.
+func (rc *Comms) ResumeWriter(host *connect.Host, path string, token []byte,
+	chunkSize int) (*Writer, error) {
+	upload, err := rc.GetUploadOffset(host,
+		&pb.RsReadRequest{Path: path, Token: token})
+	if err != nil {
+		return nil, errors.WithMessagef(err,
+			"Unable to get offset of upload to %s", path)
+	}
+	return rc.newWriter(host, path, token, chunkSize, upload.GetOffset())
+}
+
+// newWriter opens a stream uploading to the path from the offset.
+func (rc *Comms) newWriter(host *connect.Host, path string, token []byte,
+	chunkSize int, offset int64) (*Writer, error) {
+	if chunkSize <= 0 {
+		return nil, errors.Errorf("Invalid chunk size %d", chunkSize)
+	}
+	ctx, cancel := connect.StreamingContext()
+
+	// Create the Stream Function
+	f := func(conn connect.Connection) (interface{}, error) {
+		streamClient, err := pb.NewRemoteSyncClient(conn.GetGrpcConn()).
+			WriteStream(ctx)
+		if err != nil {
+			return nil, errors.New(err.Error())
+		}
+		return streamClient, nil
+	}
+
+	jww.TRACE.Printf("Streaming WriteStream of %s from %d", path, offset)
+
+	// Execute the Stream function
+	resultClient, err := rc.Stream(host, f)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	return &Writer{
+		stream:    resultClient.(pb.RemoteSync_WriteStreamClient),
+		cancel:    cancel,
+		path:      path,
+		token:     token,
+		chunkSize: chunkSize,
+		offset:    offset,
+		buf:       make([]byte, 0, chunkSize),
+	}, nil
+}
+
+// Write buffers the bytes, sending each full chunk. If it fails, the upload
+// may be continued with ResumeWriter.
+func (w *Writer) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+
+	written := 0
+	for len(p) > 0 {
+		n := copy(w.buf[len(w.buf):w.chunkSize], p)
+		w.buf = w.buf[:len(w.buf)+n]
+		p = p[n:]
+		written += n
+		if len(w.buf) == w.chunkSize {
+			if err := w.send(false); err != nil {
+				return written, err
+			}
+		}
+	}
+	return written, nil
+}
+
+// Offset returns the offset in the upload of the next byte written.
+func (w *Writer) Offset() int64 {
+	return w.offset + int64(len(w.buf))
+}
+
+// Close sends the remaining bytes and finishes the upload, writing it to the
+// path.
+func (w *Writer) Close() error {
+	defer w.cancel()
+	if w.err != nil {
+		return w.err
+	}
+	if err := w.send(true); err != nil {
+		return err
+	}
+
+	upload, err := w.stream.CloseAndRecv()
+	if err != nil {
+		return errors.WithMessagef(err, "Failed to finish upload to %s",
+			w.path)
+	}
+	if upload.GetOffset() != w.offset {
+		return errors.Errorf("Server stored %d bytes of upload to %s, "+
+			"expected %d", upload.GetOffset(), w.path, w.offset)
+	}
+	return nil
+}
+
+// send sends the buffered bytes as a chunk.
+func (w *Writer) send(final bool) error {
+	err := w.stream.Send(&pb.RsWriteChunk{
+		Path:   w.path,
+		Token:  w.token,
+		Offset: w.offset,
+		Data:   w.buf,
+		Hash:   pb.HashRsChunk(w.buf),
+		Final:  final,
+	})
+	if err != nil {
+		// The server's error is only returned once the stream is closed
+		if _, recvErr := w.stream.CloseAndRecv(); recvErr != nil {
+			err = recvErr
+		}
+		w.err = errors.WithMessagef(err, "Failed to send chunk of upload "+
+			"to %s at offset %d", w.path, w.offset)
+		return w.err
+	}
+	// The sent chunk may still be referenced by the stream, so the next one
+	// is buffered in a new array
+	w.offset += int64(len(w.buf))
+	w.buf = make([]byte, 0, w.chunkSize)
+	return nil
+}
+
+// Watch opens a stream of the changes to paths under the prefix in msg. The
+// returned function closes the stream.
+func (rc *Comms) Watch(host *connect.Host, msg *pb.RsWatchRequest) (
+	pb.RemoteSync_WatchClient, context.CancelFunc, error) {
+	ctx, cancel := connect.StreamingContext()
+
+	// Create the Stream Function
+	f := func(conn connect.Connection) (interface{}, error) {
+		streamClient, err := pb.NewRemoteSyncClient(conn.GetGrpcConn()).
+			Watch(ctx, msg)
+		if err != nil {
+			return nil, errors.New(err.Error())
+		}
+		return streamClient, nil
+	}
+
+	jww.TRACE.Printf("Streaming Watch of %q from %d", msg.GetPrefix(),
+		msg.GetAfterSequence())
+
+	// Execute the Stream function
+	resultClient, err := rc.Stream(host, f)
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+
+	return resultClient.(pb.RemoteSync_WatchClient), cancel, nil
+}
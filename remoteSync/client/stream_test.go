@@ -0,0 +1,94 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package client
+
+import (
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/remoteSync/server"
+	"io"
+	"testing"
+)
+
+// recordingWriteStream records the chunks sent on a write stream.
+type recordingWriteStream struct {
+	pb.RemoteSync_WriteStreamClient
+	chunks []*pb.RsWriteChunk
+}
+
+// Send records the chunk.
+func (s *recordingWriteStream) Send(chunk *pb.RsWriteChunk) error {
+	s.chunks = append(s.chunks, chunk)
+	return nil
+}
+
+// Tests that a Reader returns the data of chunks up to one which does not
+// match its hash, then ErrCorruptChunk.
+func TestReader_CorruptChunk(t *testing.T) {
+	impl := server.NewImplementation()
+	impl.Functions.ReadStream = func(msg *pb.RsReadStreamRequest,
+		stream pb.RemoteSync_ReadStreamServer) error {
+		err := stream.Send(&pb.RsChunk{Offset: 0, Data: []byte("good")})
+		if err != nil {
+			return err
+		}
+		return stream.Send(&pb.RsChunk{Offset: 4, Data: []byte("bad"),
+			Hash: pb.HashRsChunk([]byte("other"))})
+	}
+	c, host := startTestServer(t, impl)
+
+	r, err := c.NewReader(host, &pb.RsReadStreamRequest{Path: "file"})
+	if err != nil {
+		t.Fatalf("NewReader produced an error: %+v", err)
+	}
+	defer r.Close()
+
+	data, err := io.ReadAll(r)
+	if err != ErrCorruptChunk {
+		t.Errorf("Expected ErrCorruptChunk, received: %v", err)
+	}
+	if string(data) != "good" {
+		t.Errorf("Unexpected data before the corrupt chunk: %q", data)
+	}
+	if r.Offset() != 4 {
+		t.Errorf("Reader should resume from offset 4, not %d", r.Offset())
+	}
+}
+
+// Tests that the chunks sent by a Writer keep their data after later writes
+// are buffered.
+func TestWriter_ChunksNotReused(t *testing.T) {
+	stream := &recordingWriteStream{}
+	w := &Writer{
+		stream:    stream,
+		path:      "file",
+		chunkSize: 4,
+		buf:       make([]byte, 0, 4),
+	}
+
+	if _, err := w.Write([]byte("abcdefghij")); err != nil {
+		t.Fatalf("Write produced an error: %+v", err)
+	}
+	if err := w.send(true); err != nil {
+		t.Fatalf("send produced an error: %+v", err)
+	}
+
+	expected := []string{"abcd", "efgh", "ij"}
+	if len(stream.chunks) != len(expected) {
+		t.Fatalf("Sent %d chunks, expected %d", len(stream.chunks),
+			len(expected))
+	}
+	for i, chunk := range stream.chunks {
+		if string(chunk.GetData()) != expected[i] {
+			t.Errorf("Chunk %d holds %q, expected %q", i, chunk.GetData(),
+				expected[i])
+		}
+		if !chunk.Verify() {
+			t.Errorf("Chunk %d does not match its hash", i)
+		}
+	}
+}
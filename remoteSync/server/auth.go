@@ -0,0 +1,161 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the user credentials and login tokens of the FileSystem handler
+
+package server
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/json"
+	"github.com/pkg/errors"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"os"
+	"regexp"
+	"time"
+)
+
+const tokenLen = 32
+
+var (
+	// ErrInvalidCredentials is returned by Login for an unknown user or a
+	// password hash or salt which does not match theirs.
+	ErrInvalidCredentials = status.Error(codes.Unauthenticated,
+		"invalid username or password")
+
+	// ErrInvalidToken is returned for requests with a token which was not
+	// issued by Login or has expired.
+	ErrInvalidToken = status.Error(codes.Unauthenticated,
+		"invalid or expired token")
+)
+
+// usernameFormat restricts usernames to those safe to use as a directory name.
+var usernameFormat = regexp.MustCompile(`^[A-Za-z0-9_\-][A-Za-z0-9_.\-]{0,63}$`)
+
+// credential is the stored password of a user. The verifier is the hash of
+// the password hash the user logs in with, so the stored file cannot be used
+// to log in.
+type credential struct {
+	Salt     []byte
+	Verifier []byte
+}
+
+// session is a token issued by Login.
+type session struct {
+	username string
+	expiry   time.Time
+}
+
+// AddUser adds the user, or replaces their credentials if they exist,
+// invalidating their tokens. The user logs in with the password hash and the
+// salt it was derived with.
+func (fs *FileSystem) AddUser(username string, passwordHash, salt []byte) error {
+	if !usernameFormat.MatchString(username) {
+		return errors.Errorf("Invalid username %q", username)
+	}
+	if len(passwordHash) == 0 {
+		return errors.New("Password hash is empty")
+	}
+
+	fs.authMux.Lock()
+	defer fs.authMux.Unlock()
+	verifier := sha256.Sum256(passwordHash)
+	users := make(map[string]credential, len(fs.users)+1)
+	for name, c := range fs.users {
+		users[name] = c
+	}
+	users[username] = credential{Salt: salt, Verifier: verifier[:]}
+
+	data, err := json.Marshal(users)
+	if err != nil {
+		return errors.Wrap(err, "Unable to marshal users")
+	}
+	if err = fs.writeAtomic(fs.usersPath(), data); err != nil {
+		return errors.WithMessage(err, "Unable to store users")
+	}
+	fs.users = users
+
+	for token, s := range fs.sessions {
+		if s.username == username {
+			delete(fs.sessions, token)
+		}
+	}
+	return nil
+}
+
+// Login returns a token for the user if the password hash and salt match
+// theirs.
+func (fs *FileSystem) Login(msg *pb.RsAuthenticationRequest) (
+	*pb.RsAuthenticationResponse, error) {
+	fs.authMux.Lock()
+	defer fs.authMux.Unlock()
+
+	c, ok := fs.users[msg.GetUsername()]
+	verifier := sha256.Sum256(msg.GetPasswordHash())
+	if !ok || subtle.ConstantTimeCompare(c.Salt, msg.GetSalt()) != 1 ||
+		subtle.ConstantTimeCompare(c.Verifier, verifier[:]) != 1 {
+		return nil, ErrInvalidCredentials
+	}
+
+	token := make([]byte, tokenLen)
+	if _, err := rand.Read(token); err != nil {
+		return nil, status.Error(codes.Internal, "unable to generate token")
+	}
+
+	now := time.Now()
+	for t, s := range fs.sessions {
+		if !now.Before(s.expiry) {
+			delete(fs.sessions, t)
+		}
+	}
+	expiry := now.Add(fs.params.TokenLifetime)
+	fs.sessions[string(token)] = session{
+		username: msg.GetUsername(),
+		expiry:   expiry,
+	}
+
+	return &pb.RsAuthenticationResponse{
+		Token:     token,
+		ExpiresAt: expiry.UnixNano(),
+	}, nil
+}
+
+// authenticate returns the user the token was issued to.
+func (fs *FileSystem) authenticate(token []byte) (string, error) {
+	s, err := fs.getSession(token)
+	return s.username, err
+}
+
+// getSession returns the session of the token, if it has not expired.
+func (fs *FileSystem) getSession(token []byte) (session, error) {
+	fs.authMux.Lock()
+	defer fs.authMux.Unlock()
+	s, ok := fs.sessions[string(token)]
+	if !ok {
+		return session{}, ErrInvalidToken
+	}
+	if !time.Now().Before(s.expiry) {
+		delete(fs.sessions, string(token))
+		return session{}, ErrInvalidToken
+	}
+	return s, nil
+}
+
+// loadUsers reads the stored users, if there are any.
+func (fs *FileSystem) loadUsers() error {
+	data, err := os.ReadFile(fs.usersPath())
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "Unable to read users")
+	}
+	return errors.Wrap(json.Unmarshal(data, &fs.users), "Unable to parse users")
+}
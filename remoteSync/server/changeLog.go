@@ -0,0 +1,166 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the ChangeLog which streams changes to watchers
+
+package server
+
+import (
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrHistoryLost is returned by Watch when changes after the requested
+// sequence number are no longer kept. The watcher must start again from
+// sequence zero.
+var ErrHistoryLost = status.Error(codes.OutOfRange,
+	"changes after the sequence number are no longer available")
+
+// ChangeLog keeps the recent changes to the paths of each namespace and
+// streams them to watchers. Sequence numbers increase across all namespaces
+// and start from the time the ChangeLog is created, so a sequence number from
+// a previous ChangeLog is reported as lost rather than reused.
+type ChangeLog struct {
+	mux        sync.Mutex
+	size       int
+	namespaces map[string]*history
+	start      uint64
+	next       uint64
+}
+
+// history is the recent changes to the paths of a namespace.
+type history struct {
+	changes []*pb.RsChangeEvent
+
+	// Sequence number of the last change no longer kept, or zero
+	lost uint64
+
+	// Closed and replaced when a change is added
+	added chan struct{}
+}
+
+// NewChangeLog returns a ChangeLog keeping the size most recent changes of
+// each namespace.
+func NewChangeLog(size int) *ChangeLog {
+	start := uint64(time.Now().UnixNano())
+	return &ChangeLog{
+		size:       size,
+		namespaces: make(map[string]*history),
+		start:      start,
+		next:       start,
+	}
+}
+
+// Add records the change to a path of the namespace, setting its sequence
+// number and timestamp.
+func (l *ChangeLog) Add(namespace string, event *pb.RsChangeEvent) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	event.Sequence = l.next
+	event.Timestamp = time.Now().UnixNano()
+	l.next++
+
+	h := l.history(namespace)
+	h.changes = append(h.changes, event)
+	if len(h.changes) > l.size {
+		h.lost = h.changes[0].Sequence
+		h.changes[0] = nil
+		h.changes = h.changes[1:]
+	}
+	close(h.added)
+	h.added = make(chan struct{})
+}
+
+// Watch sends the changes to paths of the namespace under the prefix until
+// the stream is closed. See pb.RsWatchRequest.
+func (l *ChangeLog) Watch(namespace, prefix string, after uint64,
+	stream pb.RemoteSync_WatchServer) error {
+	prefix = strings.TrimPrefix(path.Clean("/"+prefix), "/")
+
+	if after == 0 {
+		l.mux.Lock()
+		after = l.next - 1
+		l.mux.Unlock()
+		err := stream.Send(&pb.RsChangeEvent{
+			Sequence:  after,
+			Type:      pb.RsChangeStarted,
+			Timestamp: time.Now().UnixNano(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for {
+		events, added, err := l.since(namespace, prefix, &after)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			if err = stream.Send(event); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-added:
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// since returns the matching changes after the sequence number, advancing it
+// past all changes, and a channel closed when the next change to the
+// namespace is added.
+func (l *ChangeLog) since(namespace, prefix string, after *uint64) (
+	[]*pb.RsChangeEvent, chan struct{}, error) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	h := l.history(namespace)
+	if *after+1 < l.start || *after >= l.next || *after < h.lost {
+		return nil, nil, ErrHistoryLost
+	}
+
+	first := sort.Search(len(h.changes), func(i int) bool {
+		return h.changes[i].Sequence > *after
+	})
+	var events []*pb.RsChangeEvent
+	for _, event := range h.changes[first:] {
+		if underPrefix(event.Path, prefix) || underPrefix(event.NewPath, prefix) {
+			events = append(events, event)
+		}
+	}
+	*after = l.next - 1
+	return events, h.added, nil
+}
+
+// history returns the history of the namespace, creating it if it has none.
+// The caller must hold the lock.
+func (l *ChangeLog) history(namespace string) *history {
+	h, ok := l.namespaces[namespace]
+	if !ok {
+		h = &history{added: make(chan struct{})}
+		l.namespaces[namespace] = h
+	}
+	return h
+}
+
+// underPrefix returns true if the path is the prefix or inside it.
+func underPrefix(p, prefix string) bool {
+	if p == "" {
+		return false
+	}
+	return prefix == "" || p == prefix || strings.HasPrefix(p, prefix+"/")
+}
@@ -0,0 +1,122 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package server
+
+import (
+	"context"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"testing"
+	"time"
+)
+
+// eventStream is a RemoteSync_WatchServer passing the events sent to a
+// channel.
+type eventStream struct {
+	pb.RemoteSync_WatchServer
+	ctx    context.Context
+	events chan *pb.RsChangeEvent
+}
+
+func newEventStream(ctx context.Context) *eventStream {
+	return &eventStream{ctx: ctx, events: make(chan *pb.RsChangeEvent, 100)}
+}
+
+func (s *eventStream) Context() context.Context { return s.ctx }
+func (s *eventStream) Send(event *pb.RsChangeEvent) error {
+	s.events <- event
+	return nil
+}
+
+// receive returns the paths of the next n events sent.
+func (s *eventStream) receive(t *testing.T, n int) []string {
+	var paths []string
+	for i := 0; i < n; i++ {
+		select {
+		case event := <-s.events:
+			paths = append(paths, event.GetPath())
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Timed out waiting for event %d of %d", i, n)
+		}
+	}
+	return paths
+}
+
+// Tests that a watcher resuming from a sequence number receives the later
+// changes of its namespace under its prefix, and then new changes as they are
+// added.
+func TestChangeLog_Watch_Resume(t *testing.T) {
+	l := NewChangeLog(10)
+	first := &pb.RsChangeEvent{Path: "dir/a"}
+	l.Add("alice", first)
+	l.Add("bob", &pb.RsChangeEvent{Path: "dir/b"})
+	l.Add("alice", &pb.RsChangeEvent{Path: "other/c"})
+	l.Add("alice", &pb.RsChangeEvent{Path: "dir/d"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := newEventStream(ctx)
+	result := make(chan error)
+	go func() {
+		result <- l.Watch("alice", "dir", first.GetSequence(), stream)
+	}()
+
+	if paths := stream.receive(t, 1); paths[0] != "dir/d" {
+		t.Errorf("Expected dir/d after resuming, received %s", paths[0])
+	}
+	l.Add("bob", &pb.RsChangeEvent{Path: "dir/e"})
+	l.Add("alice", &pb.RsChangeEvent{Path: "dir/f", NewPath: "other/f"})
+	if paths := stream.receive(t, 1); paths[0] != "dir/f" {
+		t.Errorf("Expected dir/f, received %s", paths[0])
+	}
+
+	cancel()
+	if err := <-result; err != nil {
+		t.Errorf("Watch produced an error once closed: %+v", err)
+	}
+	if len(stream.events) != 0 {
+		t.Errorf("Received %d unexpected events", len(stream.events))
+	}
+}
+
+// Tests that Watch returns ErrHistoryLost when changes after the sequence
+// number are no longer kept, without changes to other namespaces evicting
+// the history of the watched one.
+func TestChangeLog_Watch_HistoryLost(t *testing.T) {
+	l := NewChangeLog(2)
+	start := l.next
+	l.Add("alice", &pb.RsChangeEvent{Path: "a"})
+	for i := 0; i < 5; i++ {
+		l.Add("bob", &pb.RsChangeEvent{Path: "b"})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := newEventStream(ctx)
+	go func() { _ = l.Watch("alice", "", start-1, stream) }()
+	if paths := stream.receive(t, 1); paths[0] != "a" {
+		t.Errorf("Expected a, received %s", paths[0])
+	}
+
+	tests := []struct {
+		name      string
+		namespace string
+		after     uint64
+	}{
+		{"evicted", "bob", start - 1},
+		{"previous log", "alice", start - 2},
+		{"future", "alice", l.next},
+	}
+	for _, tt := range tests {
+		ctx, cancel := context.WithCancel(context.Background())
+		err := l.Watch(tt.namespace, "", tt.after, newEventStream(ctx))
+		cancel()
+		if err != ErrHistoryLost {
+			t.Errorf("Expected ErrHistoryLost for %s sequence, received: %v",
+				tt.name, err)
+		}
+	}
+}
@@ -44,3 +44,44 @@ func (rc *Comms) GetLastWrite(ctx context.Context, message *pb.RsLastWriteReques
 func (rc *Comms) ReadDir(ctx context.Context, message *pb.RsReadRequest) (*pb.RsReadDirResponse, error) {
 	return rc.handler.ReadDir(message)
 }
+
+// Delete removes a resource from the server
+func (rc *Comms) Delete(ctx context.Context, message *pb.RsReadRequest) (*messages.Ack, error) {
+	return rc.handler.Delete(message)
+}
+
+// Rename moves a resource to a new path on the server
+func (rc *Comms) Rename(ctx context.Context, message *pb.RsRenameRequest) (*messages.Ack, error) {
+	return rc.handler.Rename(message)
+}
+
+// Stat returns the metadata of a resource
+func (rc *Comms) Stat(ctx context.Context, message *pb.RsReadRequest) (*pb.RsStatResponse, error) {
+	return rc.handler.Stat(message)
+}
+
+// ConditionalWrite writes data to the server if the resource has not been
+// modified since the expected timestamp
+func (rc *Comms) ConditionalWrite(ctx context.Context, message *pb.RsConditionalWriteRequest) (*messages.Ack, error) {
+	return rc.handler.ConditionalWrite(message)
+}
+
+// ReadStream streams a range of a resource from the server in chunks
+func (rc *Comms) ReadStream(message *pb.RsReadStreamRequest, stream pb.RemoteSync_ReadStreamServer) error {
+	return rc.handler.ReadStream(message, &hashingReadStream{stream})
+}
+
+// WriteStream uploads a resource to the server in chunks
+func (rc *Comms) WriteStream(stream pb.RemoteSync_WriteStreamServer) error {
+	return rc.handler.WriteStream(&verifyingWriteStream{stream})
+}
+
+// GetUploadOffset returns the number of bytes stored of an unfinished upload
+func (rc *Comms) GetUploadOffset(ctx context.Context, message *pb.RsReadRequest) (*pb.RsUploadOffset, error) {
+	return rc.handler.GetUploadOffset(message)
+}
+
+// Watch streams changes to resources on the server
+func (rc *Comms) Watch(message *pb.RsWatchRequest, stream pb.RemoteSync_WatchServer) error {
+	return rc.handler.Watch(message, stream)
+}
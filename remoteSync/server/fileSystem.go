@@ -0,0 +1,752 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains FileSystem, a Handler storing each user's data in a directory
+
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/messages"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// streamChunkSize is the size of the chunks sent by ReadStream.
+const streamChunkSize = 512 * 1024
+
+// Directories under the root of a FileSystem
+const (
+	dataDir      = "data"
+	uploadsDir   = "uploads"
+	lastWriteDir = "lastWrite"
+	tmpDir       = "tmp"
+)
+
+var (
+	// ErrInvalidPath is returned for paths which are empty or contain
+	// invalid characters.
+	ErrInvalidPath = status.Error(codes.InvalidArgument, "invalid path")
+
+	// ErrNotFound is returned for paths which do not exist.
+	ErrNotFound = status.Error(codes.NotFound, "path not found")
+
+	// ErrExists is returned by Rename if the new path exists.
+	ErrExists = status.Error(codes.AlreadyExists, "path already exists")
+
+	// ErrIsDir is returned when reading or writing a directory as a file.
+	ErrIsDir = status.Error(codes.InvalidArgument, "path is a directory")
+
+	// ErrUploadInProgress is returned by WriteStream if another stream is
+	// uploading to the path.
+	ErrUploadInProgress = status.Error(codes.Aborted,
+		"upload to path is already in progress")
+)
+
+// FileSystemParams configures a FileSystem.
+type FileSystemParams struct {
+	// Directory all data is stored under
+	Root string
+
+	// Time a token issued by Login is valid for
+	TokenLifetime time.Duration
+
+	// Number of recent changes to the paths of each user kept for watchers
+	// to resume from
+	HistorySize int
+}
+
+// DefaultFileSystemParams returns the default FileSystemParams, storing data
+// under the root directory.
+func DefaultFileSystemParams(root string) FileSystemParams {
+	return FileSystemParams{
+		Root:          root,
+		TokenLifetime: 24 * time.Hour,
+		HistorySize:   10000,
+	}
+}
+
+// FileSystem is a Handler storing the data of each user in their own
+// directory under the root. Users are added with AddUser and log in for a
+// token, which all other requests must hold. Paths are slash-separated and
+// relative to the user's directory, which they cannot leave. Writes replace
+// files atomically, so readers see either the old or the new contents.
+//
+// Timestamps are Unix nanoseconds. The last modified time of a path is the
+// time of its last change, made unique so ConditionalWrite detects every
+// change, or its modification time on disk if it has not changed since the
+// FileSystem started. The last write time of a user is the time they last
+// changed any of their paths.
+type FileSystem struct {
+	params FileSystemParams
+
+	authMux  sync.Mutex
+	users    map[string]credential
+	sessions map[string]session
+
+	// Held for reading while reading paths, and for writing while changing
+	// them
+	mux sync.RWMutex
+
+	// Last modified time of each path of each user changed since the
+	// FileSystem started, and the latest time given to any path. Held under
+	// mux.
+	modified     map[string]map[string]int64
+	lastModified int64
+
+	uploadMux sync.Mutex
+	uploading map[string]bool
+
+	changes *ChangeLog
+}
+
+// NewFileSystem returns a FileSystem storing data under the root directory,
+// loading the users stored there.
+func NewFileSystem(params FileSystemParams) (*FileSystem, error) {
+	for _, dir := range []string{dataDir, uploadsDir, lastWriteDir} {
+		err := os.MkdirAll(filepath.Join(params.Root, dir), 0700)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Unable to create %s directory", dir)
+		}
+	}
+	// Remove files left by writes which were interrupted
+	tmp := filepath.Join(params.Root, tmpDir)
+	if err := os.RemoveAll(tmp); err != nil {
+		return nil, errors.Wrap(err, "Unable to clear tmp directory")
+	}
+	if err := os.MkdirAll(tmp, 0700); err != nil {
+		return nil, errors.Wrap(err, "Unable to create tmp directory")
+	}
+
+	fs := &FileSystem{
+		params:    params,
+		users:     make(map[string]credential),
+		sessions:  make(map[string]session),
+		uploading: make(map[string]bool),
+		modified:  make(map[string]map[string]int64),
+		changes:   NewChangeLog(params.HistorySize),
+
+		// Later than the modification time of every path on disk
+		lastModified: time.Now().UnixNano(),
+	}
+	if err := fs.loadUsers(); err != nil {
+		return nil, err
+	}
+	return fs, nil
+}
+
+// Read returns the contents of a file.
+func (fs *FileSystem) Read(msg *pb.RsReadRequest) (*pb.RsReadResponse, error) {
+	p, err := fs.resolve(msg.GetToken(), msg.GetPath(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	fs.mux.RLock()
+	defer fs.mux.RUnlock()
+	data, err := os.ReadFile(p)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.RsReadResponse{Data: data}, nil
+}
+
+// Write replaces the contents of a file, creating it and its parent
+// directories if they do not exist.
+func (fs *FileSystem) Write(msg *pb.RsWriteRequest) (*messages.Ack, error) {
+	user, p, err := fs.resolveUser(msg.GetToken(), msg.GetPath(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	fs.mux.Lock()
+	defer fs.mux.Unlock()
+	return &messages.Ack{}, fs.writeFile(user, msg.GetPath(), p, msg.GetData())
+}
+
+// ConditionalWrite replaces the contents of a file if its last modified time
+// is the expected one, or creates it if it is expected not to exist.
+func (fs *FileSystem) ConditionalWrite(msg *pb.RsConditionalWriteRequest) (
+	*messages.Ack, error) {
+	user, p, err := fs.resolveUser(msg.GetToken(), msg.GetPath(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	fs.mux.Lock()
+	defer fs.mux.Unlock()
+	var lastModified int64
+	info, err := os.Stat(p)
+	if err == nil {
+		lastModified = fs.getLastModified(user, msg.GetPath(), info)
+	} else if !os.IsNotExist(err) {
+		return nil, toStatus(err)
+	}
+	if lastModified != msg.GetExpectedLastModified() {
+		return nil, ErrModified
+	}
+	return &messages.Ack{}, fs.writeFile(user, msg.GetPath(), p, msg.GetData())
+}
+
+// GetLastModified returns the last modified time of a path.
+func (fs *FileSystem) GetLastModified(msg *pb.RsReadRequest) (
+	*pb.RsTimestampResponse, error) {
+	stat, err := fs.Stat(msg)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RsTimestampResponse{Timestamp: stat.GetLastModified()}, nil
+}
+
+// GetLastWrite returns the last time the user changed any of their paths, or
+// zero if they never have.
+func (fs *FileSystem) GetLastWrite(msg *pb.RsLastWriteRequest) (
+	*pb.RsTimestampResponse, error) {
+	user, err := fs.authenticate(msg.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	fs.mux.RLock()
+	defer fs.mux.RUnlock()
+	data, err := os.ReadFile(filepath.Join(fs.params.Root, lastWriteDir, user))
+	if os.IsNotExist(err) {
+		return &pb.RsTimestampResponse{}, nil
+	} else if err != nil {
+		return nil, toStatus(err)
+	}
+	timestamp, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.RsTimestampResponse{Timestamp: timestamp}, nil
+}
+
+// ReadDir returns the names of the entries of a directory. The empty path is
+// the user's directory.
+func (fs *FileSystem) ReadDir(msg *pb.RsReadRequest) (*pb.RsReadDirResponse, error) {
+	p, err := fs.resolve(msg.GetToken(), msg.GetPath(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	fs.mux.RLock()
+	defer fs.mux.RUnlock()
+	entries, err := os.ReadDir(p)
+	if os.IsNotExist(err) && path.Clean("/"+msg.GetPath()) == "/" {
+		// The user has not written anything yet
+		return &pb.RsReadDirResponse{}, nil
+	} else if err != nil {
+		return nil, toStatus(err)
+	}
+
+	names := make([]string, len(entries))
+	for i, entry := range entries {
+		names[i] = entry.Name()
+	}
+	return &pb.RsReadDirResponse{Data: names}, nil
+}
+
+// Delete removes a file or empty directory.
+func (fs *FileSystem) Delete(msg *pb.RsReadRequest) (*messages.Ack, error) {
+	user, p, err := fs.resolveUser(msg.GetToken(), msg.GetPath(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	fs.mux.Lock()
+	defer fs.mux.Unlock()
+	if err = os.Remove(p); err != nil {
+		return nil, toStatus(err)
+	}
+	return &messages.Ack{}, fs.changed(user, &pb.RsChangeEvent{
+		Type: pb.RsChangeDeleted,
+		Path: cleanPath(msg.GetPath()),
+	})
+}
+
+// Rename moves a file or directory to a path which does not exist, creating
+// its parent directories.
+func (fs *FileSystem) Rename(msg *pb.RsRenameRequest) (*messages.Ack, error) {
+	user, oldPath, err := fs.resolveUser(msg.GetToken(), msg.GetOldPath(), false)
+	if err != nil {
+		return nil, err
+	}
+	_, newPath, err := fs.resolveUser(msg.GetToken(), msg.GetNewPath(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	fs.mux.Lock()
+	defer fs.mux.Unlock()
+	if _, err = os.Stat(oldPath); err != nil {
+		return nil, toStatus(err)
+	}
+	if _, err = os.Stat(newPath); err == nil {
+		return nil, ErrExists
+	} else if !os.IsNotExist(err) {
+		return nil, toStatus(err)
+	}
+	if err = os.MkdirAll(filepath.Dir(newPath), 0700); err != nil {
+		return nil, toStatus(err)
+	}
+	if err = os.Rename(oldPath, newPath); err != nil {
+		return nil, toStatus(err)
+	}
+	return &messages.Ack{}, fs.changed(user, &pb.RsChangeEvent{
+		Type:    pb.RsChangeRenamed,
+		Path:    cleanPath(msg.GetOldPath()),
+		NewPath: cleanPath(msg.GetNewPath()),
+	})
+}
+
+// Stat returns the size, type and last modified time of a path.
+func (fs *FileSystem) Stat(msg *pb.RsReadRequest) (*pb.RsStatResponse, error) {
+	user, p, err := fs.resolveUser(msg.GetToken(), msg.GetPath(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	fs.mux.RLock()
+	defer fs.mux.RUnlock()
+	info, err := os.Stat(p)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.RsStatResponse{
+		Size:         info.Size(),
+		IsDir:        info.IsDir(),
+		LastModified: fs.getLastModified(user, msg.GetPath(), info),
+	}, nil
+}
+
+// ReadStream sends the requested range of a file in chunks.
+func (fs *FileSystem) ReadStream(msg *pb.RsReadStreamRequest,
+	stream pb.RemoteSync_ReadStreamServer) error {
+	if msg.GetOffset() < 0 || msg.GetLength() < 0 {
+		return status.Error(codes.InvalidArgument, "negative offset or length")
+	}
+	p, err := fs.resolve(msg.GetToken(), msg.GetPath(), false)
+	if err != nil {
+		return err
+	}
+
+	// Writes replace the file rather than changing it, so it can be read
+	// after releasing the lock
+	fs.mux.RLock()
+	f, err := os.Open(p)
+	fs.mux.RUnlock()
+	if err != nil {
+		return toStatus(err)
+	}
+	defer f.Close()
+	if info, err := f.Stat(); err != nil {
+		return toStatus(err)
+	} else if info.IsDir() {
+		return ErrIsDir
+	}
+
+	if _, err = f.Seek(msg.GetOffset(), io.SeekStart); err != nil {
+		return toStatus(err)
+	}
+	var r io.Reader = f
+	if msg.GetLength() > 0 {
+		r = io.LimitReader(f, msg.GetLength())
+	}
+
+	offset := msg.GetOffset()
+	buf := make([]byte, streamChunkSize)
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			sendErr := stream.Send(&pb.RsChunk{Offset: offset, Data: buf[:n]})
+			if sendErr != nil {
+				return sendErr
+			}
+			offset += int64(n)
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil
+		} else if err != nil {
+			return toStatus(err)
+		}
+	}
+}
+
+// WriteStream stores the chunks of an upload, writing it to its path once the
+// final chunk is stored.
+func (fs *FileSystem) WriteStream(stream pb.RemoteSync_WriteStreamServer) error {
+	var (
+		first  *pb.RsWriteChunk
+		user   string
+		dest   string
+		upload string
+		f      *os.File
+		size   int64
+		done   bool
+	)
+	defer func() {
+		if f != nil {
+			_ = f.Close()
+		}
+		if upload != "" {
+			fs.uploadMux.Lock()
+			delete(fs.uploading, upload)
+			fs.uploadMux.Unlock()
+		}
+	}()
+
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(&pb.RsUploadOffset{Offset: size})
+		} else if err != nil {
+			return err
+		}
+
+		if first == nil {
+			first = chunk
+			user, dest, err = fs.resolveUser(chunk.GetToken(), chunk.GetPath(), false)
+			if err != nil {
+				return err
+			}
+			uploadPath := fs.uploadPath(user, chunk.GetPath())
+
+			fs.uploadMux.Lock()
+			if fs.uploading[uploadPath] {
+				fs.uploadMux.Unlock()
+				return ErrUploadInProgress
+			}
+			fs.uploading[uploadPath] = true
+			fs.uploadMux.Unlock()
+			upload = uploadPath
+
+			if f, size, err = openUpload(upload, chunk.GetOffset()); err != nil {
+				return err
+			}
+		} else if done {
+			return status.Error(codes.InvalidArgument,
+				"chunk received after the final chunk")
+		} else if chunk.GetPath() != first.GetPath() ||
+			string(chunk.GetToken()) != string(first.GetToken()) {
+			return status.Error(codes.InvalidArgument,
+				"chunks of an upload must have the same path and token")
+		}
+
+		if chunk.GetOffset() != size {
+			return status.Errorf(codes.FailedPrecondition,
+				"chunk offset %d does not match the %d bytes stored",
+				chunk.GetOffset(), size)
+		}
+		n, err := f.Write(chunk.GetData())
+		size += int64(n)
+		if err != nil {
+			return toStatus(err)
+		}
+
+		if chunk.GetFinal() {
+			if err = fs.finishUpload(user, first.GetPath(), f, dest); err != nil {
+				return err
+			}
+			f, done = nil, true
+		}
+	}
+}
+
+// Watch streams the changes to the user's paths under the prefix until the
+// token expires, when it returns ErrInvalidToken. Sequence numbers restart
+// after the FileSystem is restarted, so watchers resuming from before then
+// must start again.
+func (fs *FileSystem) Watch(msg *pb.RsWatchRequest,
+	stream pb.RemoteSync_WatchServer) error {
+	s, err := fs.getSession(msg.GetToken())
+	if err != nil {
+		return err
+	}
+	if strings.ContainsAny(msg.GetPrefix(), "\x00\\") {
+		return ErrInvalidPath
+	}
+
+	ctx, cancel := context.WithDeadline(stream.Context(), s.expiry)
+	defer cancel()
+	err = fs.changes.Watch(s.username, msg.GetPrefix(),
+		msg.GetAfterSequence(), &watchStream{stream, ctx})
+	if err == nil && !time.Now().Before(s.expiry) {
+		return ErrInvalidToken
+	}
+	return err
+}
+
+// watchStream is a RemoteSync_WatchServer whose context is replaced.
+type watchStream struct {
+	pb.RemoteSync_WatchServer
+	ctx context.Context
+}
+
+func (s *watchStream) Context() context.Context {
+	return s.ctx
+}
+
+// GetUploadOffset returns the number of bytes stored of the unfinished upload
+// to a path.
+func (fs *FileSystem) GetUploadOffset(msg *pb.RsReadRequest) (
+	*pb.RsUploadOffset, error) {
+	user, _, err := fs.resolveUser(msg.GetToken(), msg.GetPath(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(fs.uploadPath(user, msg.GetPath()))
+	if os.IsNotExist(err) {
+		return &pb.RsUploadOffset{}, nil
+	} else if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.RsUploadOffset{Offset: info.Size()}, nil
+}
+
+// openUpload opens the upload file to continue from the offset, which must be
+// its size, or truncates it if the offset is zero.
+func openUpload(upload string, offset int64) (*os.File, int64, error) {
+	if offset == 0 {
+		f, err := os.OpenFile(upload, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+		if err != nil {
+			return nil, 0, toStatus(err)
+		}
+		return f, 0, nil
+	}
+
+	f, err := os.OpenFile(upload, os.O_WRONLY|os.O_APPEND, 0600)
+	if os.IsNotExist(err) {
+		return nil, 0, status.Errorf(codes.FailedPrecondition,
+			"chunk offset %d does not match the 0 bytes stored", offset)
+	} else if err != nil {
+		return nil, 0, toStatus(err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return nil, 0, toStatus(err)
+	}
+	return f, info.Size(), nil
+}
+
+// finishUpload closes the upload file and moves it to the user's path.
+func (fs *FileSystem) finishUpload(user, name string, f *os.File,
+	dest string) error {
+	err := f.Sync()
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return toStatus(err)
+	}
+
+	fs.mux.Lock()
+	defer fs.mux.Unlock()
+	if info, err := os.Stat(dest); err == nil && info.IsDir() {
+		return ErrIsDir
+	}
+	if err = os.MkdirAll(filepath.Dir(dest), 0700); err != nil {
+		return toStatus(err)
+	}
+	if err = os.Rename(f.Name(), dest); err != nil {
+		return toStatus(err)
+	}
+	return fs.changed(user, &pb.RsChangeEvent{
+		Type: pb.RsChangeWritten,
+		Path: cleanPath(name),
+	})
+}
+
+// resolve returns the location on disk of the path of the token's user.
+func (fs *FileSystem) resolve(token []byte, p string, allowRoot bool) (
+	string, error) {
+	_, resolved, err := fs.resolveUser(token, p, allowRoot)
+	return resolved, err
+}
+
+// resolveUser returns the token's user and the location on disk of their
+// path. The path cannot leave the user's directory, and is only allowed to be
+// the directory itself if allowRoot is set.
+func (fs *FileSystem) resolveUser(token []byte, p string, allowRoot bool) (
+	string, string, error) {
+	user, err := fs.authenticate(token)
+	if err != nil {
+		return "", "", err
+	}
+
+	if strings.ContainsAny(p, "\x00\\") {
+		return "", "", ErrInvalidPath
+	}
+	// Cleaning a rooted path removes any ".." which would leave the root
+	clean := path.Clean("/" + p)
+	if clean == "/" && !allowRoot {
+		return "", "", ErrInvalidPath
+	}
+	return user, filepath.Join(fs.params.Root, dataDir, user,
+		filepath.FromSlash(clean)), nil
+}
+
+// uploadPath returns the location on disk of the unfinished upload to the
+// user's path.
+func (fs *FileSystem) uploadPath(user, p string) string {
+	h := sha256.Sum256([]byte(cleanPath(p)))
+	return filepath.Join(fs.params.Root, uploadsDir,
+		user+"-"+hex.EncodeToString(h[:]))
+}
+
+// cleanPath returns the path relative to the user's directory, as used in
+// change events.
+func cleanPath(p string) string {
+	return strings.TrimPrefix(path.Clean("/"+p), "/")
+}
+
+// usersPath returns the location on disk of the stored users.
+func (fs *FileSystem) usersPath() string {
+	return filepath.Join(fs.params.Root, "users.json")
+}
+
+// writeFile replaces the contents of the user's path, stored at p. The caller
+// must hold the write lock.
+func (fs *FileSystem) writeFile(user, name, p string, data []byte) error {
+	if info, err := os.Stat(p); err == nil && info.IsDir() {
+		return ErrIsDir
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0700); err != nil {
+		return toStatus(err)
+	}
+	if err := fs.writeAtomic(p, data); err != nil {
+		return toStatus(err)
+	}
+	return fs.changed(user, &pb.RsChangeEvent{
+		Type: pb.RsChangeWritten,
+		Path: cleanPath(name),
+	})
+}
+
+// getLastModified returns the last modified time of the user's path, whose
+// file on disk is described by info. The caller must hold the lock.
+func (fs *FileSystem) getLastModified(user, name string,
+	info os.FileInfo) int64 {
+	if lastModified, ok := fs.modified[user][cleanPath(name)]; ok {
+		return lastModified
+	}
+	return info.ModTime().UnixNano()
+}
+
+// setModified sets the last modified time of the paths changed by the event
+// to a time later than any given before, so each change of a path gives it a
+// new last modified time even if the clock has not advanced. The caller must
+// hold the write lock.
+func (fs *FileSystem) setModified(user string, event *pb.RsChangeEvent) {
+	now := time.Now().UnixNano()
+	if now <= fs.lastModified {
+		now = fs.lastModified + 1
+	}
+	fs.lastModified = now
+
+	paths := fs.modified[user]
+	if paths == nil {
+		paths = make(map[string]int64)
+		fs.modified[user] = paths
+	}
+	switch event.GetType() {
+	case pb.RsChangeWritten:
+		paths[event.GetPath()] = now
+	case pb.RsChangeDeleted:
+		delete(paths, event.GetPath())
+	case pb.RsChangeRenamed:
+		// Paths inside a renamed directory move with it
+		oldPrefix := event.GetPath() + "/"
+		var moved []string
+		for p := range paths {
+			if p == event.GetPath() {
+				delete(paths, p)
+			} else if strings.HasPrefix(p, oldPrefix) {
+				delete(paths, p)
+				moved = append(moved, p[len(oldPrefix):])
+			}
+		}
+		for _, p := range moved {
+			paths[event.GetNewPath()+"/"+p] = now
+		}
+		paths[event.GetNewPath()] = now
+	}
+}
+
+// changed records the change to the user's paths for watchers and sets their
+// last write time to its time. The caller must hold the write lock.
+func (fs *FileSystem) changed(user string, event *pb.RsChangeEvent) error {
+	fs.setModified(user, event)
+	fs.changes.Add(user, event)
+	timestamp := strconv.FormatInt(event.GetTimestamp(), 10)
+	err := fs.writeAtomic(filepath.Join(fs.params.Root, lastWriteDir, user),
+		[]byte(timestamp))
+	if err != nil {
+		return toStatus(err)
+	}
+	return nil
+}
+
+// writeAtomic writes the file through a temporary file which is synced to
+// disk and renamed over it.
+func (fs *FileSystem) writeAtomic(p string, data []byte) error {
+	f, err := os.CreateTemp(filepath.Join(fs.params.Root, tmpDir), "write-")
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(data); err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), p)
+	}
+	if err != nil {
+		_ = os.Remove(f.Name())
+	}
+	return err
+}
+
+// toStatus converts a file system error to a gRPC status error, without
+// revealing locations on disk.
+func toStatus(err error) error {
+	switch {
+	case os.IsNotExist(err):
+		return ErrNotFound
+	case errors.Is(err, syscall.EISDIR):
+		return ErrIsDir
+	case errors.Is(err, syscall.ENOTDIR):
+		return ErrNotFound
+	case errors.Is(err, syscall.EINVAL):
+		return ErrInvalidPath
+	case errors.Is(err, syscall.ENOTEMPTY):
+		// Before os.IsExist, which also matches it
+		return status.Error(codes.FailedPrecondition, "directory is not empty")
+	case os.IsExist(err):
+		return ErrExists
+	}
+	jww.ERROR.Printf("Remote sync file system error: %+v", err)
+	return status.Error(codes.Internal, "internal file system error")
+}
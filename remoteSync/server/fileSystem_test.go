@@ -0,0 +1,217 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package server
+
+import (
+	"context"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// newTestFileSystem returns a FileSystem in a temporary directory with the
+// users added.
+func newTestFileSystem(t *testing.T, users ...string) *FileSystem {
+	fs, err := NewFileSystem(DefaultFileSystemParams(t.TempDir()))
+	if err != nil {
+		t.Fatalf("NewFileSystem produced an error: %+v", err)
+	}
+	for _, user := range users {
+		if err = fs.AddUser(user, []byte(user+" password"), []byte("salt")); err != nil {
+			t.Fatalf("AddUser produced an error: %+v", err)
+		}
+	}
+	return fs
+}
+
+// login returns a token for the user added by newTestFileSystem.
+func login(t *testing.T, fs *FileSystem, user string) []byte {
+	resp, err := fs.Login(&pb.RsAuthenticationRequest{Username: user,
+		PasswordHash: []byte(user + " password"), Salt: []byte("salt")})
+	if err != nil {
+		t.Fatalf("Login produced an error: %+v", err)
+	}
+	return resp.GetToken()
+}
+
+// Tests that paths cannot leave the user's directory.
+func TestFileSystem_PathEscape(t *testing.T) {
+	fs := newTestFileSystem(t, "alice", "bob")
+	alice, bob := login(t, fs, "alice"), login(t, fs, "bob")
+
+	_, err := fs.Write(&pb.RsWriteRequest{
+		Path: "secret", Token: bob, Data: []byte("bob's")})
+	if err != nil {
+		t.Fatalf("Write produced an error: %+v", err)
+	}
+
+	escaping := []string{"../bob/secret", "a/../../bob/secret",
+		"/../../data/bob/secret", "../../users.json"}
+	for _, p := range escaping {
+		read, err := fs.Read(&pb.RsReadRequest{Path: p, Token: alice})
+		if err != ErrNotFound {
+			t.Errorf("Expected ErrNotFound reading %q, received %q: %v",
+				p, read.GetData(), err)
+		}
+	}
+	for _, p := range escaping {
+		_, err = fs.Write(&pb.RsWriteRequest{
+			Path: p, Token: alice, Data: []byte("alice's")})
+		if err != nil {
+			t.Errorf("Write of %q produced an error: %+v", p, err)
+		}
+	}
+
+	read, err := fs.Read(&pb.RsReadRequest{Path: "secret", Token: bob})
+	if err != nil || string(read.GetData()) != "bob's" {
+		t.Errorf("Writes by alice changed bob's file to %q: %v",
+			read.GetData(), err)
+	}
+	root := fs.params.Root
+	if _, err = os.Stat(filepath.Join(root, dataDir, "alice", "bob", "secret")); err != nil {
+		t.Errorf("Write of an escaping path was not kept in the user's "+
+			"directory: %+v", err)
+	}
+
+	for _, p := range []string{"", "/", "..", "a\\..\\..\\bob", "a\x00b"} {
+		_, err = fs.Write(&pb.RsWriteRequest{Path: p, Token: alice})
+		if err != ErrInvalidPath {
+			t.Errorf("Expected ErrInvalidPath writing %q, received: %v", p, err)
+		}
+	}
+	_, err = fs.Rename(&pb.RsRenameRequest{
+		OldPath: "secret", NewPath: "../../tmp/secret", Token: bob})
+	if err != nil {
+		t.Fatalf("Rename produced an error: %+v", err)
+	}
+	if _, err = os.Stat(filepath.Join(root, tmpDir, "secret")); !os.IsNotExist(err) {
+		t.Errorf("Rename moved the file out of the user's directory: %v", err)
+	}
+}
+
+// Tests that requests with an unknown or expired token are rejected.
+func TestFileSystem_InvalidToken(t *testing.T) {
+	fs := newTestFileSystem(t, "alice")
+	token := login(t, fs, "alice")
+
+	if _, err := fs.Read(&pb.RsReadRequest{Path: "file", Token: []byte("unknown")}); err != ErrInvalidToken {
+		t.Errorf("Expected ErrInvalidToken for an unknown token, received: %v",
+			err)
+	}
+	if _, err := fs.GetLastWrite(&pb.RsLastWriteRequest{}); err != ErrInvalidToken {
+		t.Errorf("Expected ErrInvalidToken for no token, received: %v", err)
+	}
+
+	fs.authMux.Lock()
+	s := fs.sessions[string(token)]
+	s.expiry = time.Now().Add(-time.Second)
+	fs.sessions[string(token)] = s
+	fs.authMux.Unlock()
+	_, err := fs.Write(&pb.RsWriteRequest{Path: "file", Token: token})
+	if err != ErrInvalidToken {
+		t.Errorf("Expected ErrInvalidToken for an expired token, received: %v",
+			err)
+	}
+
+	// Changing the credentials of a user invalidates their tokens
+	token = login(t, fs, "alice")
+	if err = fs.AddUser("alice", []byte("new password"), nil); err != nil {
+		t.Fatalf("AddUser produced an error: %+v", err)
+	}
+	_, err = fs.Stat(&pb.RsReadRequest{Path: "file", Token: token})
+	if err != ErrInvalidToken {
+		t.Errorf("Expected ErrInvalidToken after changing credentials, "+
+			"received: %v", err)
+	}
+}
+
+// Tests that ConditionalWrite only writes when the last modified time is the
+// expected one, including after changes made in quick succession.
+func TestFileSystem_ConditionalWrite(t *testing.T) {
+	fs := newTestFileSystem(t, "alice")
+	token := login(t, fs, "alice")
+	write := func(p string, expected int64) error {
+		_, err := fs.ConditionalWrite(&pb.RsConditionalWriteRequest{Path: p,
+			Token: token, Data: []byte("data"), ExpectedLastModified: expected})
+		return err
+	}
+	lastModified := func(p string) int64 {
+		stat, err := fs.Stat(&pb.RsReadRequest{Path: p, Token: token})
+		if err != nil {
+			t.Fatalf("Stat produced an error: %+v", err)
+		}
+		return stat.GetLastModified()
+	}
+
+	if err := write("dir/file", 0); err != nil {
+		t.Fatalf("ConditionalWrite creating a file produced an error: %+v", err)
+	}
+	if err := write("dir/file", 0); err != ErrModified {
+		t.Errorf("Expected ErrModified creating an existing file, "+
+			"received: %v", err)
+	}
+
+	for i := 0; i < 10; i++ {
+		expected := lastModified("dir/file")
+		_, err := fs.Write(&pb.RsWriteRequest{Path: "dir/file", Token: token})
+		if err != nil {
+			t.Fatalf("Write produced an error: %+v", err)
+		}
+		if err = write("dir/file", expected); err != ErrModified {
+			t.Fatalf("Expected ErrModified after write %d, received: %v",
+				i, err)
+		}
+		if err = write("dir/file", lastModified("dir/file")); err != nil {
+			t.Fatalf("ConditionalWrite %d produced an error: %+v", i, err)
+		}
+	}
+
+	// Renaming changes the last modified time of the new paths
+	expected := lastModified("dir/file")
+	_, err := fs.Rename(&pb.RsRenameRequest{
+		OldPath: "dir", NewPath: "moved", Token: token})
+	if err != nil {
+		t.Fatalf("Rename produced an error: %+v", err)
+	}
+	if err = write("moved/file", expected); err != ErrModified {
+		t.Errorf("Expected ErrModified after rename, received: %v", err)
+	}
+	if err = write("dir/file", expected); err != ErrModified {
+		t.Errorf("Expected ErrModified for a renamed file, received: %v", err)
+	}
+	if err = write("moved/file", lastModified("moved/file")); err != nil {
+		t.Errorf("ConditionalWrite after rename produced an error: %+v", err)
+	}
+}
+
+// Tests that Watch ends with ErrInvalidToken when the token expires.
+func TestFileSystem_Watch_Expiry(t *testing.T) {
+	fs := newTestFileSystem(t, "alice")
+	token := login(t, fs, "alice")
+	fs.authMux.Lock()
+	s := fs.sessions[string(token)]
+	s.expiry = time.Now().Add(100 * time.Millisecond)
+	fs.sessions[string(token)] = s
+	fs.authMux.Unlock()
+
+	stream := newEventStream(context.Background())
+	result := make(chan error)
+	go func() {
+		result <- fs.Watch(&pb.RsWatchRequest{Token: token}, stream)
+	}()
+	select {
+	case err := <-result:
+		if err != ErrInvalidToken {
+			t.Errorf("Expected ErrInvalidToken, received: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Watch did not end once the token expired")
+	}
+}
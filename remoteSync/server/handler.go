@@ -10,14 +10,25 @@
 package server
 
 import (
+	"context"
 	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/elixxir/comms/certificates"
+	"gitlab.com/elixxir/comms/drain"
 	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/recorder"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/comms/messages"
 	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"runtime/debug"
 )
 
+// ErrModified is returned by ConditionalWrite when the path has been modified
+// since the expected timestamp.
+var ErrModified = status.Error(codes.FailedPrecondition,
+	"path has been modified since the expected timestamp")
+
 // Comms object bundles low-level connect.ProtoComms,
 // and the endpoint Handler interface.
 type Comms struct {
@@ -25,6 +36,9 @@ type Comms struct {
 	handler Handler
 	*pb.UnimplementedRemoteSyncServer
 	*messages.UnimplementedGenericServer
+	recorder.Tap
+	drain.Drainer
+	certificates.HttpsServer
 }
 
 // Handler describes the endpoint callbacks for remote sync.
@@ -35,6 +49,22 @@ type Handler interface {
 	GetLastModified(*pb.RsReadRequest) (*pb.RsTimestampResponse, error)
 	GetLastWrite(*pb.RsLastWriteRequest) (*pb.RsTimestampResponse, error)
 	ReadDir(*pb.RsReadRequest) (*pb.RsReadDirResponse, error)
+	Delete(*pb.RsReadRequest) (*messages.Ack, error)
+	Rename(*pb.RsRenameRequest) (*messages.Ack, error)
+	Stat(*pb.RsReadRequest) (*pb.RsStatResponse, error)
+	// ConditionalWrite must return ErrModified if the path's last modified
+	// timestamp does not match the expected one.
+	ConditionalWrite(*pb.RsConditionalWriteRequest) (*messages.Ack, error)
+	// ReadStream sends the requested range in chunks. Chunks sent without a
+	// hash have it set.
+	ReadStream(*pb.RsReadStreamRequest, pb.RemoteSync_ReadStreamServer) error
+	// WriteStream stores the chunks received, replying with the offset of
+	// the upload once the client closes the stream. Chunks which do not
+	// match their hash are rejected with ErrCorruptChunk.
+	WriteStream(pb.RemoteSync_WriteStreamServer) error
+	GetUploadOffset(*pb.RsReadRequest) (*pb.RsUploadOffset, error)
+	// Watch sends changes until the stream is closed. See ChangeLog.
+	Watch(*pb.RsWatchRequest, pb.RemoteSync_WatchServer) error
 }
 
 // StartRemoteSync starts a new RemoteSync server on the address:port specified by localServer
@@ -56,21 +86,47 @@ func StartRemoteSync(id *id.ID, localServer string, handler Handler,
 
 	// Register the high-level comms endpoint functionality
 	grpcServer := rsServer.GetServer()
-	pb.RegisterRemoteSyncServer(grpcServer, &rsServer)
+	grpcServer.RegisterService(rsServer.TrackService(
+		rsServer.WrapService(&pb.RemoteSync_ServiceDesc)), &rsServer)
 	messages.RegisterGenericServer(grpcServer, &rsServer)
+	rsServer.RegisterHealth(grpcServer)
 
 	pc.ServeWithWeb()
 	return &rsServer
 }
 
+// GracefulStop drains the calls in flight, cancelling any left once ctx is
+// done, and shuts down the server and its HTTPS listener.
+func (rc *Comms) GracefulStop(ctx context.Context) error {
+	err := drain.GracefulStop(ctx, &rc.Drainer, rc.ProtoComms)
+	rc.StopHttps()
+	return err
+}
+
+// ServeHttpsReloading serves the gRPC-web endpoints of the RemoteSync server
+// over HTTPS on a listener of its own at the address, presenting the
+// certificate held by the reloader to each new handshake.
+func (rc *Comms) ServeHttpsReloading(address string,
+	reloader *certificates.Reloader) error {
+	return rc.StartHttps(rc.ProtoComms, address, reloader)
+}
+
 // implementationFunctions for the Handler interface.
 type implementationFunctions struct {
-	Login           func(req *pb.RsAuthenticationRequest) (*pb.RsAuthenticationResponse, error)
-	Read            func(*pb.RsReadRequest) (*pb.RsReadResponse, error)
-	Write           func(*pb.RsWriteRequest) (*messages.Ack, error)
-	GetLastModified func(*pb.RsReadRequest) (*pb.RsTimestampResponse, error)
-	GetLastWrite    func(*messages.Ack) (*pb.RsTimestampResponse, error)
-	ReadDir         func(*pb.RsReadRequest) (*pb.RsReadDirResponse, error)
+	Login            func(req *pb.RsAuthenticationRequest) (*pb.RsAuthenticationResponse, error)
+	Read             func(*pb.RsReadRequest) (*pb.RsReadResponse, error)
+	Write            func(*pb.RsWriteRequest) (*messages.Ack, error)
+	GetLastModified  func(*pb.RsReadRequest) (*pb.RsTimestampResponse, error)
+	GetLastWrite     func(*pb.RsLastWriteRequest) (*pb.RsTimestampResponse, error)
+	ReadDir          func(*pb.RsReadRequest) (*pb.RsReadDirResponse, error)
+	Delete           func(*pb.RsReadRequest) (*messages.Ack, error)
+	Rename           func(*pb.RsRenameRequest) (*messages.Ack, error)
+	Stat             func(*pb.RsReadRequest) (*pb.RsStatResponse, error)
+	ConditionalWrite func(*pb.RsConditionalWriteRequest) (*messages.Ack, error)
+	ReadStream       func(*pb.RsReadStreamRequest, pb.RemoteSync_ReadStreamServer) error
+	WriteStream      func(pb.RemoteSync_WriteStreamServer) error
+	GetUploadOffset  func(*pb.RsReadRequest) (*pb.RsUploadOffset, error)
+	Watch            func(*pb.RsWatchRequest, pb.RemoteSync_WatchServer) error
 }
 
 // Implementation allows users of the client library to set the
@@ -104,7 +160,7 @@ func NewImplementation() *Implementation {
 				warn(um)
 				return new(pb.RsTimestampResponse), nil
 			},
-			GetLastWrite: func(*messages.Ack) (*pb.RsTimestampResponse, error) {
+			GetLastWrite: func(*pb.RsLastWriteRequest) (*pb.RsTimestampResponse, error) {
 				warn(um)
 				return new(pb.RsTimestampResponse), nil
 			},
@@ -112,6 +168,38 @@ func NewImplementation() *Implementation {
 				warn(um)
 				return new(pb.RsReadDirResponse), nil
 			},
+			Delete: func(*pb.RsReadRequest) (*messages.Ack, error) {
+				warn(um)
+				return new(messages.Ack), nil
+			},
+			Rename: func(*pb.RsRenameRequest) (*messages.Ack, error) {
+				warn(um)
+				return new(messages.Ack), nil
+			},
+			Stat: func(*pb.RsReadRequest) (*pb.RsStatResponse, error) {
+				warn(um)
+				return new(pb.RsStatResponse), nil
+			},
+			ConditionalWrite: func(*pb.RsConditionalWriteRequest) (*messages.Ack, error) {
+				warn(um)
+				return new(messages.Ack), nil
+			},
+			ReadStream: func(*pb.RsReadStreamRequest, pb.RemoteSync_ReadStreamServer) error {
+				warn(um)
+				return nil
+			},
+			WriteStream: func(pb.RemoteSync_WriteStreamServer) error {
+				warn(um)
+				return nil
+			},
+			GetUploadOffset: func(*pb.RsReadRequest) (*pb.RsUploadOffset, error) {
+				warn(um)
+				return new(pb.RsUploadOffset), nil
+			},
+			Watch: func(*pb.RsWatchRequest, pb.RemoteSync_WatchServer) error {
+				warn(um)
+				return nil
+			},
 		},
 	}
 }
@@ -129,9 +217,33 @@ func (s *Implementation) Write(message *pb.RsWriteRequest) (*messages.Ack, error
 func (s *Implementation) GetLastModified(message *pb.RsReadRequest) (*pb.RsTimestampResponse, error) {
 	return s.Functions.GetLastModified(message)
 }
-func (s *Implementation) GetLastWrite(message *messages.Ack) (*pb.RsTimestampResponse, error) {
+func (s *Implementation) GetLastWrite(message *pb.RsLastWriteRequest) (*pb.RsTimestampResponse, error) {
 	return s.Functions.GetLastWrite(message)
 }
 func (s *Implementation) ReadDir(message *pb.RsReadRequest) (*pb.RsReadDirResponse, error) {
 	return s.Functions.ReadDir(message)
 }
+func (s *Implementation) Delete(message *pb.RsReadRequest) (*messages.Ack, error) {
+	return s.Functions.Delete(message)
+}
+func (s *Implementation) Rename(message *pb.RsRenameRequest) (*messages.Ack, error) {
+	return s.Functions.Rename(message)
+}
+func (s *Implementation) Stat(message *pb.RsReadRequest) (*pb.RsStatResponse, error) {
+	return s.Functions.Stat(message)
+}
+func (s *Implementation) ConditionalWrite(message *pb.RsConditionalWriteRequest) (*messages.Ack, error) {
+	return s.Functions.ConditionalWrite(message)
+}
+func (s *Implementation) ReadStream(message *pb.RsReadStreamRequest, stream pb.RemoteSync_ReadStreamServer) error {
+	return s.Functions.ReadStream(message, stream)
+}
+func (s *Implementation) WriteStream(stream pb.RemoteSync_WriteStreamServer) error {
+	return s.Functions.WriteStream(stream)
+}
+func (s *Implementation) GetUploadOffset(message *pb.RsReadRequest) (*pb.RsUploadOffset, error) {
+	return s.Functions.GetUploadOffset(message)
+}
+func (s *Implementation) Watch(message *pb.RsWatchRequest, stream pb.RemoteSync_WatchServer) error {
+	return s.Functions.Watch(message, stream)
+}
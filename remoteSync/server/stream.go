@@ -0,0 +1,49 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the integrity checks of streamed reads and writes
+
+package server
+
+import (
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrCorruptChunk is returned by the stream passed to Handler.WriteStream when
+// a chunk does not match its hash.
+var ErrCorruptChunk = status.Error(codes.DataLoss,
+	"chunk does not match its hash")
+
+// hashingReadStream sets the hash of each chunk sent which has none.
+type hashingReadStream struct {
+	pb.RemoteSync_ReadStreamServer
+}
+
+func (s *hashingReadStream) Send(chunk *pb.RsChunk) error {
+	if len(chunk.GetHash()) == 0 {
+		chunk.Hash = pb.HashRsChunk(chunk.GetData())
+	}
+	return s.RemoteSync_ReadStreamServer.Send(chunk)
+}
+
+// verifyingWriteStream rejects received chunks which do not match their hash.
+type verifyingWriteStream struct {
+	pb.RemoteSync_WriteStreamServer
+}
+
+func (s *verifyingWriteStream) Recv() (*pb.RsWriteChunk, error) {
+	chunk, err := s.RemoteSync_WriteStreamServer.Recv()
+	if err != nil {
+		return nil, err
+	}
+	if !chunk.Verify() {
+		return nil, ErrCorruptChunk
+	}
+	return chunk, nil
+}
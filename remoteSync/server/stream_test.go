@@ -0,0 +1,50 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package server
+
+import (
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"io"
+	"testing"
+)
+
+// writeChunkStream is a RemoteSync_WriteStreamServer receiving the chunks.
+type writeChunkStream struct {
+	pb.RemoteSync_WriteStreamServer
+	chunks []*pb.RsWriteChunk
+}
+
+func (s *writeChunkStream) Recv() (*pb.RsWriteChunk, error) {
+	if len(s.chunks) == 0 {
+		return nil, io.EOF
+	}
+	chunk := s.chunks[0]
+	s.chunks = s.chunks[1:]
+	return chunk, nil
+}
+
+// Tests that chunks which do not match their hash, or have none, are rejected
+// with ErrCorruptChunk.
+func TestVerifyingWriteStream_Recv(t *testing.T) {
+	data := []byte("chunk data")
+	stream := &verifyingWriteStream{&writeChunkStream{chunks: []*pb.RsWriteChunk{
+		{Data: data, Hash: pb.HashRsChunk(data)},
+		{Data: []byte("altered data"), Hash: pb.HashRsChunk(data)},
+		{Data: data},
+	}}}
+
+	if _, err := stream.Recv(); err != nil {
+		t.Errorf("Recv rejected a valid chunk: %+v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := stream.Recv(); err != ErrCorruptChunk {
+			t.Errorf("Expected ErrCorruptChunk for corrupt chunk %d, "+
+				"received: %v", i, err)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package replayguard rejects timestamped, signed requests which are stale or
+// have already been received, before they reach the handler of a comms
+// server. Requests are only remembered once the handler, which authenticates
+// them, accepts them.
+
+package replayguard
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/binary"
+	jww "github.com/spf13/jwalterweatherman"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrStale is returned for requests whose timestamp is outside the
+	// freshness window.
+	ErrStale = status.Error(codes.InvalidArgument,
+		"request timestamp is outside the freshness window")
+
+	// ErrReplayed is returned for requests which have already been received.
+	ErrReplayed = status.Error(codes.AlreadyExists,
+		"request has already been received")
+
+	// ErrUnsigned is returned for requests without a signature, which cannot
+	// be told apart from each other.
+	ErrUnsigned = status.Error(codes.Unauthenticated, "request is not signed")
+)
+
+// Params configures a Guard.
+type Params struct {
+	// Requests are accepted if their timestamp is within this duration of
+	// the current time, in either direction
+	Window time.Duration
+
+	// Maximum number of requests remembered. Once full, the oldest requests
+	// are forgotten before their timestamp becomes stale.
+	CacheSize int
+}
+
+// DefaultParams returns the default Params.
+func DefaultParams() Params {
+	return Params{
+		Window:    5 * time.Minute,
+		CacheSize: 1 << 16,
+	}
+}
+
+// Guard remembers the senders and signatures of the fresh requests it has
+// accepted. Its zero value uses DefaultParams.
+type Guard struct {
+	mux    sync.Mutex
+	params *Params
+
+	seen  map[[sha256.Size]byte]*list.Element
+	order *list.List
+
+	// Requests being handled, which are remembered once the handler accepts
+	// them
+	pending map[[sha256.Size]byte]struct{}
+}
+
+// entry is a remembered request.
+type entry struct {
+	key    [sha256.Size]byte
+	expiry time.Time
+}
+
+// SetReplayParams replaces the parameters of the Guard.
+func (g *Guard) SetReplayParams(params Params) {
+	g.mux.Lock()
+	defer g.mux.Unlock()
+	g.params = &params
+}
+
+// CheckReplay returns ErrStale if the timestamp, in Unix nanoseconds, is
+// outside the freshness window, ErrUnsigned if there is no signature, or
+// ErrReplayed if a request to the endpoint from the sender with the same
+// signature has already been accepted or is being handled. Otherwise, it
+// returns a function which must be called with the error returned by handling
+// the request. The request is only remembered, until its timestamp becomes
+// stale, if that error is nil, so requests failing authentication by the
+// handler neither fill the cache nor evict the requests accepted.
+func (g *Guard) CheckReplay(endpoint string, sender []byte, timestamp int64,
+	signature []byte) (done func(err error), err error) {
+	g.mux.Lock()
+	defer g.mux.Unlock()
+
+	params := g.getParams()
+	if g.seen == nil {
+		g.seen = make(map[[sha256.Size]byte]*list.Element)
+		g.order = list.New()
+		g.pending = make(map[[sha256.Size]byte]struct{})
+	}
+
+	now := time.Now()
+	ts := time.Unix(0, timestamp)
+	if ts.Before(now.Add(-params.Window)) || ts.After(now.Add(params.Window)) {
+		jww.DEBUG.Printf("Rejected stale %s request with timestamp %s",
+			endpoint, ts)
+		return nil, ErrStale
+	}
+	if len(signature) == 0 {
+		jww.DEBUG.Printf("Rejected unsigned %s request", endpoint)
+		return nil, ErrUnsigned
+	}
+
+	g.forgetExpired(now)
+
+	key := replayKey(endpoint, sender, signature)
+	_, pending := g.pending[key]
+	if _, seen := g.seen[key]; seen || pending {
+		jww.WARN.Printf("Rejected replayed %s request with timestamp %s",
+			endpoint, ts)
+		return nil, ErrReplayed
+	}
+	g.pending[key] = struct{}{}
+
+	return func(err error) {
+		g.mux.Lock()
+		defer g.mux.Unlock()
+		delete(g.pending, key)
+		if err == nil {
+			g.remember(key, ts.Add(g.getParams().Window))
+		}
+	}, nil
+}
+
+// getParams returns the parameters of the Guard. The lock must be held.
+func (g *Guard) getParams() Params {
+	if g.params != nil {
+		return *g.params
+	}
+	return DefaultParams()
+}
+
+// remember adds the accepted request, forgetting the oldest requests if the
+// cache is full. The lock must be held.
+func (g *Guard) remember(key [sha256.Size]byte, expiry time.Time) {
+	g.forgetExpired(time.Now())
+	for g.order.Len() > 0 && g.order.Len() >= g.getParams().CacheSize {
+		oldest := g.order.Remove(g.order.Front()).(*entry)
+		delete(g.seen, oldest.key)
+		jww.WARN.Printf("Replay cache is full, forgetting a request before " +
+			"it becomes stale")
+	}
+	g.seen[key] = g.order.PushBack(&entry{key: key, expiry: expiry})
+}
+
+// replayKey returns the key a request is remembered by. Each field is length
+// prefixed so that different fields cannot produce the same key.
+func replayKey(endpoint string, sender, signature []byte) [sha256.Size]byte {
+	h := sha256.New()
+	for _, field := range [][]byte{[]byte(endpoint), sender, signature} {
+		var length [8]byte
+		binary.BigEndian.PutUint64(length[:], uint64(len(field)))
+		h.Write(length[:])
+		h.Write(field)
+	}
+	var key [sha256.Size]byte
+	copy(key[:], h.Sum(nil))
+	return key
+}
+
+// forgetExpired removes the oldest remembered requests whose timestamps have
+// become stale.
+func (g *Guard) forgetExpired(now time.Time) {
+	for e := g.order.Front(); e != nil; e = g.order.Front() {
+		if now.Before(e.Value.(*entry).expiry) {
+			return
+		}
+		delete(g.seen, g.order.Remove(e).(*entry).key)
+	}
+}
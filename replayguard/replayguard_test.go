@@ -0,0 +1,127 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package replayguard
+
+import (
+	"github.com/pkg/errors"
+	"testing"
+	"time"
+)
+
+// accept checks the request and, if it is not rejected, handles it with the
+// error handlerErr.
+func accept(g *Guard, endpoint, sender string, timestamp int64,
+	signature string, handlerErr error) error {
+	done, err := g.CheckReplay(endpoint, []byte(sender), timestamp,
+		[]byte(signature))
+	if err != nil {
+		return err
+	}
+	done(handlerErr)
+	return nil
+}
+
+// Tests that CheckReplay rejects stale, unsigned and replayed requests with
+// distinct errors and accepts fresh ones.
+func TestGuard_CheckReplay(t *testing.T) {
+	g := &Guard{}
+	g.SetReplayParams(Params{Window: time.Minute, CacheSize: 10})
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		endpoint  string
+		sender    string
+		timestamp time.Time
+		signature string
+		expected  error
+	}{
+		{"fresh", "A", "s", now, "sig1", nil},
+		{"replayed", "A", "s", now, "sig1", ErrReplayed},
+		{"other endpoint", "B", "s", now, "sig1", nil},
+		{"other sender", "A", "t", now, "sig1", nil},
+		{"other signature", "A", "s", now, "sig2", nil},
+		{"old", "A", "s", now.Add(-2 * time.Minute), "sig3", ErrStale},
+		{"future", "A", "s", now.Add(2 * time.Minute), "sig4", ErrStale},
+		{"stale replay", "A", "s", now.Add(-2 * time.Minute), "sig1", ErrStale},
+		{"unsigned", "A", "s", now, "", ErrUnsigned},
+		{"unsigned again", "A", "s", now, "", ErrUnsigned},
+		{"shifted fields", "A", "ss", now, "ig1", nil},
+	}
+
+	for _, tt := range tests {
+		err := accept(g, tt.endpoint, tt.sender, tt.timestamp.UnixNano(),
+			tt.signature, nil)
+		if !errors.Is(err, tt.expected) {
+			t.Errorf("%s: expected %v, received %v", tt.name, tt.expected, err)
+		}
+	}
+}
+
+// Tests that requests the handler rejects are not remembered, so a flood of
+// forged signatures does not evict the requests accepted, and that a request
+// is rejected while a request with the same signature is being handled.
+func TestGuard_CheckReplay_HandlerRejected(t *testing.T) {
+	g := &Guard{}
+	g.SetReplayParams(Params{Window: time.Minute, CacheSize: 2})
+	now := time.Now().UnixNano()
+
+	if err := accept(g, "A", "s", now, "real", nil); err != nil {
+		t.Fatalf("Request should be accepted: %+v", err)
+	}
+	for i := 0; i < 10; i++ {
+		err := accept(g, "A", "s", now, string(rune('a'+i)),
+			errors.New("invalid signature"))
+		if err != nil {
+			t.Fatalf("Forged request %d should reach the handler: %+v", i, err)
+		}
+	}
+	if len(g.seen) != 1 || len(g.pending) != 0 {
+		t.Errorf("Guard remembers %d requests with %d pending, expected 1 "+
+			"and 0", len(g.seen), len(g.pending))
+	}
+	if err := accept(g, "A", "s", now, "real", nil); err != ErrReplayed {
+		t.Errorf("Accepted request should be replayed: %v", err)
+	}
+	if err := accept(g, "A", "s", now, "a", nil); err != nil {
+		t.Errorf("Request rejected by the handler should be accepted "+
+			"again: %+v", err)
+	}
+
+	done, err := g.CheckReplay("A", []byte("s"), now, []byte("handling"))
+	if err != nil {
+		t.Fatalf("Request should be accepted: %+v", err)
+	}
+	if err = accept(g, "A", "s", now, "handling", nil); err != ErrReplayed {
+		t.Errorf("Request being handled should be replayed: %v", err)
+	}
+	done(nil)
+}
+
+// Tests that the cache stays within its size, forgetting the oldest requests
+// first.
+func TestGuard_CheckReplay_CacheSize(t *testing.T) {
+	g := &Guard{}
+	g.SetReplayParams(Params{Window: time.Minute, CacheSize: 2})
+	now := time.Now().UnixNano()
+
+	for _, sig := range []string{"a", "b", "c"} {
+		if err := accept(g, "A", "s", now, sig, nil); err != nil {
+			t.Fatalf("Request %s should be accepted: %+v", sig, err)
+		}
+	}
+	if g.order.Len() != 2 || len(g.seen) != 2 {
+		t.Errorf("Cache holds %d requests, expected 2", len(g.seen))
+	}
+	if err := accept(g, "A", "s", now, "a", nil); err != nil {
+		t.Errorf("Forgotten request should be accepted: %+v", err)
+	}
+	if err := accept(g, "A", "s", now, "c", nil); err != ErrReplayed {
+		t.Errorf("Remembered request should be replayed: %v", err)
+	}
+}
@@ -17,8 +17,15 @@ import (
 	jww "github.com/spf13/jwalterweatherman"
 	pb "gitlab.com/elixxir/comms/mixmessages"
 	"gitlab.com/xx_network/comms/messages"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// ErrInvalidSignature is returned to requests which are not signed with the
+// registered key of the user they are for.
+var ErrInvalidSignature = status.Error(codes.Unauthenticated,
+	"request is not signed by the user")
+
 // Handles validation of reverse-authentication tokens
 func (u *Comms) AuthenticateToken(ctx context.Context,
 	msg *messages.AuthenticatedMessage) (*messages.Ack, error) {
@@ -58,7 +65,39 @@ func (u *Comms) RemoveFact(ctx context.Context, msg *pb.FactRemovalRequest) (*me
 }
 
 func (u *Comms) RequestChannelLease(ctx context.Context, msg *pb.ChannelLeaseRequest) (*pb.ChannelLeaseResponse, error) {
-	return u.handler.RequestChannelLease(msg)
+	done, err := u.CheckReplay("RequestChannelLease", msg.GetUserID(),
+		msg.GetTimestamp(), msg.GetUserPubKeyRSASignature())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := u.handler.RequestChannelLease(msg)
+	done(err)
+	return resp, err
+}
+
+// RenewChannelLease extends a user's unexpired channel lease.
+func (u *Comms) RenewChannelLease(ctx context.Context, msg *pb.ChannelLeaseRenewal) (*pb.ChannelLeaseResponse, error) {
+	done, err := u.CheckReplay("RenewChannelLease",
+		msg.GetRequest().GetUserID(), msg.GetRequest().GetTimestamp(),
+		msg.GetRequest().GetUserPubKeyRSASignature())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := u.handler.RenewChannelLease(msg)
+	done(err)
+	return resp, err
+}
+
+// RevokeChannelLease revokes a user's channel lease before it expires.
+func (u *Comms) RevokeChannelLease(ctx context.Context, msg *pb.ChannelLeaseRevocation) (*messages.Ack, error) {
+	done, err := u.CheckReplay("RevokeChannelLease", msg.GetUserID(),
+		msg.GetTimestamp(), msg.GetUserPubKeyRSASignature())
+	if err != nil {
+		return nil, err
+	}
+	ack, err := u.handler.RevokeChannelLease(msg)
+	done(err)
+	return ack, err
 }
 
 // ValidateUsername validates that a user owns a username by signing the contents of the
@@ -67,3 +106,46 @@ func (u *Comms) ValidateUsername(
 	ctx context.Context, request *pb.UsernameValidationRequest) (*pb.UsernameValidation, error) {
 	return u.handler.ValidateUsername(request)
 }
+
+// RegisterFacts registers several facts, with a result for each fact.
+func (u *Comms) RegisterFacts(ctx context.Context, msg *pb.FactRegisterBatch) (*pb.FactRegisterBatchResponse, error) {
+	return u.handler.RegisterFacts(msg)
+}
+
+// ConfirmFacts confirms several facts, with a result for each fact.
+func (u *Comms) ConfirmFacts(ctx context.Context, msg *pb.FactConfirmBatch) (*pb.FactConfirmBatchResponse, error) {
+	return u.handler.ConfirmFacts(msg)
+}
+
+// ListFacts lists the facts registered to the ID of a request signed with the
+// user's registered key.
+func (u *Comms) ListFacts(ctx context.Context, msg *pb.FactListRequest) (*pb.FactListResponse, error) {
+	done, err := u.CheckReplay("ListFacts", msg.GetUID(), msg.GetTimestamp(),
+		msg.GetSignature())
+	if err != nil {
+		return nil, err
+	}
+	if err = u.verifyUser(msg); err != nil {
+		done(err)
+		return nil, err
+	}
+	resp, err := u.handler.ListFacts(msg)
+	done(err)
+	return resp, err
+}
+
+// verifyUser returns ErrInvalidSignature unless the request is signed with the
+// registered key of the user it lists the facts of.
+func (u *Comms) verifyUser(msg *pb.FactListRequest) error {
+	key, err := u.handler.GetUserKey(msg.GetUID())
+	if err != nil {
+		jww.DEBUG.Printf("Unable to get key of user %v: %+v", msg.GetUID(), err)
+		return ErrInvalidSignature
+	}
+	if err = msg.Verify(key); err != nil {
+		jww.DEBUG.Printf("Rejecting fact list request of user %v: %+v",
+			msg.GetUID(), err)
+		return ErrInvalidSignature
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package udb
+
+import (
+	"context"
+	"crypto/rand"
+	"github.com/pkg/errors"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/replayguard"
+	"gitlab.com/elixxir/comms/testkeys"
+	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/crypto/signature/rsa"
+	"testing"
+	"time"
+)
+
+// Tests that RegisterFacts and ConfirmFacts default to registering and
+// confirming each fact, with the error of each fact in its result.
+func TestComms_RegisterFacts_Default(t *testing.T) {
+	impl := NewImplementation()
+	impl.Functions.RegisterFact = func(
+		request *pb.FactRegisterRequest) (*pb.FactRegisterResponse, error) {
+		if request.Fact.Fact == "bad" {
+			return nil, errors.New("bad fact")
+		}
+		return &pb.FactRegisterResponse{ConfirmationID: request.Fact.Fact}, nil
+	}
+	impl.Functions.ConfirmFact = func(
+		request *pb.FactConfirmRequest) (*messages.Ack, error) {
+		if request.Code != "1234" {
+			return nil, errors.New("wrong code")
+		}
+		return &messages.Ack{}, nil
+	}
+	comms := &Comms{handler: impl}
+
+	registered, err := comms.RegisterFacts(context.Background(),
+		&pb.FactRegisterBatch{Requests: []*pb.FactRegisterRequest{
+			{Fact: &pb.Fact{Fact: "user@example.com"}},
+			{Fact: &pb.Fact{Fact: "bad"}},
+		}})
+	if err != nil {
+		t.Fatalf("RegisterFacts produced an error: %+v", err)
+	}
+	results := registered.GetResults()
+	if len(results) != 2 ||
+		results[0].GetResponse().GetConfirmationID() != "user@example.com" ||
+		results[0].GetError() != "" || results[1].GetError() == "" {
+		t.Errorf("Unexpected registration results: %+v", results)
+	}
+
+	confirmed, err := comms.ConfirmFacts(context.Background(),
+		&pb.FactConfirmBatch{Requests: []*pb.FactConfirmRequest{
+			{ConfirmationID: "user@example.com", Code: "0000"},
+			{ConfirmationID: "user@example.com", Code: "1234"},
+		}})
+	if err != nil {
+		t.Fatalf("ConfirmFacts produced an error: %+v", err)
+	}
+	confirmResults := confirmed.GetResults()
+	if len(confirmResults) != 2 || confirmResults[0].GetError() == "" ||
+		confirmResults[1].GetError() != "" {
+		t.Errorf("Unexpected confirmation results: %+v", confirmResults)
+	}
+}
+
+// Tests that ListFacts passes requests signed with the user's key to the
+// handler, and rejects replayed, unsigned and foreign-signed requests before
+// they reach it.
+func TestComms_ListFacts_Authenticated(t *testing.T) {
+	key, err := rsa.LoadPrivateKeyFromPem(testkeys.GetNodeKey())
+	if err != nil {
+		t.Fatalf("Unable to load key: %+v", err)
+	}
+	foreignKey, err := rsa.LoadPrivateKeyFromPem(testkeys.GetGatewayKey())
+	if err != nil {
+		t.Fatalf("Unable to load key: %+v", err)
+	}
+
+	impl := NewImplementation()
+	calls := 0
+	impl.Functions.ListFacts = func(
+		*pb.FactListRequest) (*pb.FactListResponse, error) {
+		calls++
+		return &pb.FactListResponse{}, nil
+	}
+	impl.Functions.GetUserKey = func(uid []byte) (*rsa.PublicKey, error) {
+		if string(uid) != "user" {
+			return nil, errors.New("unknown user")
+		}
+		return key.GetPublic(), nil
+	}
+	comms := &Comms{handler: impl}
+
+	request := &pb.FactListRequest{
+		UID: []byte("user"), Timestamp: time.Now().UnixNano()}
+	if err = request.Sign(rand.Reader, key); err != nil {
+		t.Fatalf("Unable to sign request: %+v", err)
+	}
+	if _, err = comms.ListFacts(context.Background(), request); err != nil {
+		t.Errorf("ListFacts produced an error: %+v", err)
+	}
+	_, err = comms.ListFacts(context.Background(), request)
+	if err != replayguard.ErrReplayed {
+		t.Errorf("Replayed request was not rejected: %v", err)
+	}
+
+	_, err = comms.ListFacts(context.Background(), &pb.FactListRequest{
+		UID: []byte("user"), Timestamp: time.Now().UnixNano()})
+	if err != replayguard.ErrUnsigned {
+		t.Errorf("Unsigned request was not rejected: %v", err)
+	}
+
+	foreign := &pb.FactListRequest{
+		UID: []byte("user"), Timestamp: time.Now().UnixNano()}
+	if err = foreign.Sign(rand.Reader, foreignKey); err != nil {
+		t.Fatalf("Unable to sign request: %+v", err)
+	}
+	_, err = comms.ListFacts(context.Background(), foreign)
+	if err != ErrInvalidSignature {
+		t.Errorf("Foreign-signed request was not rejected: %v", err)
+	}
+
+	unknown := &pb.FactListRequest{
+		UID: []byte("other"), Timestamp: time.Now().UnixNano()}
+	if err = unknown.Sign(rand.Reader, key); err != nil {
+		t.Fatalf("Unable to sign request: %+v", err)
+	}
+	_, err = comms.ListFacts(context.Background(), unknown)
+	if err != ErrInvalidSignature {
+		t.Errorf("Request for an unknown user was not rejected: %v", err)
+	}
+
+	if calls != 1 {
+		t.Errorf("Handler called %d times, expected 1", calls)
+	}
+}
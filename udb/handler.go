@@ -10,12 +10,18 @@
 package udb
 
 import (
-	//	"github.com/pkg/errors"
+	"context"
+	"github.com/pkg/errors"
 	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/elixxir/comms/certificates"
+	"gitlab.com/elixxir/comms/drain"
 	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/recorder"
+	"gitlab.com/elixxir/comms/replayguard"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/comms/messages"
 	//	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/crypto/signature/rsa"
 	"gitlab.com/xx_network/primitives/id"
 	//	"google.golang.org/grpc/reflection"
 	"runtime/debug"
@@ -29,6 +35,10 @@ type Comms struct {
 	// has all the functions called by endpoint.go
 	*pb.UnimplementedUDBServer
 	*messages.UnimplementedGenericServer
+	recorder.Tap
+	drain.Drainer
+	certificates.HttpsServer
+	replayguard.Guard
 }
 
 // StartServer starts a new server on the address:port specified by localServer
@@ -46,13 +56,31 @@ func StartServer(id *id.ID, localServer string, handler Handler,
 		ProtoComms: pc,
 		handler:    handler,
 	}
-	pb.RegisterUDBServer(udbServer.GetServer(), &udbServer)
+	udbServer.GetServer().RegisterService(udbServer.TrackService(
+		udbServer.WrapService(&pb.UDB_ServiceDesc)), &udbServer)
 	messages.RegisterGenericServer(udbServer.GetServer(), &udbServer)
+	udbServer.RegisterHealth(udbServer.GetServer())
 
 	pc.ServeWithWeb()
 	return &udbServer
 }
 
+// GracefulStop drains the calls in flight, cancelling any left once ctx is
+// done, and shuts down the server and its HTTPS listener.
+func (u *Comms) GracefulStop(ctx context.Context) error {
+	err := drain.GracefulStop(ctx, &u.Drainer, u.ProtoComms)
+	u.StopHttps()
+	return err
+}
+
+// ServeHttpsReloading serves the gRPC-web endpoints of the server over
+// HTTPS on a listener of its own at the address, presenting the certificate
+// held by the reloader to each new handshake.
+func (u *Comms) ServeHttpsReloading(address string,
+	reloader *certificates.Reloader) error {
+	return u.StartHttps(u.ProtoComms, address, reloader)
+}
+
 // Handler is the interface udb has to implement to integrate with the comms
 // library properly.
 type Handler interface {
@@ -71,9 +99,23 @@ type Handler interface {
 	RemoveFact(request *pb.FactRemovalRequest) (*messages.Ack, error)
 	// RequestChannelLease requests a signature & lease on a user's ed25519 public key from user discovery for use in channels
 	RequestChannelLease(request *pb.ChannelLeaseRequest) (*pb.ChannelLeaseResponse, error)
+	// RenewChannelLease extends a user's unexpired channel lease
+	RenewChannelLease(request *pb.ChannelLeaseRenewal) (*pb.ChannelLeaseResponse, error)
+	// RevokeChannelLease revokes a user's channel lease before it expires
+	RevokeChannelLease(request *pb.ChannelLeaseRevocation) (*messages.Ack, error)
 	// ValidateUsername validates that a user owns a username by signing the contents of the
 	// mixmessages.UsernameValidationRequest.
 	ValidateUsername(request *pb.UsernameValidationRequest) (*pb.UsernameValidation, error)
+	// RegisterFacts handles registering several facts into the database
+	RegisterFacts(request *pb.FactRegisterBatch) (*pb.FactRegisterBatchResponse, error)
+	// ConfirmFacts checks several Facts against the Fact database
+	ConfirmFacts(request *pb.FactConfirmBatch) (*pb.FactConfirmBatchResponse, error)
+	// ListFacts returns the facts registered to the ID of a request, which
+	// comms has verified is signed with the key returned by GetUserKey
+	ListFacts(request *pb.FactListRequest) (*pb.FactListResponse, error)
+	// GetUserKey returns the RSA public key registered to the user ID, which
+	// signed requests from the user are verified against
+	GetUserKey(uid []byte) (*rsa.PublicKey, error)
 }
 
 // implementationFunctions are the actual implementations of
@@ -98,9 +140,23 @@ type implementationFunctions struct {
 	RemoveFact func(request *pb.FactRemovalRequest) (*messages.Ack, error)
 	// RequestChannelLease requests a signature & lease on a user's ed25519 public key from user discovery for use in channels
 	RequestChannelLease func(request *pb.ChannelLeaseRequest) (*pb.ChannelLeaseResponse, error)
+	// RenewChannelLease extends a user's unexpired channel lease
+	RenewChannelLease func(request *pb.ChannelLeaseRenewal) (*pb.ChannelLeaseResponse, error)
+	// RevokeChannelLease revokes a user's channel lease before it expires
+	RevokeChannelLease func(request *pb.ChannelLeaseRevocation) (*messages.Ack, error)
 	// ValidateUsername validates that a user owns a username by signing the contents of the
 	// mixmessages.UsernameValidationRequest.
 	ValidateUsername func(request *pb.UsernameValidationRequest) (*pb.UsernameValidation, error)
+	// RegisterFacts handles registering several facts into the database
+	RegisterFacts func(request *pb.FactRegisterBatch) (*pb.FactRegisterBatchResponse, error)
+	// ConfirmFacts checks several Facts against the Fact database
+	ConfirmFacts func(request *pb.FactConfirmBatch) (*pb.FactConfirmBatchResponse, error)
+	// ListFacts returns the facts registered to the ID of a request, which
+	// comms has verified is signed with the key returned by GetUserKey
+	ListFacts func(request *pb.FactListRequest) (*pb.FactListResponse, error)
+	// GetUserKey returns the RSA public key registered to the user ID, which
+	// signed requests from the user are verified against
+	GetUserKey func(uid []byte) (*rsa.PublicKey, error)
 }
 
 // Implementation allows users of the client library to set the
@@ -112,14 +168,15 @@ type Implementation struct {
 // NewImplementation returns a Implementation struct with all of the
 // function pointers returning nothing and printing an error.
 // Inside UDB, you would call this, then set all functions to your
-// own UDB version of the function.
+// own UDB version of the function. The batch functions default to calling
+// RegisterFact and ConfirmFact for each fact.
 func NewImplementation() *Implementation {
 	um := "UNIMPLEMENTED FUNCTION!"
 	warn := func(msg string) {
 		jww.WARN.Printf(msg)
 		jww.WARN.Printf("%s", debug.Stack())
 	}
-	return &Implementation{
+	impl := &Implementation{
 		Functions: implementationFunctions{
 			// Stub for RegisterUser which returns a blank message and prints a warning
 			RegisterUser: func(registration *pb.UDBUserRegistration) (*messages.Ack, error) {
@@ -150,12 +207,35 @@ func NewImplementation() *Implementation {
 				warn(um)
 				return &pb.ChannelLeaseResponse{}, nil
 			},
+			RenewChannelLease: func(request *pb.ChannelLeaseRenewal) (*pb.ChannelLeaseResponse, error) {
+				warn(um)
+				return &pb.ChannelLeaseResponse{}, nil
+			},
+			RevokeChannelLease: func(request *pb.ChannelLeaseRevocation) (*messages.Ack, error) {
+				warn(um)
+				return &messages.Ack{}, nil
+			},
 			ValidateUsername: func(request *pb.UsernameValidationRequest) (*pb.UsernameValidation, error) {
 				warn(um)
 				return &pb.UsernameValidation{}, nil
 			},
+			ListFacts: func(request *pb.FactListRequest) (*pb.FactListResponse, error) {
+				warn(um)
+				return &pb.FactListResponse{}, nil
+			},
+			GetUserKey: func(uid []byte) (*rsa.PublicKey, error) {
+				warn(um)
+				return nil, errors.New(um)
+			},
 		},
 	}
+	impl.Functions.RegisterFacts = func(request *pb.FactRegisterBatch) (*pb.FactRegisterBatchResponse, error) {
+		return registerEachFact(request, impl.Functions.RegisterFact), nil
+	}
+	impl.Functions.ConfirmFacts = func(request *pb.FactConfirmBatch) (*pb.FactConfirmBatchResponse, error) {
+		return confirmEachFact(request, impl.Functions.ConfirmFact), nil
+	}
+	return impl
 }
 
 // RegisterUser is called by the RegisterUser in endpoint.go. It calls the corresponding function in the interface.
@@ -188,8 +268,67 @@ func (s *Implementation) RequestChannelLease(request *pb.ChannelLeaseRequest) (*
 	return s.Functions.RequestChannelLease(request)
 }
 
+// RenewChannelLease is called by the RenewChannelLease in endpoint.go. It calls the corresponding function in the interface.
+func (s *Implementation) RenewChannelLease(request *pb.ChannelLeaseRenewal) (*pb.ChannelLeaseResponse, error) {
+	return s.Functions.RenewChannelLease(request)
+}
+
+// RevokeChannelLease is called by the RevokeChannelLease in endpoint.go. It calls the corresponding function in the interface.
+func (s *Implementation) RevokeChannelLease(request *pb.ChannelLeaseRevocation) (*messages.Ack, error) {
+	return s.Functions.RevokeChannelLease(request)
+}
+
 // ValidateUsername validates that a user owns a username by signing the contents of the
 // mixmessages.UsernameValidationRequest.
 func (s *Implementation) ValidateUsername(request *pb.UsernameValidationRequest) (*pb.UsernameValidation, error) {
 	return s.Functions.ValidateUsername(request)
 }
+
+// RegisterFacts is called by the RegisterFacts in endpoint.go. It calls the corresponding function in the interface.
+func (s *Implementation) RegisterFacts(request *pb.FactRegisterBatch) (*pb.FactRegisterBatchResponse, error) {
+	return s.Functions.RegisterFacts(request)
+}
+
+// ConfirmFacts is called by the ConfirmFacts in endpoint.go. It calls the corresponding function in the interface.
+func (s *Implementation) ConfirmFacts(request *pb.FactConfirmBatch) (*pb.FactConfirmBatchResponse, error) {
+	return s.Functions.ConfirmFacts(request)
+}
+
+// ListFacts is called by the ListFacts in endpoint.go. It calls the corresponding function in the interface.
+func (s *Implementation) ListFacts(request *pb.FactListRequest) (*pb.FactListResponse, error) {
+	return s.Functions.ListFacts(request)
+}
+
+// GetUserKey is called by the ListFacts in endpoint.go. It calls the corresponding function in the interface.
+func (s *Implementation) GetUserKey(uid []byte) (*rsa.PublicKey, error) {
+	return s.Functions.GetUserKey(uid)
+}
+
+// registerEachFact registers each fact in the batch, recording the error of
+// each registration which fails in its result.
+func registerEachFact(request *pb.FactRegisterBatch,
+	registerFact func(*pb.FactRegisterRequest) (*pb.FactRegisterResponse, error)) *pb.FactRegisterBatchResponse {
+	results := make([]*pb.FactRegisterResult, len(request.GetRequests()))
+	for i, r := range request.GetRequests() {
+		response, err := registerFact(r)
+		results[i] = &pb.FactRegisterResult{Response: response}
+		if err != nil {
+			results[i].Error = err.Error()
+		}
+	}
+	return &pb.FactRegisterBatchResponse{Results: results}
+}
+
+// confirmEachFact confirms each fact in the batch, recording the error of
+// each confirmation which fails in its result.
+func confirmEachFact(request *pb.FactConfirmBatch,
+	confirmFact func(*pb.FactConfirmRequest) (*messages.Ack, error)) *pb.FactConfirmBatchResponse {
+	results := make([]*pb.FactConfirmResult, len(request.GetRequests()))
+	for i, r := range request.GetRequests() {
+		results[i] = &pb.FactConfirmResult{}
+		if _, err := confirmFact(r); err != nil {
+			results[i].Error = err.Error()
+		}
+	}
+	return &pb.FactConfirmBatchResponse{Results: results}
+}
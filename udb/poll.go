@@ -15,7 +15,9 @@ import (
 	"github.com/pkg/errors"
 	jww "github.com/spf13/jwalterweatherman"
 	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/network"
 	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/ndf"
 )
 
 // RequestNdf is used by User Discovery to Request a NDF from permissioning
@@ -46,3 +48,12 @@ func (u *Comms) RequestNdf(host *connect.Host) (*pb.NDF, error) {
 	result := &pb.NDF{}
 	return result, ptypes.UnmarshalAny(resultMsg, result)
 }
+
+// NewNdfWatcher returns a watcher keeping the NDF current by streaming each new
+// NDF from the permissioning host, starting from the current NDF, which may be
+// nil. Call Start on the watcher to begin watching.
+func (u *Comms) NewNdfWatcher(host *connect.Host,
+	current *ndf.NetworkDefinition, params network.NdfWatcherParams) (
+	*network.NdfWatcher, error) {
+	return network.NewNdfWatcher(u.ProtoComms, host, current, params)
+}
@@ -0,0 +1,30 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the replayer for captures of user discovery traffic
+
+package udb
+
+import (
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/recorder"
+	"gitlab.com/xx_network/comms/connect"
+	"io"
+)
+
+// ReplayCapture feeds the UDB calls recorded in capture into handler, in
+// the order they were made, and reports how each replayed call compared to
+// the recording. Senders are authenticated against the hosts known to pc;
+// calls recorded with their tokens redacted arrive unauthenticated.
+func ReplayCapture(pc *connect.ProtoComms, handler Handler,
+	capture io.Reader) ([]*recorder.Result, error) {
+	u := &Comms{
+		ProtoComms: pc,
+		handler:    handler,
+	}
+	return recorder.Replay(capture, &pb.UDB_ServiceDesc, u)
+}